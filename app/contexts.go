@@ -19,110 +19,69 @@ import (
 	"unicode/utf8"
 )
 
-// CreateContainerContext provides the container create action context.
-type CreateContainerContext struct {
+// AddDomainContainerContext provides the container addDomain action context.
+type AddDomainContainerContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Command     []string
-	Entrypoint  []string
-	Env         []string
-	Image       string
-	Name        string
-	SslRedirect bool
-	Volumes     []string
-	WorkingDir  *string
+	Domain string
+	ID     string
 }
 
-// NewCreateContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller create action.
-func NewCreateContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*CreateContainerContext, error) {
+// NewAddDomainContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller addDomain action.
+func NewAddDomainContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddDomainContainerContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := CreateContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramCommand := req.Params["command"]
-	if len(paramCommand) > 0 {
-		params := paramCommand
-		rctx.Command = params
-	}
-	paramEntrypoint := req.Params["entrypoint"]
-	if len(paramEntrypoint) > 0 {
-		params := paramEntrypoint
-		rctx.Entrypoint = params
-	}
-	paramEnv := req.Params["env"]
-	if len(paramEnv) > 0 {
-		params := paramEnv
-		rctx.Env = params
-	}
-	paramImage := req.Params["image"]
-	if len(paramImage) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("image"))
+	rctx := AddDomainContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDomain := req.Params["domain"]
+	if len(paramDomain) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("domain"))
 	} else {
-		rawImage := paramImage[0]
-		rctx.Image = rawImage
-	}
-	paramName := req.Params["name"]
-	if len(paramName) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("name"))
-	} else {
-		rawName := paramName[0]
-		rctx.Name = rawName
-		if ok := goa.ValidatePattern(`^[a-zA-Z0-9_]+$`, rctx.Name); !ok {
-			err = goa.MergeErrors(err, goa.InvalidPatternError(`name`, rctx.Name, `^[a-zA-Z0-9_]+$`))
-		}
-		if utf8.RuneCountInString(rctx.Name) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`name`, rctx.Name, utf8.RuneCountInString(rctx.Name), 1, true))
-		}
-		if utf8.RuneCountInString(rctx.Name) > 64 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`name`, rctx.Name, utf8.RuneCountInString(rctx.Name), 64, false))
+		rawDomain := paramDomain[0]
+		rctx.Domain = rawDomain
+		if ok := goa.ValidatePattern(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`, rctx.Domain); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`domain`, rctx.Domain, `^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`))
 		}
-	}
-	paramSslRedirect := req.Params["sslRedirect"]
-	if len(paramSslRedirect) == 0 {
-		rctx.SslRedirect = true
-	} else {
-		rawSslRedirect := paramSslRedirect[0]
-		if sslRedirect, err2 := strconv.ParseBool(rawSslRedirect); err2 == nil {
-			rctx.SslRedirect = sslRedirect
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("sslRedirect", rawSslRedirect, "boolean"))
+		if utf8.RuneCountInString(rctx.Domain) > 253 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`domain`, rctx.Domain, utf8.RuneCountInString(rctx.Domain), 253, false))
 		}
 	}
-	paramVolumes := req.Params["volumes"]
-	if len(paramVolumes) > 0 {
-		params := paramVolumes
-		rctx.Volumes = params
-	}
-	paramWorkingDir := req.Params["workingDir"]
-	if len(paramWorkingDir) > 0 {
-		rawWorkingDir := paramWorkingDir[0]
-		rctx.WorkingDir = &rawWorkingDir
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
 	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *CreateContainerContext) OK(r *GoaContainerCreateResults) error {
+func (ctx *AddDomainContainerContext) OK(r *GoaContainerDomain) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "vnd.application/goa.container.create.results+json")
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.domain+json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *CreateContainerContext) BadRequest(r error) error {
+func (ctx *AddDomainContainerContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
+// NotFound sends a HTTP response with status code 404.
+func (ctx *AddDomainContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
 // Conflict sends a HTTP response with status code 409.
-func (ctx *CreateContainerContext) Conflict(r error) error {
+func (ctx *AddDomainContainerContext) Conflict(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -130,410 +89,3472 @@ func (ctx *CreateContainerContext) Conflict(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *CreateContainerContext) InternalServerError(r error) error {
+func (ctx *AddDomainContainerContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// DownloadContainerContext provides the container download action context.
-type DownloadContainerContext struct {
+// AddRouteContainerContext provides the container addRoute action context.
+type AddRouteContainerContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	ID           string
-	InternalPath string
+	Host        string
+	ID          string
+	PathPrefix  string
+	Priority    int
+	StripPrefix bool
 }
 
-// NewDownloadContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller download action.
-func NewDownloadContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*DownloadContainerContext, error) {
+// NewAddRouteContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller addRoute action.
+func NewAddRouteContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddRouteContainerContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := DownloadContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := AddRouteContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramHost := req.Params["host"]
+	if len(paramHost) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("host"))
+	} else {
+		rawHost := paramHost[0]
+		rctx.Host = rawHost
+		if ok := goa.ValidatePattern(`^([a-zA-Z0-9]([a-zA-Z0-9_-]{0,62}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`, rctx.Host); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`host`, rctx.Host, `^([a-zA-Z0-9]([a-zA-Z0-9_-]{0,62}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`))
+		}
+		if utf8.RuneCountInString(rctx.Host) > 253 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`host`, rctx.Host, utf8.RuneCountInString(rctx.Host), 253, false))
+		}
+	}
 	paramID := req.Params["id"]
 	if len(paramID) > 0 {
 		rawID := paramID[0]
 		rctx.ID = rawID
 	}
-	paramInternalPath := req.Params["internalPath"]
-	if len(paramInternalPath) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("internalPath"))
+	paramPathPrefix := req.Params["pathPrefix"]
+	if len(paramPathPrefix) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("pathPrefix"))
 	} else {
-		rawInternalPath := paramInternalPath[0]
-		rctx.InternalPath = rawInternalPath
+		rawPathPrefix := paramPathPrefix[0]
+		rctx.PathPrefix = rawPathPrefix
+		if ok := goa.ValidatePattern(`^/[a-zA-Z0-9._~/-]*$`, rctx.PathPrefix); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`pathPrefix`, rctx.PathPrefix, `^/[a-zA-Z0-9._~/-]*$`))
+		}
+		if utf8.RuneCountInString(rctx.PathPrefix) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`pathPrefix`, rctx.PathPrefix, utf8.RuneCountInString(rctx.PathPrefix), 255, false))
+		}
+	}
+	paramPriority := req.Params["priority"]
+	if len(paramPriority) == 0 {
+		rctx.Priority = 0
+	} else {
+		rawPriority := paramPriority[0]
+		if priority, err2 := strconv.Atoi(rawPriority); err2 == nil {
+			rctx.Priority = priority
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("priority", rawPriority, "integer"))
+		}
+		if rctx.Priority < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`priority`, rctx.Priority, 0, true))
+		}
+		if rctx.Priority > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`priority`, rctx.Priority, 1000, false))
+		}
+	}
+	paramStripPrefix := req.Params["stripPrefix"]
+	if len(paramStripPrefix) == 0 {
+		rctx.StripPrefix = false
+	} else {
+		rawStripPrefix := paramStripPrefix[0]
+		if stripPrefix, err2 := strconv.ParseBool(rawStripPrefix); err2 == nil {
+			rctx.StripPrefix = stripPrefix
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("stripPrefix", rawStripPrefix, "boolean"))
+		}
 	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *DownloadContainerContext) OK(resp []byte) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/octet-stream")
-	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
-}
-
-// NotFound sends a HTTP response with status code 404.
-func (ctx *DownloadContainerContext) NotFound(r error) error {
+func (ctx *AddRouteContainerContext) OK(r *GoaContainerRoute) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.route+json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// InternalServerError sends a HTTP response with status code 500.
-func (ctx *DownloadContainerContext) InternalServerError(r error) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *AddRouteContainerContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
-}
-
-// ExecContainerContext provides the container exec action context.
-type ExecContainerContext struct {
-	context.Context
-	*goa.ResponseData
-	*goa.RequestData
-	Command []string
-	ID      string
-	Tty     *bool
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// NewExecContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller exec action.
-func NewExecContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ExecContainerContext, error) {
-	var err error
-	resp := goa.ContextResponse(ctx)
-	resp.Service = service
-	req := goa.ContextRequest(ctx)
-	req.Request = r
-	rctx := ExecContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramCommand := req.Params["command"]
-	if len(paramCommand) > 0 {
-		params := paramCommand
-		rctx.Command = params
-	}
-	paramID := req.Params["id"]
-	if len(paramID) > 0 {
-		rawID := paramID[0]
-		rctx.ID = rawID
-	}
-	paramTty := req.Params["tty"]
-	if len(paramTty) > 0 {
-		rawTty := paramTty[0]
-		if tty, err2 := strconv.ParseBool(rawTty); err2 == nil {
-			tmp2 := &tty
-			rctx.Tty = tmp2
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("tty", rawTty, "boolean"))
-		}
-	}
-	return &rctx, err
+// NotFound sends a HTTP response with status code 404.
+func (ctx *AddRouteContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *ExecContainerContext) NotFound(r error) error {
+// Conflict sends a HTTP response with status code 409.
+func (ctx *AddRouteContainerContext) Conflict(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ExecContainerContext) InternalServerError(r error) error {
+func (ctx *AddRouteContainerContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetConfigContainerContext provides the container getConfig action context.
-type GetConfigContainerContext struct {
+// AddScheduleContainerContext provides the container addSchedule action context.
+type AddScheduleContainerContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	ID string
+	Action   *string
+	ID       string
+	Schedule *string
+	Timezone string
+	Window   *string
 }
 
-// NewGetConfigContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller getConfig action.
-func NewGetConfigContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetConfigContainerContext, error) {
+// NewAddScheduleContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller addSchedule action.
+func NewAddScheduleContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddScheduleContainerContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetConfigContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := AddScheduleContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAction := req.Params["action"]
+	if len(paramAction) > 0 {
+		rawAction := paramAction[0]
+		rctx.Action = &rawAction
+		if rctx.Action != nil {
+			if !(*rctx.Action == "start" || *rctx.Action == "stop") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError(`action`, *rctx.Action, []interface{}{"start", "stop"}))
+			}
+		}
+	}
 	paramID := req.Params["id"]
 	if len(paramID) > 0 {
 		rawID := paramID[0]
 		rctx.ID = rawID
 	}
+	paramSchedule := req.Params["schedule"]
+	if len(paramSchedule) > 0 {
+		rawSchedule := paramSchedule[0]
+		rctx.Schedule = &rawSchedule
+	}
+	paramTimezone := req.Params["timezone"]
+	if len(paramTimezone) == 0 {
+		rctx.Timezone = "UTC"
+	} else {
+		rawTimezone := paramTimezone[0]
+		rctx.Timezone = rawTimezone
+	}
+	paramWindow := req.Params["window"]
+	if len(paramWindow) > 0 {
+		rawWindow := paramWindow[0]
+		rctx.Window = &rawWindow
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetConfigContainerContext) OK(r *GoaContainerConfig) error {
+func (ctx *AddScheduleContainerContext) OK(r GoaContainerScheduleCollection) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.config+json")
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.schedule+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerScheduleCollection{}
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *AddScheduleContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetConfigContainerContext) NotFound() error {
+func (ctx *AddScheduleContainerContext) NotFound() error {
 	ctx.ResponseData.WriteHeader(404)
 	return nil
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetConfigContainerContext) InternalServerError(r error) error {
+func (ctx *AddScheduleContainerContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// InspectContainerContext provides the container inspect action context.
-type InspectContainerContext struct {
+// AddSecretContainerContext provides the container addSecret action context.
+type AddSecretContainerContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	ID string
+	Deferred bool
+	Env      *string
+	File     *string
+	ID       string
+	Name     string
 }
 
-// NewInspectContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller inspect action.
-func NewInspectContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*InspectContainerContext, error) {
+// NewAddSecretContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller addSecret action.
+func NewAddSecretContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddSecretContainerContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := InspectContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := AddSecretContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDeferred := req.Params["deferred"]
+	if len(paramDeferred) == 0 {
+		rctx.Deferred = false
+	} else {
+		rawDeferred := paramDeferred[0]
+		if deferred, err2 := strconv.ParseBool(rawDeferred); err2 == nil {
+			rctx.Deferred = deferred
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("deferred", rawDeferred, "boolean"))
+		}
+	}
+	paramEnv := req.Params["env"]
+	if len(paramEnv) > 0 {
+		rawEnv := paramEnv[0]
+		rctx.Env = &rawEnv
+		if rctx.Env != nil {
+			if ok := goa.ValidatePattern(`^[a-zA-Z_][a-zA-Z0-9_]*$`, *rctx.Env); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`env`, *rctx.Env, `^[a-zA-Z_][a-zA-Z0-9_]*$`))
+			}
+		}
+	}
+	paramFile := req.Params["file"]
+	if len(paramFile) > 0 {
+		rawFile := paramFile[0]
+		rctx.File = &rawFile
+		if rctx.File != nil {
+			if ok := goa.ValidatePattern(`^/.+$`, *rctx.File); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`file`, *rctx.File, `^/.+$`))
+			}
+		}
+	}
 	paramID := req.Params["id"]
 	if len(paramID) > 0 {
 		rawID := paramID[0]
 		rctx.ID = rawID
 	}
+	paramName := req.Params["name"]
+	if len(paramName) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("name"))
+	} else {
+		rawName := paramName[0]
+		rctx.Name = rawName
+	}
 	return &rctx, err
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *InspectContainerContext) OK(r *GoaContainerInspect) error {
+// Accepted sends a HTTP response with status code 202.
+func (ctx *AddSecretContainerContext) Accepted() error {
+	ctx.ResponseData.WriteHeader(202)
+	return nil
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *AddSecretContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *AddSecretContainerContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.inspect+json")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *InspectContainerContext) NotFound() error {
+func (ctx *AddSecretContainerContext) NotFound() error {
 	ctx.ResponseData.WriteHeader(404)
 	return nil
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *InspectContainerContext) InternalServerError(r error) error {
+func (ctx *AddSecretContainerContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ListContainerContext provides the container list action context.
-type ListContainerContext struct {
+// CreateContainerContext provides the container create action context.
+type CreateContainerContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Command                []string
+	CPU                    *int
+	Entrypoint             []string
+	Env                    []string
+	ExpiresAt              *time.Time
+	HealthCheckCommand     []string
+	HealthCheckInterval    int
+	HealthCheckPath        *string
+	HealthCheckRetries     int
+	HealthCheckStartPeriod int
+	HealthCheckTimeout     int
+	IdleTimeout            int
+	Image                  string
+	Kind                   string
+	Memory                 *string
+	MetricsPath            *string
+	MetricsPort            *int
+	Name                   string
+	Pids                   *int
+	Port                   *int
+	Ports                  []string
+	Protocol               string
+	Replicas               int
+	RestartMaxRetries      int
+	RestartPolicy          string
+	Secrets                []string
+	SslRedirect            bool
+	Storage                *string
+	TTL                    *int
+	Volumes                []string
+	WorkingDir             *string
 }
 
-// NewListContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller list action.
-func NewListContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListContainerContext, error) {
+// NewCreateContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller create action.
+func NewCreateContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*CreateContainerContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ListContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
-	return &rctx, err
-}
-
-// OK sends a HTTP response with status code 200.
+	rctx := CreateContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramCommand := req.Params["command"]
+	if len(paramCommand) > 0 {
+		params := paramCommand
+		rctx.Command = params
+	}
+	paramCPU := req.Params["cpu"]
+	if len(paramCPU) > 0 {
+		rawCPU := paramCPU[0]
+		if cpu, err2 := strconv.Atoi(rawCPU); err2 == nil {
+			tmp3 := cpu
+			tmp2 := &tmp3
+			rctx.CPU = tmp2
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("cpu", rawCPU, "integer"))
+		}
+		if rctx.CPU != nil {
+			if *rctx.CPU < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`cpu`, *rctx.CPU, 1, true))
+			}
+		}
+		if rctx.CPU != nil {
+			if *rctx.CPU > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`cpu`, *rctx.CPU, 100, false))
+			}
+		}
+	}
+	paramEntrypoint := req.Params["entrypoint"]
+	if len(paramEntrypoint) > 0 {
+		params := paramEntrypoint
+		rctx.Entrypoint = params
+	}
+	paramEnv := req.Params["env"]
+	if len(paramEnv) > 0 {
+		params := paramEnv
+		rctx.Env = params
+	}
+	paramExpiresAt := req.Params["expiresAt"]
+	if len(paramExpiresAt) > 0 {
+		rawExpiresAt := paramExpiresAt[0]
+		if expiresAt, err2 := time.Parse(time.RFC3339, rawExpiresAt); err2 == nil {
+			tmp4 := &expiresAt
+			rctx.ExpiresAt = tmp4
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("expiresAt", rawExpiresAt, "datetime"))
+		}
+	}
+	paramHealthCheckCommand := req.Params["healthCheckCommand"]
+	if len(paramHealthCheckCommand) > 0 {
+		params := paramHealthCheckCommand
+		rctx.HealthCheckCommand = params
+	}
+	paramHealthCheckInterval := req.Params["healthCheckInterval"]
+	if len(paramHealthCheckInterval) == 0 {
+		rctx.HealthCheckInterval = 30
+	} else {
+		rawHealthCheckInterval := paramHealthCheckInterval[0]
+		if healthCheckInterval, err2 := strconv.Atoi(rawHealthCheckInterval); err2 == nil {
+			rctx.HealthCheckInterval = healthCheckInterval
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("healthCheckInterval", rawHealthCheckInterval, "integer"))
+		}
+		if rctx.HealthCheckInterval < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`healthCheckInterval`, rctx.HealthCheckInterval, 1, true))
+		}
+	}
+	paramHealthCheckPath := req.Params["healthCheckPath"]
+	if len(paramHealthCheckPath) > 0 {
+		rawHealthCheckPath := paramHealthCheckPath[0]
+		rctx.HealthCheckPath = &rawHealthCheckPath
+		if rctx.HealthCheckPath != nil {
+			if ok := goa.ValidatePattern(`^/[^'\s]*$`, *rctx.HealthCheckPath); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`healthCheckPath`, *rctx.HealthCheckPath, `^/[^'\s]*$`))
+			}
+		}
+	}
+	paramHealthCheckRetries := req.Params["healthCheckRetries"]
+	if len(paramHealthCheckRetries) == 0 {
+		rctx.HealthCheckRetries = 3
+	} else {
+		rawHealthCheckRetries := paramHealthCheckRetries[0]
+		if healthCheckRetries, err2 := strconv.Atoi(rawHealthCheckRetries); err2 == nil {
+			rctx.HealthCheckRetries = healthCheckRetries
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("healthCheckRetries", rawHealthCheckRetries, "integer"))
+		}
+		if rctx.HealthCheckRetries < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`healthCheckRetries`, rctx.HealthCheckRetries, 1, true))
+		}
+	}
+	paramHealthCheckStartPeriod := req.Params["healthCheckStartPeriod"]
+	if len(paramHealthCheckStartPeriod) == 0 {
+		rctx.HealthCheckStartPeriod = 0
+	} else {
+		rawHealthCheckStartPeriod := paramHealthCheckStartPeriod[0]
+		if healthCheckStartPeriod, err2 := strconv.Atoi(rawHealthCheckStartPeriod); err2 == nil {
+			rctx.HealthCheckStartPeriod = healthCheckStartPeriod
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("healthCheckStartPeriod", rawHealthCheckStartPeriod, "integer"))
+		}
+		if rctx.HealthCheckStartPeriod < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`healthCheckStartPeriod`, rctx.HealthCheckStartPeriod, 0, true))
+		}
+	}
+	paramHealthCheckTimeout := req.Params["healthCheckTimeout"]
+	if len(paramHealthCheckTimeout) == 0 {
+		rctx.HealthCheckTimeout = 10
+	} else {
+		rawHealthCheckTimeout := paramHealthCheckTimeout[0]
+		if healthCheckTimeout, err2 := strconv.Atoi(rawHealthCheckTimeout); err2 == nil {
+			rctx.HealthCheckTimeout = healthCheckTimeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("healthCheckTimeout", rawHealthCheckTimeout, "integer"))
+		}
+		if rctx.HealthCheckTimeout < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`healthCheckTimeout`, rctx.HealthCheckTimeout, 1, true))
+		}
+	}
+	paramIdleTimeout := req.Params["idleTimeout"]
+	if len(paramIdleTimeout) == 0 {
+		rctx.IdleTimeout = 0
+	} else {
+		rawIdleTimeout := paramIdleTimeout[0]
+		if idleTimeout, err2 := strconv.Atoi(rawIdleTimeout); err2 == nil {
+			rctx.IdleTimeout = idleTimeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("idleTimeout", rawIdleTimeout, "integer"))
+		}
+		if rctx.IdleTimeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`idleTimeout`, rctx.IdleTimeout, 0, true))
+		}
+	}
+	paramImage := req.Params["image"]
+	if len(paramImage) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("image"))
+	} else {
+		rawImage := paramImage[0]
+		rctx.Image = rawImage
+	}
+	paramKind := req.Params["kind"]
+	if len(paramKind) == 0 {
+		rctx.Kind = "web"
+	} else {
+		rawKind := paramKind[0]
+		rctx.Kind = rawKind
+		if !(rctx.Kind == "web" || rctx.Kind == "worker" || rctx.Kind == "private") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`kind`, rctx.Kind, []interface{}{"web", "worker", "private"}))
+		}
+	}
+	paramMemory := req.Params["memory"]
+	if len(paramMemory) > 0 {
+		rawMemory := paramMemory[0]
+		rctx.Memory = &rawMemory
+	}
+	paramMetricsPath := req.Params["metricsPath"]
+	if len(paramMetricsPath) > 0 {
+		rawMetricsPath := paramMetricsPath[0]
+		rctx.MetricsPath = &rawMetricsPath
+		if rctx.MetricsPath != nil {
+			if ok := goa.ValidatePattern(`^/\S*$`, *rctx.MetricsPath); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`metricsPath`, *rctx.MetricsPath, `^/\S*$`))
+			}
+		}
+	}
+	paramMetricsPort := req.Params["metricsPort"]
+	if len(paramMetricsPort) > 0 {
+		rawMetricsPort := paramMetricsPort[0]
+		if metricsPort, err2 := strconv.Atoi(rawMetricsPort); err2 == nil {
+			tmp11 := metricsPort
+			tmp10 := &tmp11
+			rctx.MetricsPort = tmp10
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("metricsPort", rawMetricsPort, "integer"))
+		}
+		if rctx.MetricsPort != nil {
+			if *rctx.MetricsPort < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`metricsPort`, *rctx.MetricsPort, 1, true))
+			}
+		}
+		if rctx.MetricsPort != nil {
+			if *rctx.MetricsPort > 65535 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`metricsPort`, *rctx.MetricsPort, 65535, false))
+			}
+		}
+	}
+	paramName := req.Params["name"]
+	if len(paramName) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("name"))
+	} else {
+		rawName := paramName[0]
+		rctx.Name = rawName
+		if ok := goa.ValidatePattern(`^[a-zA-Z0-9_]+$`, rctx.Name); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`name`, rctx.Name, `^[a-zA-Z0-9_]+$`))
+		}
+		if utf8.RuneCountInString(rctx.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`name`, rctx.Name, utf8.RuneCountInString(rctx.Name), 1, true))
+		}
+		if utf8.RuneCountInString(rctx.Name) > 64 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`name`, rctx.Name, utf8.RuneCountInString(rctx.Name), 64, false))
+		}
+	}
+	paramPids := req.Params["pids"]
+	if len(paramPids) > 0 {
+		rawPids := paramPids[0]
+		if pids, err2 := strconv.Atoi(rawPids); err2 == nil {
+			tmp13 := pids
+			tmp12 := &tmp13
+			rctx.Pids = tmp12
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("pids", rawPids, "integer"))
+		}
+		if rctx.Pids != nil {
+			if *rctx.Pids < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`pids`, *rctx.Pids, 1, true))
+			}
+		}
+	}
+	paramPort := req.Params["port"]
+	if len(paramPort) > 0 {
+		rawPort := paramPort[0]
+		if port, err2 := strconv.Atoi(rawPort); err2 == nil {
+			tmp15 := port
+			tmp14 := &tmp15
+			rctx.Port = tmp14
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("port", rawPort, "integer"))
+		}
+		if rctx.Port != nil {
+			if *rctx.Port < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`port`, *rctx.Port, 1, true))
+			}
+		}
+		if rctx.Port != nil {
+			if *rctx.Port > 65535 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`port`, *rctx.Port, 65535, false))
+			}
+		}
+	}
+	paramPorts := req.Params["ports"]
+	if len(paramPorts) > 0 {
+		params := paramPorts
+		rctx.Ports = params
+	}
+	paramProtocol := req.Params["protocol"]
+	if len(paramProtocol) == 0 {
+		rctx.Protocol = "http"
+	} else {
+		rawProtocol := paramProtocol[0]
+		rctx.Protocol = rawProtocol
+		if !(rctx.Protocol == "http" || rctx.Protocol == "https") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`protocol`, rctx.Protocol, []interface{}{"http", "https"}))
+		}
+	}
+	paramReplicas := req.Params["replicas"]
+	if len(paramReplicas) == 0 {
+		rctx.Replicas = 1
+	} else {
+		rawReplicas := paramReplicas[0]
+		if replicas, err2 := strconv.Atoi(rawReplicas); err2 == nil {
+			rctx.Replicas = replicas
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("replicas", rawReplicas, "integer"))
+		}
+		if rctx.Replicas < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`replicas`, rctx.Replicas, 1, true))
+		}
+	}
+	paramRestartMaxRetries := req.Params["restartMaxRetries"]
+	if len(paramRestartMaxRetries) == 0 {
+		rctx.RestartMaxRetries = 0
+	} else {
+		rawRestartMaxRetries := paramRestartMaxRetries[0]
+		if restartMaxRetries, err2 := strconv.Atoi(rawRestartMaxRetries); err2 == nil {
+			rctx.RestartMaxRetries = restartMaxRetries
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("restartMaxRetries", rawRestartMaxRetries, "integer"))
+		}
+		if rctx.RestartMaxRetries < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`restartMaxRetries`, rctx.RestartMaxRetries, 0, true))
+		}
+	}
+	paramRestartPolicy := req.Params["restartPolicy"]
+	if len(paramRestartPolicy) == 0 {
+		rctx.RestartPolicy = "no"
+	} else {
+		rawRestartPolicy := paramRestartPolicy[0]
+		rctx.RestartPolicy = rawRestartPolicy
+		if !(rctx.RestartPolicy == "no" || rctx.RestartPolicy == "on-failure" || rctx.RestartPolicy == "always" || rctx.RestartPolicy == "unless-stopped") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`restartPolicy`, rctx.RestartPolicy, []interface{}{"no", "on-failure", "always", "unless-stopped"}))
+		}
+	}
+	paramSecrets := req.Params["secrets"]
+	if len(paramSecrets) > 0 {
+		params := paramSecrets
+		rctx.Secrets = params
+	}
+	paramSslRedirect := req.Params["sslRedirect"]
+	if len(paramSslRedirect) == 0 {
+		rctx.SslRedirect = true
+	} else {
+		rawSslRedirect := paramSslRedirect[0]
+		if sslRedirect, err2 := strconv.ParseBool(rawSslRedirect); err2 == nil {
+			rctx.SslRedirect = sslRedirect
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("sslRedirect", rawSslRedirect, "boolean"))
+		}
+	}
+	paramStorage := req.Params["storage"]
+	if len(paramStorage) > 0 {
+		rawStorage := paramStorage[0]
+		rctx.Storage = &rawStorage
+	}
+	paramTTL := req.Params["ttl"]
+	if len(paramTTL) > 0 {
+		rawTTL := paramTTL[0]
+		if ttl, err2 := strconv.Atoi(rawTTL); err2 == nil {
+			tmp19 := ttl
+			tmp18 := &tmp19
+			rctx.TTL = tmp18
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("ttl", rawTTL, "integer"))
+		}
+		if rctx.TTL != nil {
+			if *rctx.TTL < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`ttl`, *rctx.TTL, 1, true))
+			}
+		}
+	}
+	paramVolumes := req.Params["volumes"]
+	if len(paramVolumes) > 0 {
+		params := paramVolumes
+		rctx.Volumes = params
+	}
+	paramWorkingDir := req.Params["workingDir"]
+	if len(paramWorkingDir) > 0 {
+		rawWorkingDir := paramWorkingDir[0]
+		rctx.WorkingDir = &rawWorkingDir
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *CreateContainerContext) OK(r *GoaContainerCreateResults) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vnd.application/goa.container.create.results+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *CreateContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *CreateContainerContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// Conflict sends a HTTP response with status code 409.
+func (ctx *CreateContainerContext) Conflict(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *CreateContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// DownloadContainerContext provides the container download action context.
+type DownloadContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID           string
+	InternalPath string
+}
+
+// NewDownloadContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller download action.
+func NewDownloadContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*DownloadContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := DownloadContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramInternalPath := req.Params["internalPath"]
+	if len(paramInternalPath) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("internalPath"))
+	} else {
+		rawInternalPath := paramInternalPath[0]
+		rctx.InternalPath = rawInternalPath
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *DownloadContainerContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/octet-stream")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *DownloadContainerContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *DownloadContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// EventsContainerContext provides the container events action context.
+type EventsContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID    string
+	Limit int
+}
+
+// NewEventsContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller events action.
+func NewEventsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*EventsContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := EventsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramLimit := req.Params["limit"]
+	if len(paramLimit) == 0 {
+		rctx.Limit = 50
+	} else {
+		rawLimit := paramLimit[0]
+		if limit, err2 := strconv.Atoi(rawLimit); err2 == nil {
+			rctx.Limit = limit
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("limit", rawLimit, "integer"))
+		}
+		if rctx.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`limit`, rctx.Limit, 1, true))
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *EventsContainerContext) OK(r GoaContainerEventCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.event+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerEventCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *EventsContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *EventsContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ExecContainerContext provides the container exec action context.
+type ExecContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Command []string
+	ID      string
+	Tty     *bool
+}
+
+// NewExecContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller exec action.
+func NewExecContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ExecContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ExecContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramCommand := req.Params["command"]
+	if len(paramCommand) > 0 {
+		params := paramCommand
+		rctx.Command = params
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramTty := req.Params["tty"]
+	if len(paramTty) > 0 {
+		rawTty := paramTty[0]
+		if tty, err2 := strconv.ParseBool(rawTty); err2 == nil {
+			tmp2 := &tty
+			rctx.Tty = tmp2
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("tty", rawTty, "boolean"))
+		}
+	}
+	return &rctx, err
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ExecContainerContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ExecContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ExtendContainerContext provides the container extend action context.
+type ExtendContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ExpiresAt *time.Time
+	ID        string
+	TTL       *int
+}
+
+// NewExtendContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller extend action.
+func NewExtendContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ExtendContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ExtendContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramExpiresAt := req.Params["expiresAt"]
+	if len(paramExpiresAt) > 0 {
+		rawExpiresAt := paramExpiresAt[0]
+		if expiresAt, err2 := time.Parse(time.RFC3339, rawExpiresAt); err2 == nil {
+			tmp22 := &expiresAt
+			rctx.ExpiresAt = tmp22
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("expiresAt", rawExpiresAt, "datetime"))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramTTL := req.Params["ttl"]
+	if len(paramTTL) > 0 {
+		rawTTL := paramTTL[0]
+		if ttl, err2 := strconv.Atoi(rawTTL); err2 == nil {
+			tmp24 := ttl
+			tmp23 := &tmp24
+			rctx.TTL = tmp23
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("ttl", rawTTL, "integer"))
+		}
+		if rctx.TTL != nil {
+			if *rctx.TTL < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`ttl`, *rctx.TTL, 1, true))
+			}
+		}
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *ExtendContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ExtendContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ExtendContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ExtendContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetConfigContainerContext provides the container getConfig action context.
+type GetConfigContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewGetConfigContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller getConfig action.
+func NewGetConfigContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetConfigContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetConfigContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetConfigContainerContext) OK(r *GoaContainerConfig) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.config+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetConfigContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetConfigContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetEnvContainerContext provides the container getEnv action context.
+type GetEnvContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewGetEnvContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller getEnv action.
+func NewGetEnvContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetEnvContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetEnvContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetEnvContainerContext) OK(r GoaContainerEnvCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.env+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerEnvCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetEnvContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetEnvContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// InspectContainerContext provides the container inspect action context.
+type InspectContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewInspectContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller inspect action.
+func NewInspectContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*InspectContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := InspectContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *InspectContainerContext) OK(r *GoaContainerInspect) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.inspect+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *InspectContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *InspectContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ListContainerContext provides the container list action context.
+type ListContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewListContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller list action.
+func NewListContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ListContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
 func (ctx *ListContainerContext) OK(r GoaContainerListEachCollection) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.list.each+json; type=collection")
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.list.each+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerListEachCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ListContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ListDomainsContainerContext provides the container listDomains action context.
+type ListDomainsContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewListDomainsContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller listDomains action.
+func NewListDomainsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListDomainsContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ListDomainsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ListDomainsContainerContext) OK(r GoaContainerDomainCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.domain+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerDomainCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ListDomainsContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ListDomainsContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ListRoutesContainerContext provides the container listRoutes action context.
+type ListRoutesContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewListRoutesContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller listRoutes action.
+func NewListRoutesContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListRoutesContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ListRoutesContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ListRoutesContainerContext) OK(r GoaContainerRouteCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.route+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerRouteCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ListRoutesContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ListRoutesContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ListSchedulesContainerContext provides the container listSchedules action context.
+type ListSchedulesContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewListSchedulesContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller listSchedules action.
+func NewListSchedulesContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListSchedulesContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ListSchedulesContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ListSchedulesContainerContext) OK(r GoaContainerScheduleCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.schedule+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerScheduleCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ListSchedulesContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ListSchedulesContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ListSecretsContainerContext provides the container listSecrets action context.
+type ListSecretsContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewListSecretsContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller listSecrets action.
+func NewListSecretsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListSecretsContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ListSecretsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ListSecretsContainerContext) OK(r GoaContainerSecretCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.secret+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerSecretCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ListSecretsContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ListSecretsContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// LogsContainerContext provides the container logs action context.
+type LogsContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Follow     bool
+	ID         string
+	Since      *time.Time
+	Stderr     bool
+	Stdout     bool
+	Tail       string
+	Timestamps bool
+	Until      *time.Time
+}
+
+// NewLogsContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller logs action.
+func NewLogsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*LogsContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := LogsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramFollow := req.Params["follow"]
+	if len(paramFollow) == 0 {
+		rctx.Follow = false
+	} else {
+		rawFollow := paramFollow[0]
+		if follow, err2 := strconv.ParseBool(rawFollow); err2 == nil {
+			rctx.Follow = follow
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("follow", rawFollow, "boolean"))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramSince := req.Params["since"]
+	if len(paramSince) > 0 {
+		rawSince := paramSince[0]
+		if since, err2 := time.Parse(time.RFC3339, rawSince); err2 == nil {
+			tmp25 := &since
+			rctx.Since = tmp25
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("since", rawSince, "datetime"))
+		}
+	}
+	paramStderr := req.Params["stderr"]
+	if len(paramStderr) == 0 {
+		rctx.Stderr = false
+	} else {
+		rawStderr := paramStderr[0]
+		if stderr, err2 := strconv.ParseBool(rawStderr); err2 == nil {
+			rctx.Stderr = stderr
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("stderr", rawStderr, "boolean"))
+		}
+	}
+	paramStdout := req.Params["stdout"]
+	if len(paramStdout) == 0 {
+		rctx.Stdout = false
+	} else {
+		rawStdout := paramStdout[0]
+		if stdout, err2 := strconv.ParseBool(rawStdout); err2 == nil {
+			rctx.Stdout = stdout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("stdout", rawStdout, "boolean"))
+		}
+	}
+	paramTail := req.Params["tail"]
+	if len(paramTail) == 0 {
+		rctx.Tail = "all"
+	} else {
+		rawTail := paramTail[0]
+		rctx.Tail = rawTail
+	}
+	paramTimestamps := req.Params["timestamps"]
+	if len(paramTimestamps) == 0 {
+		rctx.Timestamps = false
+	} else {
+		rawTimestamps := paramTimestamps[0]
+		if timestamps, err2 := strconv.ParseBool(rawTimestamps); err2 == nil {
+			rctx.Timestamps = timestamps
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timestamps", rawTimestamps, "boolean"))
+		}
+	}
+	paramUntil := req.Params["until"]
+	if len(paramUntil) > 0 {
+		rawUntil := paramUntil[0]
+		if until, err2 := time.Parse(time.RFC3339, rawUntil); err2 == nil {
+			tmp8 := &until
+			rctx.Until = tmp8
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("until", rawUntil, "datetime"))
+		}
+	}
+	return &rctx, err
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *LogsContainerContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *LogsContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// MetricsContainerContext provides the container metrics action context.
+type MetricsContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	From *time.Time
+	ID   string
+	Step int
+	To   *time.Time
+}
+
+// NewMetricsContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller metrics action.
+func NewMetricsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*MetricsContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := MetricsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramFrom := req.Params["from"]
+	if len(paramFrom) > 0 {
+		rawFrom := paramFrom[0]
+		if from, err2 := time.Parse(time.RFC3339, rawFrom); err2 == nil {
+			tmp27 := &from
+			rctx.From = tmp27
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("from", rawFrom, "datetime"))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramStep := req.Params["step"]
+	if len(paramStep) == 0 {
+		rctx.Step = 60
+	} else {
+		rawStep := paramStep[0]
+		if step, err2 := strconv.Atoi(rawStep); err2 == nil {
+			rctx.Step = step
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("step", rawStep, "integer"))
+		}
+		if rctx.Step < 60 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`step`, rctx.Step, 60, true))
+		}
+	}
+	paramTo := req.Params["to"]
+	if len(paramTo) > 0 {
+		rawTo := paramTo[0]
+		if to, err2 := time.Parse(time.RFC3339, rawTo); err2 == nil {
+			tmp29 := &to
+			rctx.To = tmp29
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("to", rawTo, "datetime"))
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *MetricsContainerContext) OK(r *GoaContainerMetrics) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.metrics+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *MetricsContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *MetricsContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *MetricsContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// PauseContainerContext provides the container pause action context.
+type PauseContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewPauseContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller pause action.
+func NewPauseContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*PauseContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := PauseContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *PauseContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *PauseContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// NotRunning sends a HTTP response with status code 409.
+func (ctx *PauseContainerContext) NotRunning() error {
+	ctx.ResponseData.WriteHeader(409)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *PauseContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RedeployContainerContext provides the container redeploy action context.
+type RedeployContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Command       []string
+	DrainPeriod   int
+	Entrypoint    []string
+	Env           []string
+	HealthTimeout int
+	ID            string
+	Image         *string
+	Strategy      string
+	Timeout       int
+	WorkingDir    *string
+}
+
+// NewRedeployContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller redeploy action.
+func NewRedeployContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RedeployContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RedeployContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramCommand := req.Params["command"]
+	if len(paramCommand) > 0 {
+		params := paramCommand
+		rctx.Command = params
+	}
+	paramDrainPeriod := req.Params["drainPeriod"]
+	if len(paramDrainPeriod) == 0 {
+		rctx.DrainPeriod = 10
+	} else {
+		rawDrainPeriod := paramDrainPeriod[0]
+		if drainPeriod, err2 := strconv.Atoi(rawDrainPeriod); err2 == nil {
+			rctx.DrainPeriod = drainPeriod
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("drainPeriod", rawDrainPeriod, "integer"))
+		}
+		if rctx.DrainPeriod < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`drainPeriod`, rctx.DrainPeriod, 0, true))
+		}
+	}
+	paramEntrypoint := req.Params["entrypoint"]
+	if len(paramEntrypoint) > 0 {
+		params := paramEntrypoint
+		rctx.Entrypoint = params
+	}
+	paramEnv := req.Params["env"]
+	if len(paramEnv) > 0 {
+		params := paramEnv
+		rctx.Env = params
+	}
+	paramHealthTimeout := req.Params["healthTimeout"]
+	if len(paramHealthTimeout) == 0 {
+		rctx.HealthTimeout = 60
+	} else {
+		rawHealthTimeout := paramHealthTimeout[0]
+		if healthTimeout, err2 := strconv.Atoi(rawHealthTimeout); err2 == nil {
+			rctx.HealthTimeout = healthTimeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("healthTimeout", rawHealthTimeout, "integer"))
+		}
+		if rctx.HealthTimeout < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`healthTimeout`, rctx.HealthTimeout, 1, true))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramImage := req.Params["image"]
+	if len(paramImage) > 0 {
+		rawImage := paramImage[0]
+		rctx.Image = &rawImage
+	}
+	paramStrategy := req.Params["strategy"]
+	if len(paramStrategy) == 0 {
+		rctx.Strategy = "recreate"
+	} else {
+		rawStrategy := paramStrategy[0]
+		rctx.Strategy = rawStrategy
+		if !(rctx.Strategy == "recreate" || rctx.Strategy == "blueGreen") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`strategy`, rctx.Strategy, []interface{}{"recreate", "blueGreen"}))
+		}
+	}
+	paramTimeout := req.Params["timeout"]
+	if len(paramTimeout) == 0 {
+		rctx.Timeout = 15
+	} else {
+		rawTimeout := paramTimeout[0]
+		if timeout, err2 := strconv.Atoi(rawTimeout); err2 == nil {
+			rctx.Timeout = timeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timeout", rawTimeout, "integer"))
+		}
+		if rctx.Timeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 0, true))
+		}
+	}
+	paramWorkingDir := req.Params["workingDir"]
+	if len(paramWorkingDir) > 0 {
+		rawWorkingDir := paramWorkingDir[0]
+		rctx.WorkingDir = &rawWorkingDir
+	}
+	return &rctx, err
+}
+
+// Accepted sends a HTTP response with status code 202.
+func (ctx *RedeployContainerContext) Accepted() error {
+	ctx.ResponseData.WriteHeader(202)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RedeployContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RedeployContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ReleasesContainerContext provides the container releases action context.
+type ReleasesContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewReleasesContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller releases action.
+func NewReleasesContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ReleasesContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ReleasesContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ReleasesContainerContext) OK(r GoaContainerReleaseCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.release+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerReleaseCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ReleasesContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ReleasesContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RemoveContainerContext provides the container remove action context.
+type RemoveContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Force bool
+	ID    string
+}
+
+// NewRemoveContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller remove action.
+func NewRemoveContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RemoveContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramForce := req.Params["force"]
+	if len(paramForce) == 0 {
+		rctx.Force = false
+	} else {
+		rawForce := paramForce[0]
+		if force, err2 := strconv.ParseBool(rawForce); err2 == nil {
+			rctx.Force = force
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("force", rawForce, "boolean"))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *RemoveContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// RunningContainer sends a HTTP response with status code 409.
+func (ctx *RemoveContainerContext) RunningContainer() error {
+	ctx.ResponseData.WriteHeader(409)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RemoveContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RemoveDomainContainerContext provides the container removeDomain action context.
+type RemoveDomainContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Domain string
+	ID     string
+}
+
+// NewRemoveDomainContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller removeDomain action.
+func NewRemoveDomainContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveDomainContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RemoveDomainContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDomain := req.Params["domain"]
+	if len(paramDomain) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("domain"))
+	} else {
+		rawDomain := paramDomain[0]
+		rctx.Domain = rawDomain
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *RemoveDomainContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveDomainContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RemoveDomainContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RemoveEnvContainerContext provides the container removeEnv action context.
+type RemoveEnvContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Deferred bool
+	ID       string
+	Name     []string
+}
+
+// NewRemoveEnvContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller removeEnv action.
+func NewRemoveEnvContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveEnvContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RemoveEnvContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDeferred := req.Params["deferred"]
+	if len(paramDeferred) == 0 {
+		rctx.Deferred = false
+	} else {
+		rawDeferred := paramDeferred[0]
+		if deferred, err2 := strconv.ParseBool(rawDeferred); err2 == nil {
+			rctx.Deferred = deferred
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("deferred", rawDeferred, "boolean"))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramName := req.Params["name"]
+	if len(paramName) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("name"))
+	} else {
+		params := paramName
+		rctx.Name = params
+	}
+	return &rctx, err
+}
+
+// Accepted sends a HTTP response with status code 202.
+func (ctx *RemoveEnvContainerContext) Accepted() error {
+	ctx.ResponseData.WriteHeader(202)
+	return nil
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *RemoveEnvContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveEnvContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RemoveEnvContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RemoveRouteContainerContext provides the container removeRoute action context.
+type RemoveRouteContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID    string
+	Route int
+}
+
+// NewRemoveRouteContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller removeRoute action.
+func NewRemoveRouteContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveRouteContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RemoveRouteContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramRoute := req.Params["route"]
+	if len(paramRoute) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("route"))
+	} else {
+		rawRoute := paramRoute[0]
+		if route, err2 := strconv.Atoi(rawRoute); err2 == nil {
+			rctx.Route = route
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("route", rawRoute, "integer"))
+		}
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *RemoveRouteContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveRouteContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RemoveRouteContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RemoveScheduleContainerContext provides the container removeSchedule action context.
+type RemoveScheduleContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID       string
+	Schedule int
+}
+
+// NewRemoveScheduleContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller removeSchedule action.
+func NewRemoveScheduleContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveScheduleContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RemoveScheduleContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramSchedule := req.Params["schedule"]
+	if len(paramSchedule) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("schedule"))
+	} else {
+		rawSchedule := paramSchedule[0]
+		if schedule, err2 := strconv.Atoi(rawSchedule); err2 == nil {
+			rctx.Schedule = schedule
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("schedule", rawSchedule, "integer"))
+		}
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *RemoveScheduleContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveScheduleContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RemoveScheduleContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RemoveSecretContainerContext provides the container removeSecret action context.
+type RemoveSecretContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Deferred bool
+	ID       string
+	Name     string
+}
+
+// NewRemoveSecretContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller removeSecret action.
+func NewRemoveSecretContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveSecretContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RemoveSecretContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDeferred := req.Params["deferred"]
+	if len(paramDeferred) == 0 {
+		rctx.Deferred = false
+	} else {
+		rawDeferred := paramDeferred[0]
+		if deferred, err2 := strconv.ParseBool(rawDeferred); err2 == nil {
+			rctx.Deferred = deferred
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("deferred", rawDeferred, "boolean"))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramName := req.Params["name"]
+	if len(paramName) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("name"))
+	} else {
+		rawName := paramName[0]
+		rctx.Name = rawName
+	}
+	return &rctx, err
+}
+
+// Accepted sends a HTTP response with status code 202.
+func (ctx *RemoveSecretContainerContext) Accepted() error {
+	ctx.ResponseData.WriteHeader(202)
+	return nil
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *RemoveSecretContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveSecretContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RemoveSecretContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RestartContainerContext provides the container restart action context.
+type RestartContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID      string
+	Timeout int
+}
+
+// NewRestartContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller restart action.
+func NewRestartContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RestartContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RestartContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramTimeout := req.Params["timeout"]
+	if len(paramTimeout) == 0 {
+		rctx.Timeout = 15
+	} else {
+		rawTimeout := paramTimeout[0]
+		if timeout, err2 := strconv.Atoi(rawTimeout); err2 == nil {
+			rctx.Timeout = timeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timeout", rawTimeout, "integer"))
+		}
+		if rctx.Timeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 0, true))
+		}
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *RestartContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RestartContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RestartContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RollbackContainerContext provides the container rollback action context.
+type RollbackContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	DrainPeriod   int
+	HealthTimeout int
+	ID            string
+	Release       *int
+	Strategy      string
+	Timeout       int
+}
+
+// NewRollbackContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller rollback action.
+func NewRollbackContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RollbackContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RollbackContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDrainPeriod := req.Params["drainPeriod"]
+	if len(paramDrainPeriod) == 0 {
+		rctx.DrainPeriod = 10
+	} else {
+		rawDrainPeriod := paramDrainPeriod[0]
+		if drainPeriod, err2 := strconv.Atoi(rawDrainPeriod); err2 == nil {
+			rctx.DrainPeriod = drainPeriod
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("drainPeriod", rawDrainPeriod, "integer"))
+		}
+		if rctx.DrainPeriod < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`drainPeriod`, rctx.DrainPeriod, 0, true))
+		}
+	}
+	paramHealthTimeout := req.Params["healthTimeout"]
+	if len(paramHealthTimeout) == 0 {
+		rctx.HealthTimeout = 60
+	} else {
+		rawHealthTimeout := paramHealthTimeout[0]
+		if healthTimeout, err2 := strconv.Atoi(rawHealthTimeout); err2 == nil {
+			rctx.HealthTimeout = healthTimeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("healthTimeout", rawHealthTimeout, "integer"))
+		}
+		if rctx.HealthTimeout < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`healthTimeout`, rctx.HealthTimeout, 1, true))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramRelease := req.Params["release"]
+	if len(paramRelease) > 0 {
+		rawRelease := paramRelease[0]
+		if release, err2 := strconv.Atoi(rawRelease); err2 == nil {
+			tmp39 := release
+			tmp38 := &tmp39
+			rctx.Release = tmp38
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("release", rawRelease, "integer"))
+		}
+	}
+	paramStrategy := req.Params["strategy"]
+	if len(paramStrategy) == 0 {
+		rctx.Strategy = "recreate"
+	} else {
+		rawStrategy := paramStrategy[0]
+		rctx.Strategy = rawStrategy
+		if !(rctx.Strategy == "recreate" || rctx.Strategy == "blueGreen") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`strategy`, rctx.Strategy, []interface{}{"recreate", "blueGreen"}))
+		}
+	}
+	paramTimeout := req.Params["timeout"]
+	if len(paramTimeout) == 0 {
+		rctx.Timeout = 15
+	} else {
+		rawTimeout := paramTimeout[0]
+		if timeout, err2 := strconv.Atoi(rawTimeout); err2 == nil {
+			rctx.Timeout = timeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timeout", rawTimeout, "integer"))
+		}
+		if rctx.Timeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 0, true))
+		}
+	}
+	return &rctx, err
+}
+
+// Accepted sends a HTTP response with status code 202.
+func (ctx *RollbackContainerContext) Accepted() error {
+	ctx.ResponseData.WriteHeader(202)
+	return nil
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RollbackContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RollbackContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RollbackContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RunContainerContext provides the container run action context.
+type RunContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Command    []string
+	Entrypoint []string
+	Env        []string
+	Image      string
+	Timeout    int
+	WorkingDir *string
+}
+
+// NewRunContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller run action.
+func NewRunContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RunContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RunContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramCommand := req.Params["command"]
+	if len(paramCommand) > 0 {
+		params := paramCommand
+		rctx.Command = params
+	}
+	paramEntrypoint := req.Params["entrypoint"]
+	if len(paramEntrypoint) > 0 {
+		params := paramEntrypoint
+		rctx.Entrypoint = params
+	}
+	paramEnv := req.Params["env"]
+	if len(paramEnv) > 0 {
+		params := paramEnv
+		rctx.Env = params
+	}
+	paramImage := req.Params["image"]
+	if len(paramImage) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("image"))
+	} else {
+		rawImage := paramImage[0]
+		rctx.Image = rawImage
+	}
+	paramTimeout := req.Params["timeout"]
+	if len(paramTimeout) == 0 {
+		rctx.Timeout = 600
+	} else {
+		rawTimeout := paramTimeout[0]
+		if timeout, err2 := strconv.Atoi(rawTimeout); err2 == nil {
+			rctx.Timeout = timeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timeout", rawTimeout, "integer"))
+		}
+		if rctx.Timeout < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 1, true))
+		}
+		if rctx.Timeout > 3600 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 3600, false))
+		}
+	}
+	paramWorkingDir := req.Params["workingDir"]
+	if len(paramWorkingDir) > 0 {
+		rawWorkingDir := paramWorkingDir[0]
+		rctx.WorkingDir = &rawWorkingDir
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *RunContainerContext) OK(r *GoaContainerRunResult) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.run.result+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RunContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RunContainerContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RunContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RunStreamContainerContext provides the container runStream action context.
+type RunStreamContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Command    []string
+	Entrypoint []string
+	Env        []string
+	Image      string
+	Timeout    int
+	WorkingDir *string
+}
+
+// NewRunStreamContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller runStream action.
+func NewRunStreamContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RunStreamContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RunStreamContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramCommand := req.Params["command"]
+	if len(paramCommand) > 0 {
+		params := paramCommand
+		rctx.Command = params
+	}
+	paramEntrypoint := req.Params["entrypoint"]
+	if len(paramEntrypoint) > 0 {
+		params := paramEntrypoint
+		rctx.Entrypoint = params
+	}
+	paramEnv := req.Params["env"]
+	if len(paramEnv) > 0 {
+		params := paramEnv
+		rctx.Env = params
+	}
+	paramImage := req.Params["image"]
+	if len(paramImage) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("image"))
+	} else {
+		rawImage := paramImage[0]
+		rctx.Image = rawImage
+	}
+	paramTimeout := req.Params["timeout"]
+	if len(paramTimeout) == 0 {
+		rctx.Timeout = 600
+	} else {
+		rawTimeout := paramTimeout[0]
+		if timeout, err2 := strconv.Atoi(rawTimeout); err2 == nil {
+			rctx.Timeout = timeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timeout", rawTimeout, "integer"))
+		}
+		if rctx.Timeout < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 1, true))
+		}
+		if rctx.Timeout > 3600 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 3600, false))
+		}
+	}
+	paramWorkingDir := req.Params["workingDir"]
+	if len(paramWorkingDir) > 0 {
+		rawWorkingDir := paramWorkingDir[0]
+		rctx.WorkingDir = &rawWorkingDir
+	}
+	return &rctx, err
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RunStreamContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RunStreamContainerContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RunStreamContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ScaleContainerContext provides the container scale action context.
+type ScaleContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID       string
+	Replicas int
+	Timeout  int
+}
+
+// NewScaleContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller scale action.
+func NewScaleContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ScaleContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ScaleContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramReplicas := req.Params["replicas"]
+	if len(paramReplicas) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("replicas"))
+	} else {
+		rawReplicas := paramReplicas[0]
+		if replicas, err2 := strconv.Atoi(rawReplicas); err2 == nil {
+			rctx.Replicas = replicas
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("replicas", rawReplicas, "integer"))
+		}
+		if rctx.Replicas < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`replicas`, rctx.Replicas, 1, true))
+		}
+	}
+	paramTimeout := req.Params["timeout"]
+	if len(paramTimeout) == 0 {
+		rctx.Timeout = 15
+	} else {
+		rawTimeout := paramTimeout[0]
+		if timeout, err2 := strconv.Atoi(rawTimeout); err2 == nil {
+			rctx.Timeout = timeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timeout", rawTimeout, "integer"))
+		}
+		if rctx.Timeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 0, true))
+		}
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *ScaleContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *ScaleContainerContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ScaleContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ScaleContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ScheduleLogsContainerContext provides the container scheduleLogs action context.
+type ScheduleLogsContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID    string
+	Limit int
+}
+
+// NewScheduleLogsContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller scheduleLogs action.
+func NewScheduleLogsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*ScheduleLogsContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ScheduleLogsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramLimit := req.Params["limit"]
+	if len(paramLimit) == 0 {
+		rctx.Limit = 50
+	} else {
+		rawLimit := paramLimit[0]
+		if limit, err2 := strconv.Atoi(rawLimit); err2 == nil {
+			rctx.Limit = limit
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("limit", rawLimit, "integer"))
+		}
+		if rctx.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`limit`, rctx.Limit, 1, true))
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ScheduleLogsContainerContext) OK(r GoaContainerScheduleLogCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.schedule.log+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerScheduleLogCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ScheduleLogsContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ScheduleLogsContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SetConfigContainerContext provides the container setConfig action context.
+type SetConfigContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID      string
+	Payload *ContainerConfig
+}
+
+// NewSetConfigContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller setConfig action.
+func NewSetConfigContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*SetConfigContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SetConfigContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *SetConfigContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *SetConfigContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *SetConfigContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SetConfigContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SetEnvContainerContext provides the container setEnv action context.
+type SetEnvContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Deferred bool
+	ID       string
+	Payload  SetEnvContainerPayload
+}
+
+// NewSetEnvContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller setEnv action.
+func NewSetEnvContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*SetEnvContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SetEnvContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDeferred := req.Params["deferred"]
+	if len(paramDeferred) == 0 {
+		rctx.Deferred = false
+	} else {
+		rawDeferred := paramDeferred[0]
+		if deferred, err2 := strconv.ParseBool(rawDeferred); err2 == nil {
+			rctx.Deferred = deferred
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("deferred", rawDeferred, "boolean"))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// SetEnvContainerPayload is the container setEnv action payload.
+type SetEnvContainerPayload []*ContainerEnv
+
+// Validate runs the validation rules defined in the design.
+func (payload SetEnvContainerPayload) Validate() (err error) {
+	for _, e := range payload {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// Accepted sends a HTTP response with status code 202.
+func (ctx *SetEnvContainerContext) Accepted() error {
+	ctx.ResponseData.WriteHeader(202)
+	return nil
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *SetEnvContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *SetEnvContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SetEnvContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SetLimitsContainerContext provides the container setLimits action context.
+type SetLimitsContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	CPU    *int
+	ID     string
+	Memory *string
+	Pids   *int
+}
+
+// NewSetLimitsContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller setLimits action.
+func NewSetLimitsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*SetLimitsContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SetLimitsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramCPU := req.Params["cpu"]
+	if len(paramCPU) > 0 {
+		rawCPU := paramCPU[0]
+		if cpu, err2 := strconv.Atoi(rawCPU); err2 == nil {
+			tmp47 := cpu
+			tmp46 := &tmp47
+			rctx.CPU = tmp46
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("cpu", rawCPU, "integer"))
+		}
+		if rctx.CPU != nil {
+			if *rctx.CPU < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`cpu`, *rctx.CPU, 0, true))
+			}
+		}
+		if rctx.CPU != nil {
+			if *rctx.CPU > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`cpu`, *rctx.CPU, 100, false))
+			}
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramMemory := req.Params["memory"]
+	if len(paramMemory) > 0 {
+		rawMemory := paramMemory[0]
+		rctx.Memory = &rawMemory
+	}
+	paramPids := req.Params["pids"]
+	if len(paramPids) > 0 {
+		rawPids := paramPids[0]
+		if pids, err2 := strconv.Atoi(rawPids); err2 == nil {
+			tmp49 := pids
+			tmp48 := &tmp49
+			rctx.Pids = tmp48
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("pids", rawPids, "integer"))
+		}
+		if rctx.Pids != nil {
+			if *rctx.Pids < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError(`pids`, *rctx.Pids, 0, true))
+			}
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *SetLimitsContainerContext) OK(r *GoaContainerLimits) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.limits+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *SetLimitsContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *SetLimitsContainerContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *SetLimitsContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SetLimitsContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// StartContainerContext provides the container start action context.
+type StartContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewStartContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller start action.
+func NewStartContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*StartContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := StartContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *StartContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *StartContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *StartContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// StatsContainerContext provides the container stats action context.
+type StatsContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewStatsContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller stats action.
+func NewStatsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*StatsContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := StatsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *StatsContainerContext) OK(r GoaContainerStatsCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.stats+json; type=collection")
+	}
+	if r == nil {
+		r = GoaContainerStatsCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *StatsContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *StatsContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// StatsStreamContainerContext provides the container statsStream action context.
+type StatsStreamContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewStatsStreamContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller statsStream action.
+func NewStatsStreamContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*StatsStreamContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := StatsStreamContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *StatsStreamContainerContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *StatsStreamContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// StopContainerContext provides the container stop action context.
+type StopContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID      string
+	Timeout int
+}
+
+// NewStopContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller stop action.
+func NewStopContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*StopContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := StopContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	paramTimeout := req.Params["timeout"]
+	if len(paramTimeout) == 0 {
+		rctx.Timeout = 15
+	} else {
+		rawTimeout := paramTimeout[0]
+		if timeout, err2 := strconv.Atoi(rawTimeout); err2 == nil {
+			rctx.Timeout = timeout
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timeout", rawTimeout, "integer"))
+		}
+		if rctx.Timeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`timeout`, rctx.Timeout, 0, true))
+		}
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *StopContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *StopContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *StopContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// UnpauseContainerContext provides the container unpause action context.
+type UnpauseContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID string
+}
+
+// NewUnpauseContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller unpause action.
+func NewUnpauseContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*UnpauseContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := UnpauseContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *UnpauseContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *UnpauseContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *UnpauseContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// UploadContainerContext provides the container upload action context.
+type UploadContainerContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID      string
+	Payload *UploadPayload
+}
+
+// NewUploadContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller upload action.
+func NewUploadContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*UploadContainerContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := UploadContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = rawID
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *UploadContainerContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *UploadContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	if r == nil {
-		r = GoaContainerListEachCollection{}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *UploadContainerContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// RequestEntityTooLarge sends a HTTP response with status code 413.
+func (ctx *UploadContainerContext) RequestEntityTooLarge() error {
+	ctx.ResponseData.WriteHeader(413)
+	return nil
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ListContainerContext) InternalServerError(r error) error {
+func (ctx *UploadContainerContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LogsContainerContext provides the container logs action context.
-type LogsContainerContext struct {
+// VerifyDomainContainerContext provides the container verifyDomain action context.
+type VerifyDomainContainerContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Follow     bool
-	ID         string
-	Since      *time.Time
-	Stderr     bool
-	Stdout     bool
-	Tail       string
-	Timestamps bool
-	Until      *time.Time
+	Domain string
+	ID     string
 }
 
-// NewLogsContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller logs action.
-func NewLogsContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*LogsContainerContext, error) {
+// NewVerifyDomainContainerContext parses the incoming request URL and body, performs validations and creates the
+// context used by the container controller verifyDomain action.
+func NewVerifyDomainContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*VerifyDomainContainerContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LogsContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramFollow := req.Params["follow"]
-	if len(paramFollow) == 0 {
-		rctx.Follow = false
+	rctx := VerifyDomainContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDomain := req.Params["domain"]
+	if len(paramDomain) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("domain"))
 	} else {
-		rawFollow := paramFollow[0]
-		if follow, err2 := strconv.ParseBool(rawFollow); err2 == nil {
-			rctx.Follow = follow
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("follow", rawFollow, "boolean"))
-		}
+		rawDomain := paramDomain[0]
+		rctx.Domain = rawDomain
 	}
 	paramID := req.Params["id"]
 	if len(paramID) > 0 {
 		rawID := paramID[0]
 		rctx.ID = rawID
 	}
-	paramSince := req.Params["since"]
-	if len(paramSince) > 0 {
-		rawSince := paramSince[0]
-		if since, err2 := time.Parse(time.RFC3339, rawSince); err2 == nil {
-			tmp4 := &since
-			rctx.Since = tmp4
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("since", rawSince, "datetime"))
-		}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *VerifyDomainContainerContext) OK(r *GoaContainerDomain) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.container.domain+json")
 	}
-	paramStderr := req.Params["stderr"]
-	if len(paramStderr) == 0 {
-		rctx.Stderr = false
-	} else {
-		rawStderr := paramStderr[0]
-		if stderr, err2 := strconv.ParseBool(rawStderr); err2 == nil {
-			rctx.Stderr = stderr
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("stderr", rawStderr, "boolean"))
-		}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *VerifyDomainContainerContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	paramStdout := req.Params["stdout"]
-	if len(paramStdout) == 0 {
-		rctx.Stdout = false
-	} else {
-		rawStdout := paramStdout[0]
-		if stdout, err2 := strconv.ParseBool(rawStdout); err2 == nil {
-			rctx.Stdout = stdout
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("stdout", rawStdout, "boolean"))
-		}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *VerifyDomainContainerContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// Conflict sends a HTTP response with status code 409.
+func (ctx *VerifyDomainContainerContext) Conflict(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	paramTail := req.Params["tail"]
-	if len(paramTail) == 0 {
-		rctx.Tail = "all"
-	} else {
-		rawTail := paramTail[0]
-		rctx.Tail = rawTail
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *VerifyDomainContainerContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	paramTimestamps := req.Params["timestamps"]
-	if len(paramTimestamps) == 0 {
-		rctx.Timestamps = false
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// CreateJobContext provides the job create action context.
+type CreateJobContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Command   []string
+	Container *string
+	Env       []string
+	Image     *string
+	Name      string
+	Schedule  string
+}
+
+// NewCreateJobContext parses the incoming request URL and body, performs validations and creates the
+// context used by the job controller create action.
+func NewCreateJobContext(ctx context.Context, r *http.Request, service *goa.Service) (*CreateJobContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := CreateJobContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramCommand := req.Params["command"]
+	if len(paramCommand) > 0 {
+		params := paramCommand
+		rctx.Command = params
+	}
+	paramContainer := req.Params["container"]
+	if len(paramContainer) > 0 {
+		rawContainer := paramContainer[0]
+		rctx.Container = &rawContainer
+	}
+	paramEnv := req.Params["env"]
+	if len(paramEnv) > 0 {
+		params := paramEnv
+		rctx.Env = params
+	}
+	paramImage := req.Params["image"]
+	if len(paramImage) > 0 {
+		rawImage := paramImage[0]
+		rctx.Image = &rawImage
+	}
+	paramName := req.Params["name"]
+	if len(paramName) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("name"))
 	} else {
-		rawTimestamps := paramTimestamps[0]
-		if timestamps, err2 := strconv.ParseBool(rawTimestamps); err2 == nil {
-			rctx.Timestamps = timestamps
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("timestamps", rawTimestamps, "boolean"))
+		rawName := paramName[0]
+		rctx.Name = rawName
+		if ok := goa.ValidatePattern(`^[a-zA-Z0-9_]+$`, rctx.Name); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`name`, rctx.Name, `^[a-zA-Z0-9_]+$`))
 		}
-	}
-	paramUntil := req.Params["until"]
-	if len(paramUntil) > 0 {
-		rawUntil := paramUntil[0]
-		if until, err2 := time.Parse(time.RFC3339, rawUntil); err2 == nil {
-			tmp8 := &until
-			rctx.Until = tmp8
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("until", rawUntil, "datetime"))
+		if utf8.RuneCountInString(rctx.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`name`, rctx.Name, utf8.RuneCountInString(rctx.Name), 1, true))
+		}
+		if utf8.RuneCountInString(rctx.Name) > 64 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`name`, rctx.Name, utf8.RuneCountInString(rctx.Name), 64, false))
 		}
 	}
+	paramSchedule := req.Params["schedule"]
+	if len(paramSchedule) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("schedule"))
+	} else {
+		rawSchedule := paramSchedule[0]
+		rctx.Schedule = rawSchedule
+	}
 	return &rctx, err
 }
 
+// OK sends a HTTP response with status code 200.
+func (ctx *CreateJobContext) OK(r *GoaJob) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.job+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *CreateJobContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
 // NotFound sends a HTTP response with status code 404.
-func (ctx *LogsContainerContext) NotFound(r error) error {
+func (ctx *CreateJobContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// Conflict sends a HTTP response with status code 409.
+func (ctx *CreateJobContext) Conflict(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *LogsContainerContext) InternalServerError(r error) error {
+func (ctx *CreateJobContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RemoveContainerContext provides the container remove action context.
-type RemoveContainerContext struct {
+// InspectJobContext provides the job inspect action context.
+type InspectJobContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Force bool
-	ID    string
+	ID string
 }
 
-// NewRemoveContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller remove action.
-func NewRemoveContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveContainerContext, error) {
+// NewInspectJobContext parses the incoming request URL and body, performs validations and creates the
+// context used by the job controller inspect action.
+func NewInspectJobContext(ctx context.Context, r *http.Request, service *goa.Service) (*InspectJobContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RemoveContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramForce := req.Params["force"]
-	if len(paramForce) == 0 {
-		rctx.Force = false
-	} else {
-		rawForce := paramForce[0]
-		if force, err2 := strconv.ParseBool(rawForce); err2 == nil {
-			rctx.Force = force
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("force", rawForce, "boolean"))
-		}
-	}
+	rctx := InspectJobContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramID := req.Params["id"]
 	if len(paramID) > 0 {
 		rawID := paramID[0]
@@ -542,95 +3563,83 @@ func NewRemoveContainerContext(ctx context.Context, r *http.Request, service *go
 	return &rctx, err
 }
 
-// NoContent sends a HTTP response with status code 204.
-func (ctx *RemoveContainerContext) NoContent() error {
-	ctx.ResponseData.WriteHeader(204)
-	return nil
-}
-
-// NotFound sends a HTTP response with status code 404.
-func (ctx *RemoveContainerContext) NotFound() error {
-	ctx.ResponseData.WriteHeader(404)
-	return nil
-}
-
-// RunningContainer sends a HTTP response with status code 409.
-func (ctx *RemoveContainerContext) RunningContainer() error {
-	ctx.ResponseData.WriteHeader(409)
+// OK sends a HTTP response with status code 200.
+func (ctx *InspectJobContext) OK(r *GoaJob) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.job+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *InspectJobContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
 	return nil
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RemoveContainerContext) InternalServerError(r error) error {
+func (ctx *InspectJobContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// SetConfigContainerContext provides the container setConfig action context.
-type SetConfigContainerContext struct {
+// ListJobContext provides the job list action context.
+type ListJobContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	ID      string
-	Payload *ContainerConfig
 }
 
-// NewSetConfigContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller setConfig action.
-func NewSetConfigContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*SetConfigContainerContext, error) {
+// NewListJobContext parses the incoming request URL and body, performs validations and creates the
+// context used by the job controller list action.
+func NewListJobContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListJobContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := SetConfigContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramID := req.Params["id"]
-	if len(paramID) > 0 {
-		rawID := paramID[0]
-		rctx.ID = rawID
-	}
+	rctx := ListJobContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// NoContent sends a HTTP response with status code 204.
-func (ctx *SetConfigContainerContext) NoContent() error {
-	ctx.ResponseData.WriteHeader(204)
-	return nil
-}
-
-// NotFound sends a HTTP response with status code 404.
-func (ctx *SetConfigContainerContext) NotFound() error {
-	ctx.ResponseData.WriteHeader(404)
-	return nil
+// OK sends a HTTP response with status code 200.
+func (ctx *ListJobContext) OK(r GoaJobCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.job+json; type=collection")
+	}
+	if r == nil {
+		r = GoaJobCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *SetConfigContainerContext) InternalServerError(r error) error {
+func (ctx *ListJobContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// StartContainerContext provides the container start action context.
-type StartContainerContext struct {
+// RemoveJobContext provides the job remove action context.
+type RemoveJobContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 	ID string
 }
 
-// NewStartContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller start action.
-func NewStartContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*StartContainerContext, error) {
+// NewRemoveJobContext parses the incoming request URL and body, performs validations and creates the
+// context used by the job controller remove action.
+func NewRemoveJobContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveJobContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := StartContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RemoveJobContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramID := req.Params["id"]
 	if len(paramID) > 0 {
 		rawID := paramID[0]
@@ -640,42 +3649,42 @@ func NewStartContainerContext(ctx context.Context, r *http.Request, service *goa
 }
 
 // NoContent sends a HTTP response with status code 204.
-func (ctx *StartContainerContext) NoContent() error {
+func (ctx *RemoveJobContext) NoContent() error {
 	ctx.ResponseData.WriteHeader(204)
 	return nil
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *StartContainerContext) NotFound() error {
+func (ctx *RemoveJobContext) NotFound() error {
 	ctx.ResponseData.WriteHeader(404)
 	return nil
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *StartContainerContext) InternalServerError(r error) error {
+func (ctx *RemoveJobContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// StopContainerContext provides the container stop action context.
-type StopContainerContext struct {
+// RunJobContext provides the job run action context.
+type RunJobContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 	ID string
 }
 
-// NewStopContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller stop action.
-func NewStopContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*StopContainerContext, error) {
+// NewRunJobContext parses the incoming request URL and body, performs validations and creates the
+// context used by the job controller run action.
+func NewRunJobContext(ctx context.Context, r *http.Request, service *goa.Service) (*RunJobContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := StopContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RunJobContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramID := req.Params["id"]
 	if len(paramID) > 0 {
 		rawID := paramID[0]
@@ -684,82 +3693,85 @@ func NewStopContainerContext(ctx context.Context, r *http.Request, service *goa.
 	return &rctx, err
 }
 
-// NoContent sends a HTTP response with status code 204.
-func (ctx *StopContainerContext) NoContent() error {
-	ctx.ResponseData.WriteHeader(204)
+// Accepted sends a HTTP response with status code 202.
+func (ctx *RunJobContext) Accepted() error {
+	ctx.ResponseData.WriteHeader(202)
 	return nil
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *StopContainerContext) NotFound() error {
+func (ctx *RunJobContext) NotFound() error {
 	ctx.ResponseData.WriteHeader(404)
 	return nil
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *StopContainerContext) InternalServerError(r error) error {
+func (ctx *RunJobContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// UploadContainerContext provides the container upload action context.
-type UploadContainerContext struct {
+// RunsJobContext provides the job runs action context.
+type RunsJobContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	ID      string
-	Payload *UploadPayload
+	ID    string
+	Limit int
 }
 
-// NewUploadContainerContext parses the incoming request URL and body, performs validations and creates the
-// context used by the container controller upload action.
-func NewUploadContainerContext(ctx context.Context, r *http.Request, service *goa.Service) (*UploadContainerContext, error) {
+// NewRunsJobContext parses the incoming request URL and body, performs validations and creates the
+// context used by the job controller runs action.
+func NewRunsJobContext(ctx context.Context, r *http.Request, service *goa.Service) (*RunsJobContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := UploadContainerContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RunsJobContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramID := req.Params["id"]
 	if len(paramID) > 0 {
 		rawID := paramID[0]
 		rctx.ID = rawID
 	}
+	paramLimit := req.Params["limit"]
+	if len(paramLimit) == 0 {
+		rctx.Limit = 20
+	} else {
+		rawLimit := paramLimit[0]
+		if limit, err2 := strconv.Atoi(rawLimit); err2 == nil {
+			rctx.Limit = limit
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("limit", rawLimit, "integer"))
+		}
+		if rctx.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`limit`, rctx.Limit, 1, true))
+		}
+	}
 	return &rctx, err
 }
 
-// NoContent sends a HTTP response with status code 204.
-func (ctx *UploadContainerContext) NoContent() error {
-	ctx.ResponseData.WriteHeader(204)
-	return nil
-}
-
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *UploadContainerContext) BadRequest(r error) error {
+// OK sends a HTTP response with status code 200.
+func (ctx *RunsJobContext) OK(r GoaJobRunCollection) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.job.run+json; type=collection")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
-}
-
-// NotFound sends a HTTP response with status code 404.
-func (ctx *UploadContainerContext) NotFound(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	if r == nil {
+		r = GoaJobRunCollection{}
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// RequestEntityTooLarge sends a HTTP response with status code 413.
-func (ctx *UploadContainerContext) RequestEntityTooLarge() error {
-	ctx.ResponseData.WriteHeader(413)
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RunsJobContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
 	return nil
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *UploadContainerContext) InternalServerError(r error) error {
+func (ctx *RunsJobContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -876,6 +3888,41 @@ func (ctx *GetDefaultShellUserContext) InternalServerError(r error) error {
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
+// GetQuotaUserContext provides the user getQuota action context.
+type GetQuotaUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewGetQuotaUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller getQuota action.
+func NewGetQuotaUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetQuotaUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetQuotaUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetQuotaUserContext) OK(r *GoaUserQuota) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.user.quota+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetQuotaUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
 // ListAuthorizedKeysUserContext provides the user listAuthorizedKeys action context.
 type ListAuthorizedKeysUserContext struct {
 	context.Context
@@ -920,6 +3967,44 @@ func (ctx *ListAuthorizedKeysUserContext) InternalServerError(r error) error {
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
+// ListSecretsUserContext provides the user listSecrets action context.
+type ListSecretsUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewListSecretsUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller listSecrets action.
+func NewListSecretsUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListSecretsUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ListSecretsUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ListSecretsUserContext) OK(r GoaUserSecretCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "vpn.application/goa.user.secret+json; type=collection")
+	}
+	if r == nil {
+		r = GoaUserSecretCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ListSecretsUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
 // RemoveAuthorizedKeysUserContext provides the user removeAuthorizedKeys action context.
 type RemoveAuthorizedKeysUserContext struct {
 	context.Context
@@ -967,6 +4052,61 @@ func (ctx *RemoveAuthorizedKeysUserContext) InternalServerError(r error) error {
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
+// RemoveSecretUserContext provides the user removeSecret action context.
+type RemoveSecretUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Name string
+}
+
+// NewRemoveSecretUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller removeSecret action.
+func NewRemoveSecretUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveSecretUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RemoveSecretUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramName := req.Params["name"]
+	if len(paramName) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("name"))
+	} else {
+		rawName := paramName[0]
+		rctx.Name = rawName
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *RemoveSecretUserContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveSecretUserContext) NotFound() error {
+	ctx.ResponseData.WriteHeader(404)
+	return nil
+}
+
+// Conflict sends a HTTP response with status code 409.
+func (ctx *RemoveSecretUserContext) Conflict(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RemoveSecretUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
 // SetAuthorizedKeysUserContext provides the user setAuthorizedKeys action context.
 type SetAuthorizedKeysUserContext struct {
 	context.Context
@@ -1056,3 +4196,93 @@ func (ctx *SetDefaultShellUserContext) InternalServerError(r error) error {
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
+
+// SetQuotaUserContext provides the user setQuota action context.
+type SetQuotaUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	UID     string
+	Payload *UserQuota
+}
+
+// NewSetQuotaUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller setQuota action.
+func NewSetQuotaUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*SetQuotaUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SetQuotaUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUID := req.Params["uid"]
+	if len(paramUID) > 0 {
+		rawUID := paramUID[0]
+		rctx.UID = rawUID
+	}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *SetQuotaUserContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *SetQuotaUserContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *SetQuotaUserContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SetQuotaUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SetSecretUserContext provides the user setSecret action context.
+type SetSecretUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *UserSecret
+}
+
+// NewSetSecretUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller setSecret action.
+func NewSetSecretUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*SetSecretUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SetSecretUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// NoContent sends a HTTP response with status code 204.
+func (ctx *SetSecretUserContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SetSecretUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
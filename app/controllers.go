@@ -36,18 +36,50 @@ func initService(service *goa.Service) {
 // ContainerController is the controller interface for the Container actions.
 type ContainerController interface {
 	goa.Muxer
+	AddDomain(*AddDomainContainerContext) error
+	AddRoute(*AddRouteContainerContext) error
+	AddSchedule(*AddScheduleContainerContext) error
+	AddSecret(*AddSecretContainerContext) error
 	Create(*CreateContainerContext) error
 	Download(*DownloadContainerContext) error
+	Events(*EventsContainerContext) error
 	Exec(*ExecContainerContext) error
+	Extend(*ExtendContainerContext) error
 	GetConfig(*GetConfigContainerContext) error
+	GetEnv(*GetEnvContainerContext) error
 	Inspect(*InspectContainerContext) error
 	List(*ListContainerContext) error
+	ListDomains(*ListDomainsContainerContext) error
+	ListRoutes(*ListRoutesContainerContext) error
+	ListSchedules(*ListSchedulesContainerContext) error
+	ListSecrets(*ListSecretsContainerContext) error
 	Logs(*LogsContainerContext) error
+	Metrics(*MetricsContainerContext) error
+	Pause(*PauseContainerContext) error
+	Redeploy(*RedeployContainerContext) error
+	Releases(*ReleasesContainerContext) error
 	Remove(*RemoveContainerContext) error
+	RemoveDomain(*RemoveDomainContainerContext) error
+	RemoveEnv(*RemoveEnvContainerContext) error
+	RemoveRoute(*RemoveRouteContainerContext) error
+	RemoveSchedule(*RemoveScheduleContainerContext) error
+	RemoveSecret(*RemoveSecretContainerContext) error
+	Restart(*RestartContainerContext) error
+	Rollback(*RollbackContainerContext) error
+	Run(*RunContainerContext) error
+	RunStream(*RunStreamContainerContext) error
+	Scale(*ScaleContainerContext) error
+	ScheduleLogs(*ScheduleLogsContainerContext) error
 	SetConfig(*SetConfigContainerContext) error
+	SetEnv(*SetEnvContainerContext) error
+	SetLimits(*SetLimitsContainerContext) error
 	Start(*StartContainerContext) error
+	Stats(*StatsContainerContext) error
+	StatsStream(*StatsStreamContainerContext) error
 	Stop(*StopContainerContext) error
+	Unpause(*UnpauseContainerContext) error
 	Upload(*UploadContainerContext) error
+	VerifyDomain(*VerifyDomainContainerContext) error
 }
 
 // MountContainerController "mounts" a Container resource controller on the given service.
@@ -55,6 +87,70 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 	initService(service)
 	var h goa.Handler
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewAddDomainContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.AddDomain(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("PUT", "/api/v2/container/:id/domains", ctrl.MuxHandler("addDomain", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "AddDomain", "route", "PUT /api/v2/container/:id/domains", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewAddRouteContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.AddRoute(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("PUT", "/api/v2/container/:id/routes", ctrl.MuxHandler("addRoute", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "AddRoute", "route", "PUT /api/v2/container/:id/routes", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewAddScheduleContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.AddSchedule(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("PUT", "/api/v2/container/:id/schedules", ctrl.MuxHandler("addSchedule", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "AddSchedule", "route", "PUT /api/v2/container/:id/schedules", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewAddSecretContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.AddSecret(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("PUT", "/api/v2/container/:id/secrets", ctrl.MuxHandler("addSecret", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "AddSecret", "route", "PUT /api/v2/container/:id/secrets", "security", "jwt")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -89,6 +185,22 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 	service.Mux.Handle("HEAD", "/api/v2/container/download", ctrl.MuxHandler("download", h, nil))
 	service.LogInfo("mount", "ctrl", "Container", "action", "Download", "route", "HEAD /api/v2/container/download", "security", "jwt")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewEventsContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Events(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/events", ctrl.MuxHandler("events", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Events", "route", "GET /api/v2/container/:id/events", "security", "jwt")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -105,6 +217,22 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 	service.Mux.Handle("GET", "/api/v2/container/:id/exec", ctrl.MuxHandler("exec", h, nil))
 	service.LogInfo("mount", "ctrl", "Container", "action", "Exec", "route", "GET /api/v2/container/:id/exec", "security", "jwt")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewExtendContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Extend(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/extend", ctrl.MuxHandler("extend", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Extend", "route", "GET /api/v2/container/:id/extend", "security", "jwt")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -127,15 +255,427 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewInspectContainerContext(ctx, req, service)
+		rctx, err := NewGetEnvContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetEnv(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/env", ctrl.MuxHandler("getEnv", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "GetEnv", "route", "GET /api/v2/container/:id/env", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewInspectContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Inspect(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/inspect", ctrl.MuxHandler("inspect", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Inspect", "route", "GET /api/v2/container/:id/inspect", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewListContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.List(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/list", ctrl.MuxHandler("list", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "List", "route", "GET /api/v2/container/list", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewListDomainsContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ListDomains(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/domains", ctrl.MuxHandler("listDomains", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "ListDomains", "route", "GET /api/v2/container/:id/domains", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewListRoutesContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ListRoutes(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/routes", ctrl.MuxHandler("listRoutes", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "ListRoutes", "route", "GET /api/v2/container/:id/routes", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewListSchedulesContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ListSchedules(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/schedules", ctrl.MuxHandler("listSchedules", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "ListSchedules", "route", "GET /api/v2/container/:id/schedules", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewListSecretsContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ListSecrets(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/secrets", ctrl.MuxHandler("listSecrets", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "ListSecrets", "route", "GET /api/v2/container/:id/secrets", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewLogsContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Logs(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/logs", ctrl.MuxHandler("logs", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Logs", "route", "GET /api/v2/container/:id/logs", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewMetricsContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Metrics(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/metrics", ctrl.MuxHandler("metrics", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Metrics", "route", "GET /api/v2/container/:id/metrics", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewPauseContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Pause(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/pause", ctrl.MuxHandler("pause", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Pause", "route", "GET /api/v2/container/:id/pause", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRedeployContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Redeploy(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/redeploy", ctrl.MuxHandler("redeploy", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Redeploy", "route", "GET /api/v2/container/:id/redeploy", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewReleasesContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Releases(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/releases", ctrl.MuxHandler("releases", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Releases", "route", "GET /api/v2/container/:id/releases", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Remove(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/remove", ctrl.MuxHandler("remove", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Remove", "route", "GET /api/v2/container/:id/remove", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveDomainContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RemoveDomain(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("DELETE", "/api/v2/container/:id/domains", ctrl.MuxHandler("removeDomain", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "RemoveDomain", "route", "DELETE /api/v2/container/:id/domains", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveEnvContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RemoveEnv(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("DELETE", "/api/v2/container/:id/env", ctrl.MuxHandler("removeEnv", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "RemoveEnv", "route", "DELETE /api/v2/container/:id/env", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveRouteContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RemoveRoute(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("DELETE", "/api/v2/container/:id/routes", ctrl.MuxHandler("removeRoute", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "RemoveRoute", "route", "DELETE /api/v2/container/:id/routes", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveScheduleContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RemoveSchedule(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("DELETE", "/api/v2/container/:id/schedules", ctrl.MuxHandler("removeSchedule", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "RemoveSchedule", "route", "DELETE /api/v2/container/:id/schedules", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveSecretContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RemoveSecret(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("DELETE", "/api/v2/container/:id/secrets", ctrl.MuxHandler("removeSecret", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "RemoveSecret", "route", "DELETE /api/v2/container/:id/secrets", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRestartContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Restart(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/restart", ctrl.MuxHandler("restart", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Restart", "route", "GET /api/v2/container/:id/restart", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRollbackContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Rollback(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/rollback", ctrl.MuxHandler("rollback", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Rollback", "route", "GET /api/v2/container/:id/rollback", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRunContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Run(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("POST", "/api/v2/container/run", ctrl.MuxHandler("run", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Run", "route", "POST /api/v2/container/run", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRunStreamContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RunStream(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/run/stream", ctrl.MuxHandler("runStream", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "RunStream", "route", "GET /api/v2/container/run/stream", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewScaleContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Scale(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/scale", ctrl.MuxHandler("scale", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Scale", "route", "GET /api/v2/container/:id/scale", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewScheduleLogsContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ScheduleLogs(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/container/:id/schedules/logs", ctrl.MuxHandler("scheduleLogs", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "ScheduleLogs", "route", "GET /api/v2/container/:id/schedules/logs", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSetConfigContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*ContainerConfig)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.SetConfig(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("POST", "/api/v2/container/:id/config", ctrl.MuxHandler("setConfig", h, unmarshalSetConfigContainerPayload))
+	service.LogInfo("mount", "ctrl", "Container", "action", "SetConfig", "route", "POST /api/v2/container/:id/config", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSetEnvContainerContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Inspect(rctx)
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(SetEnvContainerPayload)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.SetEnv(rctx)
 	}
 	h = handleSecurity("jwt", h)
-	service.Mux.Handle("GET", "/api/v2/container/:id/inspect", ctrl.MuxHandler("inspect", h, nil))
-	service.LogInfo("mount", "ctrl", "Container", "action", "Inspect", "route", "GET /api/v2/container/:id/inspect", "security", "jwt")
+	service.Mux.Handle("PUT", "/api/v2/container/:id/env", ctrl.MuxHandler("setEnv", h, unmarshalSetEnvContainerPayload))
+	service.LogInfo("mount", "ctrl", "Container", "action", "SetEnv", "route", "PUT /api/v2/container/:id/env", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -143,15 +683,15 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewListContainerContext(ctx, req, service)
+		rctx, err := NewSetLimitsContainerContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.List(rctx)
+		return ctrl.SetLimits(rctx)
 	}
 	h = handleSecurity("jwt", h)
-	service.Mux.Handle("GET", "/api/v2/container/list", ctrl.MuxHandler("list", h, nil))
-	service.LogInfo("mount", "ctrl", "Container", "action", "List", "route", "GET /api/v2/container/list", "security", "jwt")
+	service.Mux.Handle("PUT", "/api/v2/container/:id/limits", ctrl.MuxHandler("setLimits", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "SetLimits", "route", "PUT /api/v2/container/:id/limits", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -159,15 +699,15 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewLogsContainerContext(ctx, req, service)
+		rctx, err := NewStartContainerContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Logs(rctx)
+		return ctrl.Start(rctx)
 	}
 	h = handleSecurity("jwt", h)
-	service.Mux.Handle("GET", "/api/v2/container/:id/logs", ctrl.MuxHandler("logs", h, nil))
-	service.LogInfo("mount", "ctrl", "Container", "action", "Logs", "route", "GET /api/v2/container/:id/logs", "security", "jwt")
+	service.Mux.Handle("GET", "/api/v2/container/:id/start", ctrl.MuxHandler("start", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Start", "route", "GET /api/v2/container/:id/start", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -175,15 +715,15 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRemoveContainerContext(ctx, req, service)
+		rctx, err := NewStatsContainerContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Remove(rctx)
+		return ctrl.Stats(rctx)
 	}
 	h = handleSecurity("jwt", h)
-	service.Mux.Handle("GET", "/api/v2/container/:id/remove", ctrl.MuxHandler("remove", h, nil))
-	service.LogInfo("mount", "ctrl", "Container", "action", "Remove", "route", "GET /api/v2/container/:id/remove", "security", "jwt")
+	service.Mux.Handle("GET", "/api/v2/container/:id/stats", ctrl.MuxHandler("stats", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Stats", "route", "GET /api/v2/container/:id/stats", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -191,21 +731,15 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewSetConfigContainerContext(ctx, req, service)
+		rctx, err := NewStatsStreamContainerContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		// Build the payload
-		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*ContainerConfig)
-		} else {
-			return goa.MissingPayloadError()
-		}
-		return ctrl.SetConfig(rctx)
+		return ctrl.StatsStream(rctx)
 	}
 	h = handleSecurity("jwt", h)
-	service.Mux.Handle("POST", "/api/v2/container/:id/config", ctrl.MuxHandler("setConfig", h, unmarshalSetConfigContainerPayload))
-	service.LogInfo("mount", "ctrl", "Container", "action", "SetConfig", "route", "POST /api/v2/container/:id/config", "security", "jwt")
+	service.Mux.Handle("GET", "/api/v2/container/:id/stats/stream", ctrl.MuxHandler("statsStream", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "StatsStream", "route", "GET /api/v2/container/:id/stats/stream", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -213,15 +747,15 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewStartContainerContext(ctx, req, service)
+		rctx, err := NewStopContainerContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Start(rctx)
+		return ctrl.Stop(rctx)
 	}
 	h = handleSecurity("jwt", h)
-	service.Mux.Handle("GET", "/api/v2/container/:id/start", ctrl.MuxHandler("start", h, nil))
-	service.LogInfo("mount", "ctrl", "Container", "action", "Start", "route", "GET /api/v2/container/:id/start", "security", "jwt")
+	service.Mux.Handle("GET", "/api/v2/container/:id/stop", ctrl.MuxHandler("stop", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Stop", "route", "GET /api/v2/container/:id/stop", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -229,15 +763,15 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewStopContainerContext(ctx, req, service)
+		rctx, err := NewUnpauseContainerContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Stop(rctx)
+		return ctrl.Unpause(rctx)
 	}
 	h = handleSecurity("jwt", h)
-	service.Mux.Handle("GET", "/api/v2/container/:id/stop", ctrl.MuxHandler("stop", h, nil))
-	service.LogInfo("mount", "ctrl", "Container", "action", "Stop", "route", "GET /api/v2/container/:id/stop", "security", "jwt")
+	service.Mux.Handle("GET", "/api/v2/container/:id/unpause", ctrl.MuxHandler("unpause", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "Unpause", "route", "GET /api/v2/container/:id/unpause", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -260,6 +794,22 @@ func MountContainerController(service *goa.Service, ctrl ContainerController) {
 	h = handleSecurity("jwt", h)
 	service.Mux.Handle("POST", "/api/v2/container/:id/upload", ctrl.MuxHandler("upload", h, unmarshalUploadContainerPayload))
 	service.LogInfo("mount", "ctrl", "Container", "action", "Upload", "route", "POST /api/v2/container/:id/upload", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewVerifyDomainContainerContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.VerifyDomain(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("POST", "/api/v2/container/:id/domains/verify", ctrl.MuxHandler("verifyDomain", h, nil))
+	service.LogInfo("mount", "ctrl", "Container", "action", "VerifyDomain", "route", "POST /api/v2/container/:id/domains/verify", "security", "jwt")
 }
 
 // unmarshalSetConfigContainerPayload unmarshals the request body into the context request data Payload field.
@@ -268,10 +818,30 @@ func unmarshalSetConfigContainerPayload(ctx context.Context, service *goa.Servic
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
 	goa.ContextRequest(ctx).Payload = payload.Publicize()
 	return nil
 }
 
+// unmarshalSetEnvContainerPayload unmarshals the request body into the context request data Payload field.
+func unmarshalSetEnvContainerPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	var payload SetEnvContainerPayload
+	if err := service.DecodeRequest(req, &payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload
+	return nil
+}
+
 // unmarshalUploadContainerPayload unmarshals the request body into the context request data Payload field.
 func unmarshalUploadContainerPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
 	var err error
@@ -310,6 +880,119 @@ func unmarshalUploadContainerPayload(ctx context.Context, service *goa.Service,
 	return nil
 }
 
+// JobController is the controller interface for the Job actions.
+type JobController interface {
+	goa.Muxer
+	Create(*CreateJobContext) error
+	Inspect(*InspectJobContext) error
+	List(*ListJobContext) error
+	Remove(*RemoveJobContext) error
+	Run(*RunJobContext) error
+	Runs(*RunsJobContext) error
+}
+
+// MountJobController "mounts" a Job resource controller on the given service.
+func MountJobController(service *goa.Service, ctrl JobController) {
+	initService(service)
+	var h goa.Handler
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewCreateJobContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Create(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/job/create", ctrl.MuxHandler("create", h, nil))
+	service.LogInfo("mount", "ctrl", "Job", "action", "Create", "route", "GET /api/v2/job/create", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewInspectJobContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Inspect(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/job/:id/inspect", ctrl.MuxHandler("inspect", h, nil))
+	service.LogInfo("mount", "ctrl", "Job", "action", "Inspect", "route", "GET /api/v2/job/:id/inspect", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewListJobContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.List(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/job/list", ctrl.MuxHandler("list", h, nil))
+	service.LogInfo("mount", "ctrl", "Job", "action", "List", "route", "GET /api/v2/job/list", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveJobContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Remove(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/job/:id/remove", ctrl.MuxHandler("remove", h, nil))
+	service.LogInfo("mount", "ctrl", "Job", "action", "Remove", "route", "GET /api/v2/job/:id/remove", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRunJobContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Run(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/job/:id/run", ctrl.MuxHandler("run", h, nil))
+	service.LogInfo("mount", "ctrl", "Job", "action", "Run", "route", "GET /api/v2/job/:id/run", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRunsJobContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Runs(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/job/:id/runs", ctrl.MuxHandler("runs", h, nil))
+	service.LogInfo("mount", "ctrl", "Job", "action", "Runs", "route", "GET /api/v2/job/:id/runs", "security", "jwt")
+}
+
 // SwaggerController is the controller interface for the Swagger actions.
 type SwaggerController interface {
 	goa.Muxer
@@ -364,10 +1047,15 @@ type UserController interface {
 	AddAuthorizedKeys(*AddAuthorizedKeysUserContext) error
 	GetConfig(*GetConfigUserContext) error
 	GetDefaultShell(*GetDefaultShellUserContext) error
+	GetQuota(*GetQuotaUserContext) error
 	ListAuthorizedKeys(*ListAuthorizedKeysUserContext) error
+	ListSecrets(*ListSecretsUserContext) error
 	RemoveAuthorizedKeys(*RemoveAuthorizedKeysUserContext) error
+	RemoveSecret(*RemoveSecretUserContext) error
 	SetAuthorizedKeys(*SetAuthorizedKeysUserContext) error
 	SetDefaultShell(*SetDefaultShellUserContext) error
+	SetQuota(*SetQuotaUserContext) error
+	SetSecret(*SetSecretUserContext) error
 }
 
 // MountUserController "mounts" a User resource controller on the given service.
@@ -429,6 +1117,22 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 	service.Mux.Handle("GET", "/api/v2/user/config/defaultShell", ctrl.MuxHandler("getDefaultShell", h, nil))
 	service.LogInfo("mount", "ctrl", "User", "action", "GetDefaultShell", "route", "GET /api/v2/user/config/defaultShell", "security", "jwt")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetQuotaUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetQuota(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/user/quota", ctrl.MuxHandler("getQuota", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "GetQuota", "route", "GET /api/v2/user/quota", "security", "jwt")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -445,6 +1149,22 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 	service.Mux.Handle("GET", "/api/v2/user/config/authorizedKeys", ctrl.MuxHandler("listAuthorizedKeys", h, nil))
 	service.LogInfo("mount", "ctrl", "User", "action", "ListAuthorizedKeys", "route", "GET /api/v2/user/config/authorizedKeys", "security", "jwt")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewListSecretsUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ListSecrets(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("GET", "/api/v2/user/secrets", ctrl.MuxHandler("listSecrets", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "ListSecrets", "route", "GET /api/v2/user/secrets", "security", "jwt")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -461,6 +1181,22 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 	service.Mux.Handle("DELETE", "/api/v2/user/config/authorizedKeys", ctrl.MuxHandler("removeAuthorizedKeys", h, nil))
 	service.LogInfo("mount", "ctrl", "User", "action", "RemoveAuthorizedKeys", "route", "DELETE /api/v2/user/config/authorizedKeys", "security", "jwt")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveSecretUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RemoveSecret(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("DELETE", "/api/v2/user/secrets", ctrl.MuxHandler("removeSecret", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "RemoveSecret", "route", "DELETE /api/v2/user/secrets", "security", "jwt")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -498,6 +1234,50 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 	h = handleSecurity("jwt", h)
 	service.Mux.Handle("POST", "/api/v2/user/config/defaultShell", ctrl.MuxHandler("setDefaultShell", h, nil))
 	service.LogInfo("mount", "ctrl", "User", "action", "SetDefaultShell", "route", "POST /api/v2/user/config/defaultShell", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSetQuotaUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*UserQuota)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.SetQuota(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("PUT", "/api/v2/user/quota/:uid", ctrl.MuxHandler("setQuota", h, unmarshalSetQuotaUserPayload))
+	service.LogInfo("mount", "ctrl", "User", "action", "SetQuota", "route", "PUT /api/v2/user/quota/:uid", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSetSecretUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*UserSecret)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.SetSecret(rctx)
+	}
+	h = handleSecurity("jwt", h)
+	service.Mux.Handle("PUT", "/api/v2/user/secrets", ctrl.MuxHandler("setSecret", h, unmarshalSetSecretUserPayload))
+	service.LogInfo("mount", "ctrl", "User", "action", "SetSecret", "route", "PUT /api/v2/user/secrets", "security", "jwt")
 }
 
 // unmarshalAddAuthorizedKeysUserPayload unmarshals the request body into the context request data Payload field.
@@ -529,3 +1309,33 @@ func unmarshalSetAuthorizedKeysUserPayload(ctx context.Context, service *goa.Ser
 	goa.ContextRequest(ctx).Payload = payload
 	return nil
 }
+
+// unmarshalSetQuotaUserPayload unmarshals the request body into the context request data Payload field.
+func unmarshalSetQuotaUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &userQuota{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalSetSecretUserPayload unmarshals the request body into the context request data Payload field.
+func unmarshalSetSecretUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &userSecret{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
@@ -37,6 +37,29 @@ func (mt *GoaContainerCreateResults) Validate() (err error) {
 	return
 }
 
+// GoaContainerInspectHealth media type (default view)
+//
+// Identifier: vnd.application/goa.container.inspect.health+json; view=default
+type GoaContainerInspectHealth struct {
+	// Number of consecutive failures
+	FailingStreak int `form:"failingStreak" json:"failingStreak" yaml:"failingStreak" xml:"failingStreak"`
+	// Output of the last health check
+	LastOutput *string `form:"lastOutput,omitempty" json:"lastOutput,omitempty" yaml:"lastOutput,omitempty" xml:"lastOutput,omitempty"`
+	Status     string  `form:"status" json:"status" yaml:"status" xml:"status"`
+}
+
+// Validate validates the GoaContainerInspectHealth media type instance.
+func (mt *GoaContainerInspectHealth) Validate() (err error) {
+	if mt.Status == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "status"))
+	}
+
+	if !(mt.Status == "starting" || mt.Status == "healthy" || mt.Status == "unhealthy") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, mt.Status, []interface{}{"starting", "healthy", "unhealthy"}))
+	}
+	return
+}
+
 // GoaContainerInspectRaw_state media type (default view)
 //
 // Identifier: vnd.application/goa.container.inspect.raw_state+json; view=default
@@ -44,13 +67,15 @@ type GoaContainerInspectRawState struct {
 	Dead       bool      `form:"dead" json:"dead" yaml:"dead" xml:"dead"`
 	ExitCode   int       `form:"exitCode" json:"exitCode" yaml:"exitCode" xml:"exitCode"`
 	FinishedAt time.Time `form:"finishedAt" json:"finishedAt" yaml:"finishedAt" xml:"finishedAt"`
-	OomKilled  bool      `form:"oomKilled" json:"oomKilled" yaml:"oomKilled" xml:"oomKilled"`
-	Paused     bool      `form:"paused" json:"paused" yaml:"paused" xml:"paused"`
-	Pid        int       `form:"pid" json:"pid" yaml:"pid" xml:"pid"`
-	Restarting bool      `form:"restarting" json:"restarting" yaml:"restarting" xml:"restarting"`
-	Running    bool      `form:"running" json:"running" yaml:"running" xml:"running"`
-	StartedAt  time.Time `form:"startedAt" json:"startedAt" yaml:"startedAt" xml:"startedAt"`
-	Status     string    `form:"status" json:"status" yaml:"status" xml:"status"`
+	// Result of the health check. Missing if the container has no health check
+	Health     *GoaContainerInspectHealth `form:"health,omitempty" json:"health,omitempty" yaml:"health,omitempty" xml:"health,omitempty"`
+	OomKilled  bool                       `form:"oomKilled" json:"oomKilled" yaml:"oomKilled" xml:"oomKilled"`
+	Paused     bool                       `form:"paused" json:"paused" yaml:"paused" xml:"paused"`
+	Pid        int                        `form:"pid" json:"pid" yaml:"pid" xml:"pid"`
+	Restarting bool                       `form:"restarting" json:"restarting" yaml:"restarting" xml:"restarting"`
+	Running    bool                       `form:"running" json:"running" yaml:"running" xml:"running"`
+	StartedAt  time.Time                  `form:"startedAt" json:"startedAt" yaml:"startedAt" xml:"startedAt"`
+	Status     string                     `form:"status" json:"status" yaml:"status" xml:"status"`
 }
 
 // Validate validates the GoaContainerInspectRawState media type instance.
@@ -59,6 +84,11 @@ func (mt *GoaContainerInspectRawState) Validate() (err error) {
 	if mt.Status == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "status"))
 	}
+	if mt.Health != nil {
+		if err2 := mt.Health.Validate(); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if !(mt.Status == "created" || mt.Status == "running" || mt.Status == "paused" || mt.Status == "restarting" || mt.Status == "removing" || mt.Status == "exited" || mt.Status == "dead") {
 		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, mt.Status, []interface{}{"created", "running", "paused", "restarting", "removing", "exited", "dead"}))
 	}
@@ -70,6 +100,69 @@ func (mt *GoaContainerInspectRawState) Validate() (err error) {
 // Identifier: vpn.application/goa.container.config+json; view=default
 type GoaContainerConfig struct {
 	DefaultShell *string `form:"defaultShell,omitempty" json:"defaultShell,omitempty" yaml:"defaultShell,omitempty" xml:"defaultShell,omitempty"`
+	// Seconds without incoming traffic until the container sleeps. A sleeping container is started by the next request. 0 disables sleeping
+	IdleTimeout *int `form:"idleTimeout,omitempty" json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty" xml:"idleTimeout,omitempty"`
+}
+
+// Validate validates the GoaContainerConfig media type instance.
+func (mt *GoaContainerConfig) Validate() (err error) {
+	if mt.IdleTimeout != nil {
+		if *mt.IdleTimeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`response.idleTimeout`, *mt.IdleTimeout, 0, true))
+		}
+	}
+	return
+}
+
+// A custom domain of a container (default view)
+//
+// Identifier: vpn.application/goa.container.domain+json; view=default
+type GoaContainerDomain struct {
+	// Domain name
+	Domain string `form:"domain" json:"domain" yaml:"domain" xml:"domain"`
+	// URL to serve the token at
+	HTTPURL string `form:"httpURL" json:"httpURL" yaml:"httpURL" xml:"httpURL"`
+	// Token to prove the ownership of the domain
+	Token string `form:"token" json:"token" yaml:"token" xml:"token"`
+	// Name of the TXT record to put the token in
+	TxtRecord string `form:"txtRecord" json:"txtRecord" yaml:"txtRecord" xml:"txtRecord"`
+	// Whether the ownership of the domain has been verified
+	Verified bool `form:"verified" json:"verified" yaml:"verified" xml:"verified"`
+}
+
+// Validate validates the GoaContainerDomain media type instance.
+func (mt *GoaContainerDomain) Validate() (err error) {
+	if mt.Domain == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "domain"))
+	}
+
+	if mt.Token == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "token"))
+	}
+	if mt.TxtRecord == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "txtRecord"))
+	}
+	if mt.HTTPURL == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "httpURL"))
+	}
+	return
+}
+
+// GoaContainerDomainCollection is the media type for an array of GoaContainerDomain (default view)
+//
+// Identifier: vpn.application/goa.container.domain+json; type=collection; view=default
+type GoaContainerDomainCollection []*GoaContainerDomain
+
+// Validate validates the GoaContainerDomainCollection media type instance.
+func (mt GoaContainerDomainCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
 // GoaContainerDownloadResult media type (default view)
@@ -79,6 +172,96 @@ type GoaContainerDownloadResult struct {
 	File *multipart.FileHeader `form:"file,omitempty" json:"file,omitempty" yaml:"file,omitempty" xml:"file,omitempty"`
 }
 
+// An environment variable of a container (default view)
+//
+// Identifier: vpn.application/goa.container.env+json; view=default
+type GoaContainerEnv struct {
+	// Name of the environment variable
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Whether the value is masked
+	Secret bool `form:"secret" json:"secret" yaml:"secret" xml:"secret"`
+	// Value of the environment variable. Masked if secret
+	Value string `form:"value" json:"value" yaml:"value" xml:"value"`
+}
+
+// Validate validates the GoaContainerEnv media type instance.
+func (mt *GoaContainerEnv) Validate() (err error) {
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+	if mt.Value == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "value"))
+	}
+
+	if ok := goa.ValidatePattern(`^[a-zA-Z_][a-zA-Z0-9_]*$`, mt.Name); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`response.name`, mt.Name, `^[a-zA-Z_][a-zA-Z0-9_]*$`))
+	}
+	if utf8.RuneCountInString(mt.Name) > 255 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.name`, mt.Name, utf8.RuneCountInString(mt.Name), 255, false))
+	}
+	return
+}
+
+// GoaContainerEnvCollection is the media type for an array of GoaContainerEnv (default view)
+//
+// Identifier: vpn.application/goa.container.env+json; type=collection; view=default
+type GoaContainerEnvCollection []*GoaContainerEnv
+
+// Validate validates the GoaContainerEnvCollection media type instance.
+func (mt GoaContainerEnvCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// An event which happened to a container (default view)
+//
+// Identifier: vpn.application/goa.container.event+json; view=default
+type GoaContainerEvent struct {
+	// The time the event happened
+	CreatedAt time.Time `form:"createdAt" json:"createdAt" yaml:"createdAt" xml:"createdAt"`
+	// Description of the event
+	Message string `form:"message" json:"message" yaml:"message" xml:"message"`
+	Type    string `form:"type" json:"type" yaml:"type" xml:"type"`
+}
+
+// Validate validates the GoaContainerEvent media type instance.
+func (mt *GoaContainerEvent) Validate() (err error) {
+	if mt.Type == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "type"))
+	}
+	if mt.Message == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "message"))
+	}
+
+	if !(mt.Type == "ExpiryWarning") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.type`, mt.Type, []interface{}{"ExpiryWarning"}))
+	}
+	return
+}
+
+// GoaContainerEventCollection is the media type for an array of GoaContainerEvent (default view)
+//
+// Identifier: vpn.application/goa.container.event+json; type=collection; view=default
+type GoaContainerEventCollection []*GoaContainerEvent
+
+// Validate validates the GoaContainerEventCollection media type instance.
+func (mt GoaContainerEventCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // GoaContainerInspect media type (default view)
 //
 // Identifier: vpn.application/goa.container.inspect+json; view=default
@@ -87,18 +270,40 @@ type GoaContainerInspect struct {
 	Args []string `form:"args" json:"args" yaml:"args" xml:"args"`
 	// The time the container was created
 	Created time.Time `form:"created" json:"created" yaml:"created" xml:"created"`
+	// Exit code of the last exit
+	ExitCode *int `form:"exitCode,omitempty" json:"exitCode,omitempty" yaml:"exitCode,omitempty" xml:"exitCode,omitempty"`
+	// The time the container is removed at
+	ExpiresAt *time.Time `form:"expiresAt,omitempty" json:"expiresAt,omitempty" yaml:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
 	// ID
 	ID int `form:"id" json:"id" yaml:"id" xml:"id"`
 	// The name of the image to use when creating the container
 	Image string `form:"image" json:"image" yaml:"image" xml:"image"`
 	// The container's image ID
 	ImageID string `form:"imageID" json:"imageID" yaml:"imageID" xml:"imageID"`
+	// Kind of the container(web, worker or private)
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" yaml:"kind,omitempty" xml:"kind,omitempty"`
+	// Effective resource limits
+	Limits *GoaContainerLimits `form:"limits,omitempty" json:"limits,omitempty" yaml:"limits,omitempty" xml:"limits,omitempty"`
 	// Assign the specified name to the container. Must match /?[a-zA-Z0-9_-]+.
 	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
 	// The path to the command being run
-	Path     string                       `form:"path" json:"path" yaml:"path" xml:"path"`
+	Path string `form:"path" json:"path" yaml:"path" xml:"path"`
+	// Port the container serves HTTP on
+	Port *int `form:"port,omitempty" json:"port,omitempty" yaml:"port,omitempty" xml:"port,omitempty"`
+	// Protocol the container serves on the port
+	Protocol *string                      `form:"protocol,omitempty" json:"protocol,omitempty" yaml:"protocol,omitempty" xml:"protocol,omitempty"`
 	RawState *GoaContainerInspectRawState `form:"raw_state" json:"raw_state" yaml:"raw_state" xml:"raw_state"`
-	Status   string                       `form:"status" json:"status" yaml:"status" xml:"status"`
+	// Number of replicas
+	Replicas *int `form:"replicas,omitempty" json:"replicas,omitempty" yaml:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Number of restarts by the restart policy since the container was started by the user
+	RestartCount *int `form:"restartCount,omitempty" json:"restartCount,omitempty" yaml:"restartCount,omitempty" xml:"restartCount,omitempty"`
+	// Restart policy of the container
+	RestartPolicy *string `form:"restartPolicy,omitempty" json:"restartPolicy,omitempty" yaml:"restartPolicy,omitempty" xml:"restartPolicy,omitempty"`
+	// Number of running replicas
+	RunningReplicas *int `form:"runningReplicas,omitempty" json:"runningReplicas,omitempty" yaml:"runningReplicas,omitempty" xml:"runningReplicas,omitempty"`
+	// Schedules starting or stopping the container
+	Schedules []*GoaContainerSchedule `form:"schedules,omitempty" json:"schedules,omitempty" yaml:"schedules,omitempty" xml:"schedules,omitempty"`
+	Status    string                  `form:"status" json:"status" yaml:"status" xml:"status"`
 	// Paths to mount volumes in
 	Volumes []string `form:"volumes" json:"volumes" yaml:"volumes" xml:"volumes"`
 }
@@ -136,12 +341,33 @@ func (mt *GoaContainerInspect) Validate() (err error) {
 			err = goa.MergeErrors(err, err2)
 		}
 	}
-	if !(mt.Status == "Image Downloading" || mt.Status == "Created" || mt.Status == "Running" || mt.Status == "Stopped" || mt.Status == "Error") {
-		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, mt.Status, []interface{}{"Image Downloading", "Created", "Running", "Stopped", "Error"}))
+	for _, e := range mt.Schedules {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if !(mt.Status == "Image Downloading" || mt.Status == "Created" || mt.Status == "Running" || mt.Status == "Healthy" || mt.Status == "Unhealthy" || mt.Status == "Paused" || mt.Status == "Stopped" || mt.Status == "Sleeping" || mt.Status == "CrashLooping" || mt.Status == "Error") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, mt.Status, []interface{}{"Image Downloading", "Created", "Running", "Healthy", "Unhealthy", "Paused", "Stopped", "Sleeping", "CrashLooping", "Error"}))
 	}
 	return
 }
 
+// Resource limits applied to a container. Missing limits are unlimited (default view)
+//
+// Identifier: vpn.application/goa.container.limits+json; view=default
+type GoaContainerLimits struct {
+	// Percentage of a CPU
+	CPU *int `form:"cpu,omitempty" json:"cpu,omitempty" yaml:"cpu,omitempty" xml:"cpu,omitempty"`
+	// Memory size such as 512M
+	Memory *string `form:"memory,omitempty" json:"memory,omitempty" yaml:"memory,omitempty" xml:"memory,omitempty"`
+	// Maximum number of processes
+	Pids *int `form:"pids,omitempty" json:"pids,omitempty" yaml:"pids,omitempty" xml:"pids,omitempty"`
+	// Storage size such as 10G
+	Storage *string `form:"storage,omitempty" json:"storage,omitempty" yaml:"storage,omitempty" xml:"storage,omitempty"`
+}
+
 // GoaContainerListEach media type (default view)
 //
 // Identifier: vpn.application/goa.container.list.each+json; view=default
@@ -150,15 +376,27 @@ type GoaContainerListEach struct {
 	Command string `form:"command" json:"command" yaml:"command" xml:"command"`
 	// The time the container was created
 	Created time.Time `form:"created" json:"created" yaml:"created" xml:"created"`
+	// The time the container is removed at
+	ExpiresAt *time.Time `form:"expiresAt,omitempty" json:"expiresAt,omitempty" yaml:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
 	// ID
 	ID int `form:"id" json:"id" yaml:"id" xml:"id"`
 	// The name of the image to use when creating the container
 	Image string `form:"image" json:"image" yaml:"image" xml:"image"`
 	// The container's image ID
 	ImageID string `form:"imageID" json:"imageID" yaml:"imageID" xml:"imageID"`
+	// Kind of the container(web, worker or private)
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" yaml:"kind,omitempty" xml:"kind,omitempty"`
 	// Assign the specified name to the container. Must match /?[a-zA-Z0-9_-]+.
-	Name   string `form:"name" json:"name" yaml:"name" xml:"name"`
-	Status string `form:"status" json:"status" yaml:"status" xml:"status"`
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Port the container serves HTTP on
+	Port *int `form:"port,omitempty" json:"port,omitempty" yaml:"port,omitempty" xml:"port,omitempty"`
+	// Protocol the container serves on the port
+	Protocol *string `form:"protocol,omitempty" json:"protocol,omitempty" yaml:"protocol,omitempty" xml:"protocol,omitempty"`
+	// Number of replicas
+	Replicas *int `form:"replicas,omitempty" json:"replicas,omitempty" yaml:"replicas,omitempty" xml:"replicas,omitempty"`
+	// Number of running replicas
+	RunningReplicas *int   `form:"runningReplicas,omitempty" json:"runningReplicas,omitempty" yaml:"runningReplicas,omitempty" xml:"runningReplicas,omitempty"`
+	Status          string `form:"status" json:"status" yaml:"status" xml:"status"`
 	// Paths to mount volumes in
 	Volumes []string `form:"volumes" json:"volumes" yaml:"volumes" xml:"volumes"`
 }
@@ -185,8 +423,8 @@ func (mt *GoaContainerListEach) Validate() (err error) {
 	if mt.Volumes == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "volumes"))
 	}
-	if !(mt.Status == "Creating" || mt.Status == "Created" || mt.Status == "Running" || mt.Status == "Stopped" || mt.Status == "Error") {
-		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, mt.Status, []interface{}{"Creating", "Created", "Running", "Stopped", "Error"}))
+	if !(mt.Status == "Creating" || mt.Status == "Created" || mt.Status == "Running" || mt.Status == "Healthy" || mt.Status == "Unhealthy" || mt.Status == "Paused" || mt.Status == "Stopped" || mt.Status == "Sleeping" || mt.Status == "CrashLooping" || mt.Status == "Error") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, mt.Status, []interface{}{"Creating", "Created", "Running", "Healthy", "Unhealthy", "Paused", "Stopped", "Sleeping", "CrashLooping", "Error"}))
 	}
 	return
 }
@@ -208,6 +446,536 @@ func (mt GoaContainerListEachCollection) Validate() (err error) {
 	return
 }
 
+// Time series of the resource usage of a container. Steps without samples are omitted (default view)
+//
+// Identifier: vpn.application/goa.container.metrics+json; view=default
+type GoaContainerMetrics struct {
+	// The start of the series
+	From   time.Time                          `form:"from" json:"from" yaml:"from" xml:"from"`
+	Points GoaContainerMetricsPointCollection `form:"points" json:"points" yaml:"points" xml:"points"`
+	// Seconds between the points
+	Step int `form:"step" json:"step" yaml:"step" xml:"step"`
+	// The end of the series
+	To time.Time `form:"to" json:"to" yaml:"to" xml:"to"`
+}
+
+// Validate validates the GoaContainerMetrics media type instance.
+func (mt *GoaContainerMetrics) Validate() (err error) {
+
+	if mt.Points == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "points"))
+	}
+	return
+}
+
+// Resource usage of a container summed over the replicas in a step (default view)
+//
+// Identifier: vpn.application/goa.container.metrics.point+json; view=default
+type GoaContainerMetricsPoint struct {
+	// Bytes read from block devices in the step
+	BlockReadBytes int `form:"blockReadBytes" json:"blockReadBytes" yaml:"blockReadBytes" xml:"blockReadBytes"`
+	// Bytes written to block devices in the step
+	BlockWriteBytes int `form:"blockWriteBytes" json:"blockWriteBytes" yaml:"blockWriteBytes" xml:"blockWriteBytes"`
+	// Average CPU usage in the percentage of a CPU
+	CPUPercent float64 `form:"cpuPercent" json:"cpuPercent" yaml:"cpuPercent" xml:"cpuPercent"`
+	// Average memory available in bytes
+	MemoryLimit int `form:"memoryLimit" json:"memoryLimit" yaml:"memoryLimit" xml:"memoryLimit"`
+	// Average memory used in bytes
+	MemoryUsage int `form:"memoryUsage" json:"memoryUsage" yaml:"memoryUsage" xml:"memoryUsage"`
+	// Average number of processes
+	Pids int `form:"pids" json:"pids" yaml:"pids" xml:"pids"`
+	// Bytes received over the network in the step
+	RxBytes int `form:"rxBytes" json:"rxBytes" yaml:"rxBytes" xml:"rxBytes"`
+	// The start of the step
+	Time time.Time `form:"time" json:"time" yaml:"time" xml:"time"`
+	// Bytes sent over the network in the step
+	TxBytes int `form:"txBytes" json:"txBytes" yaml:"txBytes" xml:"txBytes"`
+}
+
+// Validate validates the GoaContainerMetricsPoint media type instance.
+func (mt *GoaContainerMetricsPoint) Validate() (err error) {
+
+	return
+}
+
+// GoaContainerMetricsPointCollection is the media type for an array of GoaContainerMetricsPoint (default view)
+//
+// Identifier: vpn.application/goa.container.metrics.point+json; type=collection; view=default
+type GoaContainerMetricsPointCollection []*GoaContainerMetricsPoint
+
+// Validate validates the GoaContainerMetricsPointCollection media type instance.
+func (mt GoaContainerMetricsPointCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// A configuration of a container recorded every time it is deployed (default view)
+//
+// Identifier: vpn.application/goa.container.release+json; view=default
+type GoaContainerRelease struct {
+	// What deployed the release
+	Cause string `form:"cause" json:"cause" yaml:"cause" xml:"cause"`
+	// Command to run
+	Command []string `form:"command" json:"command" yaml:"command" xml:"command"`
+	// The time the release was deployed
+	CreatedAt time.Time `form:"createdAt" json:"createdAt" yaml:"createdAt" xml:"createdAt"`
+	// The entry point for the container
+	Entrypoint []string `form:"entrypoint" json:"entrypoint" yaml:"entrypoint" xml:"entrypoint"`
+	// Environment variables
+	Env []string `form:"env" json:"env" yaml:"env" xml:"env"`
+	// The name of the image
+	Image string `form:"image" json:"image" yaml:"image" xml:"image"`
+	// The repository digest of the image
+	ImageDigest string `form:"imageDigest" json:"imageDigest" yaml:"imageDigest" xml:"imageDigest"`
+	// Port the container serves HTTP on
+	Port *int `form:"port,omitempty" json:"port,omitempty" yaml:"port,omitempty" xml:"port,omitempty"`
+	// Additional ports
+	Ports []string `form:"ports" json:"ports" yaml:"ports" xml:"ports"`
+	// Protocol the container serves on the port
+	Protocol string `form:"protocol" json:"protocol" yaml:"protocol" xml:"protocol"`
+	// Release number
+	Version int `form:"version" json:"version" yaml:"version" xml:"version"`
+	// Current directory (PWD) in the command will be launched
+	WorkingDir string `form:"workingDir" json:"workingDir" yaml:"workingDir" xml:"workingDir"`
+}
+
+// Validate validates the GoaContainerRelease media type instance.
+func (mt *GoaContainerRelease) Validate() (err error) {
+
+	if mt.Image == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "image"))
+	}
+	if mt.ImageDigest == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "imageDigest"))
+	}
+	if mt.Env == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "env"))
+	}
+	if mt.Command == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "command"))
+	}
+	if mt.Entrypoint == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "entrypoint"))
+	}
+	if mt.WorkingDir == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "workingDir"))
+	}
+	if mt.Protocol == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "protocol"))
+	}
+	if mt.Ports == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "ports"))
+	}
+	if mt.Cause == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "cause"))
+	}
+
+	if !(mt.Cause == "create" || mt.Cause == "redeploy" || mt.Cause == "rollback") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.cause`, mt.Cause, []interface{}{"create", "redeploy", "rollback"}))
+	}
+	return
+}
+
+// GoaContainerReleaseCollection is the media type for an array of GoaContainerRelease (default view)
+//
+// Identifier: vpn.application/goa.container.release+json; type=collection; view=default
+type GoaContainerReleaseCollection []*GoaContainerRelease
+
+// Validate validates the GoaContainerReleaseCollection media type instance.
+func (mt GoaContainerReleaseCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// A path-prefix route to a container (default view)
+//
+// Identifier: vpn.application/goa.container.route+json; view=default
+type GoaContainerRoute struct {
+	// Host name
+	Host string `form:"host" json:"host" yaml:"host" xml:"host"`
+	// Route ID
+	ID int `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Path prefix
+	PathPrefix string `form:"pathPrefix" json:"pathPrefix" yaml:"pathPrefix" xml:"pathPrefix"`
+	// Priority of the route. Routes with higher priority are matched first
+	Priority int `form:"priority" json:"priority" yaml:"priority" xml:"priority"`
+	// Whether the prefix is stripped before forwarding requests
+	StripPrefix bool `form:"stripPrefix" json:"stripPrefix" yaml:"stripPrefix" xml:"stripPrefix"`
+}
+
+// Validate validates the GoaContainerRoute media type instance.
+func (mt *GoaContainerRoute) Validate() (err error) {
+
+	if mt.Host == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "host"))
+	}
+	if mt.PathPrefix == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "pathPrefix"))
+	}
+
+	return
+}
+
+// GoaContainerRouteCollection is the media type for an array of GoaContainerRoute (default view)
+//
+// Identifier: vpn.application/goa.container.route+json; type=collection; view=default
+type GoaContainerRouteCollection []*GoaContainerRoute
+
+// Validate validates the GoaContainerRouteCollection media type instance.
+func (mt GoaContainerRouteCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// The result of a one-off container (default view)
+//
+// Identifier: vpn.application/goa.container.run.result+json; view=default
+type GoaContainerRunResult struct {
+	// Exit code of the command
+	ExitCode int `form:"exitCode" json:"exitCode" yaml:"exitCode" xml:"exitCode"`
+	// Stdout and stderr of the command. Only the end is kept if it is long
+	Output string `form:"output" json:"output" yaml:"output" xml:"output"`
+	// Whether the container was killed by the timeout
+	TimedOut bool `form:"timedOut" json:"timedOut" yaml:"timedOut" xml:"timedOut"`
+}
+
+// Validate validates the GoaContainerRunResult media type instance.
+func (mt *GoaContainerRunResult) Validate() (err error) {
+
+	if mt.Output == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "output"))
+	}
+
+	return
+}
+
+// A schedule starting or stopping a container (default view)
+//
+// Identifier: vpn.application/goa.container.schedule+json; view=default
+type GoaContainerSchedule struct {
+	Action string `form:"action" json:"action" yaml:"action" xml:"action"`
+	// ID
+	ID int `form:"id" json:"id" yaml:"id" xml:"id"`
+	// The time the action was taken last
+	LastRunAt *time.Time `form:"lastRunAt,omitempty" json:"lastRunAt,omitempty" yaml:"lastRunAt,omitempty" xml:"lastRunAt,omitempty"`
+	// The time the action is taken next
+	NextRunAt time.Time `form:"nextRunAt" json:"nextRunAt" yaml:"nextRunAt" xml:"nextRunAt"`
+	// Cron expression(minute hour day month weekday)
+	Schedule string `form:"schedule" json:"schedule" yaml:"schedule" xml:"schedule"`
+	// Time zone the schedule is in
+	Timezone string `form:"timezone" json:"timezone" yaml:"timezone" xml:"timezone"`
+}
+
+// Validate validates the GoaContainerSchedule media type instance.
+func (mt *GoaContainerSchedule) Validate() (err error) {
+
+	if mt.Action == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "action"))
+	}
+	if mt.Schedule == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "schedule"))
+	}
+	if mt.Timezone == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "timezone"))
+	}
+
+	if !(mt.Action == "start" || mt.Action == "stop") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.action`, mt.Action, []interface{}{"start", "stop"}))
+	}
+	return
+}
+
+// An action taken by a schedule (default view)
+//
+// Identifier: vpn.application/goa.container.schedule.log+json; view=default
+type GoaContainerScheduleLog struct {
+	Action string `form:"action" json:"action" yaml:"action" xml:"action"`
+	// The time the action was taken
+	CreatedAt time.Time `form:"createdAt" json:"createdAt" yaml:"createdAt" xml:"createdAt"`
+	// Error message if the action failed
+	Message *string `form:"message,omitempty" json:"message,omitempty" yaml:"message,omitempty" xml:"message,omitempty"`
+	// ID of the schedule
+	Schedule int    `form:"schedule" json:"schedule" yaml:"schedule" xml:"schedule"`
+	Status   string `form:"status" json:"status" yaml:"status" xml:"status"`
+}
+
+// Validate validates the GoaContainerScheduleLog media type instance.
+func (mt *GoaContainerScheduleLog) Validate() (err error) {
+
+	if mt.Action == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "action"))
+	}
+	if mt.Status == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "status"))
+	}
+
+	if !(mt.Action == "start" || mt.Action == "stop") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.action`, mt.Action, []interface{}{"start", "stop"}))
+	}
+	if !(mt.Status == "Succeeded" || mt.Status == "Failed") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, mt.Status, []interface{}{"Succeeded", "Failed"}))
+	}
+	return
+}
+
+// GoaContainerScheduleLogCollection is the media type for an array of GoaContainerScheduleLog (default view)
+//
+// Identifier: vpn.application/goa.container.schedule.log+json; type=collection; view=default
+type GoaContainerScheduleLogCollection []*GoaContainerScheduleLog
+
+// Validate validates the GoaContainerScheduleLogCollection media type instance.
+func (mt GoaContainerScheduleLogCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// GoaContainerScheduleCollection is the media type for an array of GoaContainerSchedule (default view)
+//
+// Identifier: vpn.application/goa.container.schedule+json; type=collection; view=default
+type GoaContainerScheduleCollection []*GoaContainerSchedule
+
+// Validate validates the GoaContainerScheduleCollection media type instance.
+func (mt GoaContainerScheduleCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// A secret injected into a container (default view)
+//
+// Identifier: vpn.application/goa.container.secret+json; view=default
+type GoaContainerSecret struct {
+	// Environment variable the secret is injected as
+	Env *string `form:"env,omitempty" json:"env,omitempty" yaml:"env,omitempty" xml:"env,omitempty"`
+	// Path of the file the secret is copied to
+	File *string `form:"file,omitempty" json:"file,omitempty" yaml:"file,omitempty" xml:"file,omitempty"`
+	// Name of the secret
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+}
+
+// Validate validates the GoaContainerSecret media type instance.
+func (mt *GoaContainerSecret) Validate() (err error) {
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+	return
+}
+
+// GoaContainerSecretCollection is the media type for an array of GoaContainerSecret (default view)
+//
+// Identifier: vpn.application/goa.container.secret+json; type=collection; view=default
+type GoaContainerSecretCollection []*GoaContainerSecret
+
+// Validate validates the GoaContainerSecretCollection media type instance.
+func (mt GoaContainerSecretCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// Resource usage of a replica of a container (default view)
+//
+// Identifier: vpn.application/goa.container.stats+json; view=default
+type GoaContainerStats struct {
+	// Bytes read from block devices since the container started
+	BlockReadBytes int `form:"blockReadBytes" json:"blockReadBytes" yaml:"blockReadBytes" xml:"blockReadBytes"`
+	// Bytes written to block devices since the container started
+	BlockWriteBytes int `form:"blockWriteBytes" json:"blockWriteBytes" yaml:"blockWriteBytes" xml:"blockWriteBytes"`
+	// CPU usage in the percentage of a CPU
+	CPUPercent float64 `form:"cpuPercent" json:"cpuPercent" yaml:"cpuPercent" xml:"cpuPercent"`
+	// Memory available in bytes
+	MemoryLimit int `form:"memoryLimit" json:"memoryLimit" yaml:"memoryLimit" xml:"memoryLimit"`
+	// Memory usage in the percentage of the limit
+	MemoryPercent float64 `form:"memoryPercent" json:"memoryPercent" yaml:"memoryPercent" xml:"memoryPercent"`
+	// Memory used in bytes excluding the page cache
+	MemoryUsage int `form:"memoryUsage" json:"memoryUsage" yaml:"memoryUsage" xml:"memoryUsage"`
+	// Number of processes
+	Pids int `form:"pids" json:"pids" yaml:"pids" xml:"pids"`
+	// The time the stats were read
+	Read time.Time `form:"read" json:"read" yaml:"read" xml:"read"`
+	// Replica number. 0 is the main one
+	Replica int `form:"replica" json:"replica" yaml:"replica" xml:"replica"`
+	// Bytes received over the network since the container started
+	RxBytes int `form:"rxBytes" json:"rxBytes" yaml:"rxBytes" xml:"rxBytes"`
+	// Bytes sent over the network since the container started
+	TxBytes int `form:"txBytes" json:"txBytes" yaml:"txBytes" xml:"txBytes"`
+}
+
+// Validate validates the GoaContainerStats media type instance.
+func (mt *GoaContainerStats) Validate() (err error) {
+
+	return
+}
+
+// GoaContainerStatsCollection is the media type for an array of GoaContainerStats (default view)
+//
+// Identifier: vpn.application/goa.container.stats+json; type=collection; view=default
+type GoaContainerStatsCollection []*GoaContainerStats
+
+// Validate validates the GoaContainerStatsCollection media type instance.
+func (mt GoaContainerStatsCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// A job run on a cron schedule (default view)
+//
+// Identifier: vpn.application/goa.job+json; view=default
+type GoaJob struct {
+	// Command to run
+	Command []string `form:"command" json:"command" yaml:"command" xml:"command"`
+	// ID of the container the command is executed in
+	Container *int `form:"container,omitempty" json:"container,omitempty" yaml:"container,omitempty" xml:"container,omitempty"`
+	// Environment variables
+	Env []string `form:"env" json:"env" yaml:"env" xml:"env"`
+	// ID
+	ID int `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Image run as a one-off container
+	Image *string `form:"image,omitempty" json:"image,omitempty" yaml:"image,omitempty" xml:"image,omitempty"`
+	// Name of the job
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// The time the job runs next
+	NextRunAt time.Time `form:"nextRunAt" json:"nextRunAt" yaml:"nextRunAt" xml:"nextRunAt"`
+	// Cron expression(minute hour day month weekday)
+	Schedule string `form:"schedule" json:"schedule" yaml:"schedule" xml:"schedule"`
+}
+
+// Validate validates the GoaJob media type instance.
+func (mt *GoaJob) Validate() (err error) {
+
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+	if mt.Schedule == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "schedule"))
+	}
+	if mt.Command == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "command"))
+	}
+	if mt.Env == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "env"))
+	}
+
+	return
+}
+
+// A run of a job (default view)
+//
+// Identifier: vpn.application/goa.job.run+json; view=default
+type GoaJobRun struct {
+	// Exit code of the command
+	ExitCode *int `form:"exitCode,omitempty" json:"exitCode,omitempty" yaml:"exitCode,omitempty" xml:"exitCode,omitempty"`
+	// The time the run finished
+	FinishedAt *time.Time `form:"finishedAt,omitempty" json:"finishedAt,omitempty" yaml:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// ID
+	ID int `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Error message if the command could not be run
+	Message *string `form:"message,omitempty" json:"message,omitempty" yaml:"message,omitempty" xml:"message,omitempty"`
+	// Stdout and stderr of the command. Only the end is kept if it is long
+	Output string `form:"output" json:"output" yaml:"output" xml:"output"`
+	// The time the run started
+	StartedAt time.Time `form:"startedAt" json:"startedAt" yaml:"startedAt" xml:"startedAt"`
+	Status    string    `form:"status" json:"status" yaml:"status" xml:"status"`
+	// What started the run
+	Trigger string `form:"trigger" json:"trigger" yaml:"trigger" xml:"trigger"`
+}
+
+// Validate validates the GoaJobRun media type instance.
+func (mt *GoaJobRun) Validate() (err error) {
+
+	if mt.Trigger == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "trigger"))
+	}
+	if mt.Status == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "status"))
+	}
+
+	if mt.Output == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "output"))
+	}
+	if !(mt.Status == "Running" || mt.Status == "Succeeded" || mt.Status == "Failed" || mt.Status == "Error") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, mt.Status, []interface{}{"Running", "Succeeded", "Failed", "Error"}))
+	}
+	if !(mt.Trigger == "schedule" || mt.Trigger == "manual") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.trigger`, mt.Trigger, []interface{}{"schedule", "manual"}))
+	}
+	return
+}
+
+// GoaJobRunCollection is the media type for an array of GoaJobRun (default view)
+//
+// Identifier: vpn.application/goa.job.run+json; type=collection; view=default
+type GoaJobRunCollection []*GoaJobRun
+
+// Validate validates the GoaJobRunCollection media type instance.
+func (mt GoaJobRunCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// GoaJobCollection is the media type for an array of GoaJob (default view)
+//
+// Identifier: vpn.application/goa.job+json; type=collection; view=default
+type GoaJobCollection []*GoaJob
+
+// Validate validates the GoaJobCollection media type instance.
+func (mt GoaJobCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // GoaUserAuthorizedkey media type (default view)
 //
 // Identifier: vpn.application/goa.user.authorizedkey+json; view=default
@@ -292,3 +1060,76 @@ func (mt *GoaUserDefaultshell) Validate() (err error) {
 	}
 	return
 }
+
+// GoaUserQuota media type (default view)
+//
+// Identifier: vpn.application/goa.user.quota+json; view=default
+type GoaUserQuota struct {
+	// Quota of the user. Missing ones are unlimited
+	Limits *GoaUserQuotaResources `form:"limits" json:"limits" yaml:"limits" xml:"limits"`
+	// Resources used by the user
+	Usage *GoaUserQuotaResources `form:"usage" json:"usage" yaml:"usage" xml:"usage"`
+}
+
+// Validate validates the GoaUserQuota media type instance.
+func (mt *GoaUserQuota) Validate() (err error) {
+	if mt.Limits == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "limits"))
+	}
+	if mt.Usage == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "usage"))
+	}
+	return
+}
+
+// GoaUserQuotaResources media type (default view)
+//
+// Identifier: vpn.application/goa.user.quota.resources+json; view=default
+type GoaUserQuotaResources struct {
+	// Number of containers
+	Containers *int `form:"containers,omitempty" json:"containers,omitempty" yaml:"containers,omitempty" xml:"containers,omitempty"`
+	// Sum of the percentages of a CPU of the containers and their replicas. Containers without a cpu limit count as 100
+	CPU *int `form:"cpu,omitempty" json:"cpu,omitempty" yaml:"cpu,omitempty" xml:"cpu,omitempty"`
+	// Sum of the memory limits of the containers and their replicas
+	Memory *string `form:"memory,omitempty" json:"memory,omitempty" yaml:"memory,omitempty" xml:"memory,omitempty"`
+	// Sum of the storage limits of the containers and their replicas
+	Storage *string `form:"storage,omitempty" json:"storage,omitempty" yaml:"storage,omitempty" xml:"storage,omitempty"`
+	// Number of volumes
+	Volumes *int `form:"volumes,omitempty" json:"volumes,omitempty" yaml:"volumes,omitempty" xml:"volumes,omitempty"`
+}
+
+// A secret stored encrypted. The value is never returned (default view)
+//
+// Identifier: vpn.application/goa.user.secret+json; view=default
+type GoaUserSecret struct {
+	// Name of the secret
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// The time the secret was updated
+	UpdatedAt time.Time `form:"updatedAt" json:"updatedAt" yaml:"updatedAt" xml:"updatedAt"`
+}
+
+// Validate validates the GoaUserSecret media type instance.
+func (mt *GoaUserSecret) Validate() (err error) {
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+
+	return
+}
+
+// GoaUserSecretCollection is the media type for an array of GoaUserSecret (default view)
+//
+// Identifier: vpn.application/goa.user.secret+json; type=collection; view=default
+type GoaUserSecretCollection []*GoaUserSecret
+
+// Validate validates the GoaUserSecretCollection media type instance.
+func (mt GoaUserSecretCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
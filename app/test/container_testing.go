@@ -22,14 +22,15 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"time"
 )
 
-// CreateContainerBadRequest runs the method Create of the given controller with the given parameters.
+// AddDomainContainerBadRequest runs the method AddDomain of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func CreateContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, name string, sslRedirect bool, volumes []string, workingDir *string) (http.ResponseWriter, error) {
+func AddDomainContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -51,83 +52,28 @@ func CreateContainerBadRequest(t goatest.TInterface, ctx context.Context, servic
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := command
-		query["command"] = sliceVal
-	}
-	{
-		sliceVal := entrypoint
-		query["entrypoint"] = sliceVal
-	}
-	{
-		sliceVal := env
-		query["env"] = sliceVal
-	}
-	{
-		sliceVal := []string{image}
-		query["image"] = sliceVal
-	}
-	{
-		sliceVal := []string{name}
-		query["name"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
-		query["sslRedirect"] = sliceVal
-	}
-	{
-		sliceVal := volumes
-		query["volumes"] = sliceVal
-	}
-	if workingDir != nil {
-		sliceVal := []string{*workingDir}
-		query["workingDir"] = sliceVal
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/create"),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := command
-		prms["command"] = sliceVal
-	}
-	{
-		sliceVal := entrypoint
-		prms["entrypoint"] = sliceVal
-	}
-	{
-		sliceVal := env
-		prms["env"] = sliceVal
-	}
-	{
-		sliceVal := []string{image}
-		prms["image"] = sliceVal
-	}
-	{
-		sliceVal := []string{name}
-		prms["name"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
-		prms["sslRedirect"] = sliceVal
-	}
-	{
-		sliceVal := volumes
-		prms["volumes"] = sliceVal
-	}
-	if workingDir != nil {
-		sliceVal := []string{*workingDir}
-		prms["workingDir"] = sliceVal
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
+	addDomainCtx, _err := app.NewAddDomainContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -137,7 +83,7 @@ func CreateContainerBadRequest(t goatest.TInterface, ctx context.Context, servic
 	}
 
 	// Perform action
-	_err = ctrl.Create(createCtx)
+	_err = ctrl.AddDomain(addDomainCtx)
 
 	// Validate response
 	if _err != nil {
@@ -159,11 +105,11 @@ func CreateContainerBadRequest(t goatest.TInterface, ctx context.Context, servic
 	return rw, mt
 }
 
-// CreateContainerConflict runs the method Create of the given controller with the given parameters.
+// AddDomainContainerConflict runs the method AddDomain of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func CreateContainerConflict(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, name string, sslRedirect bool, volumes []string, workingDir *string) (http.ResponseWriter, error) {
+func AddDomainContainerConflict(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -185,83 +131,28 @@ func CreateContainerConflict(t goatest.TInterface, ctx context.Context, service
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := command
-		query["command"] = sliceVal
-	}
-	{
-		sliceVal := entrypoint
-		query["entrypoint"] = sliceVal
-	}
-	{
-		sliceVal := env
-		query["env"] = sliceVal
-	}
-	{
-		sliceVal := []string{image}
-		query["image"] = sliceVal
-	}
-	{
-		sliceVal := []string{name}
-		query["name"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
-		query["sslRedirect"] = sliceVal
-	}
-	{
-		sliceVal := volumes
-		query["volumes"] = sliceVal
-	}
-	if workingDir != nil {
-		sliceVal := []string{*workingDir}
-		query["workingDir"] = sliceVal
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/create"),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := command
-		prms["command"] = sliceVal
-	}
-	{
-		sliceVal := entrypoint
-		prms["entrypoint"] = sliceVal
-	}
-	{
-		sliceVal := env
-		prms["env"] = sliceVal
-	}
-	{
-		sliceVal := []string{image}
-		prms["image"] = sliceVal
-	}
-	{
-		sliceVal := []string{name}
-		prms["name"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
-		prms["sslRedirect"] = sliceVal
-	}
-	{
-		sliceVal := volumes
-		prms["volumes"] = sliceVal
-	}
-	if workingDir != nil {
-		sliceVal := []string{*workingDir}
-		prms["workingDir"] = sliceVal
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
+	addDomainCtx, _err := app.NewAddDomainContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -271,7 +162,7 @@ func CreateContainerConflict(t goatest.TInterface, ctx context.Context, service
 	}
 
 	// Perform action
-	_err = ctrl.Create(createCtx)
+	_err = ctrl.AddDomain(addDomainCtx)
 
 	// Validate response
 	if _err != nil {
@@ -293,11 +184,11 @@ func CreateContainerConflict(t goatest.TInterface, ctx context.Context, service
 	return rw, mt
 }
 
-// CreateContainerInternalServerError runs the method Create of the given controller with the given parameters.
+// AddDomainContainerInternalServerError runs the method AddDomain of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func CreateContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, name string, sslRedirect bool, volumes []string, workingDir *string) (http.ResponseWriter, error) {
+func AddDomainContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -319,83 +210,28 @@ func CreateContainerInternalServerError(t goatest.TInterface, ctx context.Contex
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := command
-		query["command"] = sliceVal
-	}
-	{
-		sliceVal := entrypoint
-		query["entrypoint"] = sliceVal
-	}
-	{
-		sliceVal := env
-		query["env"] = sliceVal
-	}
-	{
-		sliceVal := []string{image}
-		query["image"] = sliceVal
-	}
-	{
-		sliceVal := []string{name}
-		query["name"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
-		query["sslRedirect"] = sliceVal
-	}
-	{
-		sliceVal := volumes
-		query["volumes"] = sliceVal
-	}
-	if workingDir != nil {
-		sliceVal := []string{*workingDir}
-		query["workingDir"] = sliceVal
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/create"),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := command
-		prms["command"] = sliceVal
-	}
-	{
-		sliceVal := entrypoint
-		prms["entrypoint"] = sliceVal
-	}
-	{
-		sliceVal := env
-		prms["env"] = sliceVal
-	}
-	{
-		sliceVal := []string{image}
-		prms["image"] = sliceVal
-	}
-	{
-		sliceVal := []string{name}
-		prms["name"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
-		prms["sslRedirect"] = sliceVal
-	}
-	{
-		sliceVal := volumes
-		prms["volumes"] = sliceVal
-	}
-	if workingDir != nil {
-		sliceVal := []string{*workingDir}
-		prms["workingDir"] = sliceVal
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
+	addDomainCtx, _err := app.NewAddDomainContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -405,7 +241,7 @@ func CreateContainerInternalServerError(t goatest.TInterface, ctx context.Contex
 	}
 
 	// Perform action
-	_err = ctrl.Create(createCtx)
+	_err = ctrl.AddDomain(addDomainCtx)
 
 	// Validate response
 	if _err != nil {
@@ -427,17 +263,16 @@ func CreateContainerInternalServerError(t goatest.TInterface, ctx context.Contex
 	return rw, mt
 }
 
-// CreateContainerOK runs the method Create of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// AddDomainContainerNotFound runs the method AddDomain of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func CreateContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, name string, sslRedirect bool, volumes []string, workingDir *string) (http.ResponseWriter, *app.GoaContainerCreateResults) {
+func AddDomainContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
-		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -453,124 +288,57 @@ func CreateContainerOK(t goatest.TInterface, ctx context.Context, service *goa.S
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := command
-		query["command"] = sliceVal
-	}
-	{
-		sliceVal := entrypoint
-		query["entrypoint"] = sliceVal
-	}
-	{
-		sliceVal := env
-		query["env"] = sliceVal
-	}
-	{
-		sliceVal := []string{image}
-		query["image"] = sliceVal
-	}
-	{
-		sliceVal := []string{name}
-		query["name"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
-		query["sslRedirect"] = sliceVal
-	}
-	{
-		sliceVal := volumes
-		query["volumes"] = sliceVal
-	}
-	if workingDir != nil {
-		sliceVal := []string{*workingDir}
-		query["workingDir"] = sliceVal
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/create"),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := command
-		prms["command"] = sliceVal
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
 	}
-	{
-		sliceVal := entrypoint
-		prms["entrypoint"] = sliceVal
+	if ctx == nil {
+		ctx = context.Background()
 	}
-	{
-		sliceVal := env
-		prms["env"] = sliceVal
-	}
-	{
-		sliceVal := []string{image}
-		prms["image"] = sliceVal
-	}
-	{
-		sliceVal := []string{name}
-		prms["name"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
-		prms["sslRedirect"] = sliceVal
-	}
-	{
-		sliceVal := volumes
-		prms["volumes"] = sliceVal
-	}
-	if workingDir != nil {
-		sliceVal := []string{*workingDir}
-		prms["workingDir"] = sliceVal
-	}
-	if ctx == nil {
-		ctx = context.Background()
-	}
-	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
-	if _err != nil {
-		e, ok := _err.(goa.ServiceError)
-		if !ok {
-			panic("invalid test data " + _err.Error()) // bug
-		}
-		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil, nil
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	addDomainCtx, _err := app.NewAddDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
 	}
 
 	// Perform action
-	_err = ctrl.Create(createCtx)
+	_err = ctrl.AddDomain(addDomainCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 200 {
-		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
-	}
-	var mt *app.GoaContainerCreateResults
-	if resp != nil {
-		var _ok bool
-		mt, _ok = resp.(*app.GoaContainerCreateResults)
-		if !_ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerCreateResults", resp, resp)
-		}
-		_err = mt.Validate()
-		if _err != nil {
-			t.Errorf("invalid response media type: %s", _err)
-		}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
 	}
 
 	// Return results
-	return rw, mt
+	return rw
 }
 
-// DownloadContainerInternalServerError runs the method Download of the given controller with the given parameters.
+// AddDomainContainerOK runs the method AddDomain of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func DownloadContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, internalPath string) (http.ResponseWriter, error) {
+func AddDomainContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, *app.GoaContainerDomain) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -592,52 +360,57 @@ func DownloadContainerInternalServerError(t goatest.TInterface, ctx context.Cont
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := []string{internalPath}
-		query["internalPath"] = sliceVal
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/download", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := []string{internalPath}
-		prms["internalPath"] = sliceVal
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	addDomainCtx, _err := app.NewAddDomainContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
-		return nil, e
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
 	}
 
 	// Perform action
-	_err = ctrl.Download(downloadCtx)
+	_err = ctrl.AddDomain(addDomainCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 500 {
-		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
 	}
-	var mt error
+	var mt *app.GoaContainerDomain
 	if resp != nil {
 		var _ok bool
-		mt, _ok = resp.(error)
+		mt, _ok = resp.(*app.GoaContainerDomain)
 		if !_ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerDomain", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
 		}
 	}
 
@@ -645,11 +418,11 @@ func DownloadContainerInternalServerError(t goatest.TInterface, ctx context.Cont
 	return rw, mt
 }
 
-// DownloadContainerInternalServerError1 runs the method Download of the given controller with the given parameters.
+// AddRouteContainerBadRequest runs the method AddRoute of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func DownloadContainerInternalServerError1(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, internalPath string) (http.ResponseWriter, error) {
+func AddRouteContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, host string, pathPrefix string, priority int, stripPrefix bool) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -671,27 +444,52 @@ func DownloadContainerInternalServerError1(t goatest.TInterface, ctx context.Con
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := []string{internalPath}
-		query["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		query["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		query["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		query["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		query["stripPrefix"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/download"),
+		Path:     fmt.Sprintf("/api/v2/container/%v/routes", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("HEAD", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := []string{internalPath}
-		prms["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		prms["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		prms["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		prms["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		prms["stripPrefix"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	addRouteCtx, _err := app.NewAddRouteContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -701,14 +499,14 @@ func DownloadContainerInternalServerError1(t goatest.TInterface, ctx context.Con
 	}
 
 	// Perform action
-	_err = ctrl.Download(downloadCtx)
+	_err = ctrl.AddRoute(addRouteCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 500 {
-		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
 	}
 	var mt error
 	if resp != nil {
@@ -723,11 +521,11 @@ func DownloadContainerInternalServerError1(t goatest.TInterface, ctx context.Con
 	return rw, mt
 }
 
-// DownloadContainerNotFound runs the method Download of the given controller with the given parameters.
+// AddRouteContainerConflict runs the method AddRoute of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func DownloadContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, internalPath string) (http.ResponseWriter, error) {
+func AddRouteContainerConflict(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, host string, pathPrefix string, priority int, stripPrefix bool) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -749,28 +547,52 @@ func DownloadContainerNotFound(t goatest.TInterface, ctx context.Context, servic
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := []string{internalPath}
-		query["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		query["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		query["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		query["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		query["stripPrefix"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/download", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/routes", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := []string{internalPath}
-		prms["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		prms["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		prms["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		prms["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		prms["stripPrefix"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	addRouteCtx, _err := app.NewAddRouteContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -780,14 +602,14 @@ func DownloadContainerNotFound(t goatest.TInterface, ctx context.Context, servic
 	}
 
 	// Perform action
-	_err = ctrl.Download(downloadCtx)
+	_err = ctrl.AddRoute(addRouteCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 404 {
-		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	if rw.Code != 409 {
+		t.Errorf("invalid response status code: got %+v, expected 409", rw.Code)
 	}
 	var mt error
 	if resp != nil {
@@ -802,11 +624,11 @@ func DownloadContainerNotFound(t goatest.TInterface, ctx context.Context, servic
 	return rw, mt
 }
 
-// DownloadContainerNotFound1 runs the method Download of the given controller with the given parameters.
+// AddRouteContainerInternalServerError runs the method AddRoute of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func DownloadContainerNotFound1(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, internalPath string) (http.ResponseWriter, error) {
+func AddRouteContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, host string, pathPrefix string, priority int, stripPrefix bool) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -828,27 +650,52 @@ func DownloadContainerNotFound1(t goatest.TInterface, ctx context.Context, servi
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := []string{internalPath}
-		query["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		query["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		query["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		query["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		query["stripPrefix"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/download"),
+		Path:     fmt.Sprintf("/api/v2/container/%v/routes", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("HEAD", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := []string{internalPath}
-		prms["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		prms["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		prms["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		prms["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		prms["stripPrefix"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	addRouteCtx, _err := app.NewAddRouteContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -858,14 +705,14 @@ func DownloadContainerNotFound1(t goatest.TInterface, ctx context.Context, servi
 	}
 
 	// Perform action
-	_err = ctrl.Download(downloadCtx)
+	_err = ctrl.AddRoute(addRouteCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 404 {
-		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
 	}
 	var mt error
 	if resp != nil {
@@ -880,11 +727,11 @@ func DownloadContainerNotFound1(t goatest.TInterface, ctx context.Context, servi
 	return rw, mt
 }
 
-// DownloadContainerOK runs the method Download of the given controller with the given parameters.
+// AddRouteContainerNotFound runs the method AddRoute of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func DownloadContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, internalPath string) http.ResponseWriter {
+func AddRouteContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, host string, pathPrefix string, priority int, stripPrefix bool) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -905,28 +752,52 @@ func DownloadContainerOK(t goatest.TInterface, ctx context.Context, service *goa
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := []string{internalPath}
-		query["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		query["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		query["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		query["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		query["stripPrefix"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/download", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/routes", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := []string{internalPath}
-		prms["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		prms["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		prms["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		prms["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		prms["stripPrefix"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	addRouteCtx, _err := app.NewAddRouteContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -937,30 +808,31 @@ func DownloadContainerOK(t goatest.TInterface, ctx context.Context, service *goa
 	}
 
 	// Perform action
-	_err = ctrl.Download(downloadCtx)
+	_err = ctrl.AddRoute(addRouteCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 200 {
-		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
 	}
 
 	// Return results
 	return rw
 }
 
-// DownloadContainerOK1 runs the method Download of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers.
+// AddRouteContainerOK runs the method AddRoute of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func DownloadContainerOK1(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, internalPath string) http.ResponseWriter {
+func AddRouteContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, host string, pathPrefix string, priority int, stripPrefix bool) (http.ResponseWriter, *app.GoaContainerRoute) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
+		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -976,38 +848,63 @@ func DownloadContainerOK1(t goatest.TInterface, ctx context.Context, service *go
 	rw := httptest.NewRecorder()
 	query := url.Values{}
 	{
-		sliceVal := []string{internalPath}
-		query["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		query["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		query["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		query["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		query["stripPrefix"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/download"),
+		Path:     fmt.Sprintf("/api/v2/container/%v/routes", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("HEAD", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	{
-		sliceVal := []string{internalPath}
-		prms["internalPath"] = sliceVal
+		sliceVal := []string{host}
+		prms["host"] = sliceVal
+	}
+	{
+		sliceVal := []string{pathPrefix}
+		prms["pathPrefix"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(priority)}
+		prms["priority"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stripPrefix)}
+		prms["stripPrefix"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	addRouteCtx, _err := app.NewAddRouteContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
 		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil
+		return nil, nil
 	}
 
 	// Perform action
-	_err = ctrl.Download(downloadCtx)
+	_err = ctrl.AddRoute(addRouteCtx)
 
 	// Validate response
 	if _err != nil {
@@ -1016,16 +913,28 @@ func DownloadContainerOK1(t goatest.TInterface, ctx context.Context, service *go
 	if rw.Code != 200 {
 		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
 	}
+	var mt *app.GoaContainerRoute
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(*app.GoaContainerRoute)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerRoute", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
 
 	// Return results
-	return rw
+	return rw, mt
 }
 
-// ExecContainerInternalServerError runs the method Exec of the given controller with the given parameters.
+// AddScheduleContainerBadRequest runs the method AddSchedule of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func ExecContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, command []string, tty *bool) (http.ResponseWriter, error) {
+func AddScheduleContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, action *string, schedule *string, timezone string, window *string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1046,37 +955,53 @@ func ExecContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 	// Setup request context
 	rw := httptest.NewRecorder()
 	query := url.Values{}
+	if action != nil {
+		sliceVal := []string{*action}
+		query["action"] = sliceVal
+	}
+	if schedule != nil {
+		sliceVal := []string{*schedule}
+		query["schedule"] = sliceVal
+	}
 	{
-		sliceVal := command
-		query["command"] = sliceVal
+		sliceVal := []string{timezone}
+		query["timezone"] = sliceVal
 	}
-	if tty != nil {
-		sliceVal := []string{fmt.Sprintf("%v", *tty)}
-		query["tty"] = sliceVal
+	if window != nil {
+		sliceVal := []string{*window}
+		query["window"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/exec", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if action != nil {
+		sliceVal := []string{*action}
+		prms["action"] = sliceVal
+	}
+	if schedule != nil {
+		sliceVal := []string{*schedule}
+		prms["schedule"] = sliceVal
+	}
 	{
-		sliceVal := command
-		prms["command"] = sliceVal
+		sliceVal := []string{timezone}
+		prms["timezone"] = sliceVal
 	}
-	if tty != nil {
-		sliceVal := []string{fmt.Sprintf("%v", *tty)}
-		prms["tty"] = sliceVal
+	if window != nil {
+		sliceVal := []string{*window}
+		prms["window"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	execCtx, _err := app.NewExecContainerContext(goaCtx, req, service)
+	addScheduleCtx, _err := app.NewAddScheduleContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -1086,14 +1011,14 @@ func ExecContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 	}
 
 	// Perform action
-	_err = ctrl.Exec(execCtx)
+	_err = ctrl.AddSchedule(addScheduleCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 500 {
-		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
 	}
 	var mt error
 	if resp != nil {
@@ -1108,11 +1033,11 @@ func ExecContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 	return rw, mt
 }
 
-// ExecContainerNotFound runs the method Exec of the given controller with the given parameters.
+// AddScheduleContainerInternalServerError runs the method AddSchedule of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func ExecContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, command []string, tty *bool) (http.ResponseWriter, error) {
+func AddScheduleContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, action *string, schedule *string, timezone string, window *string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1133,37 +1058,53 @@ func ExecContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 	// Setup request context
 	rw := httptest.NewRecorder()
 	query := url.Values{}
+	if action != nil {
+		sliceVal := []string{*action}
+		query["action"] = sliceVal
+	}
+	if schedule != nil {
+		sliceVal := []string{*schedule}
+		query["schedule"] = sliceVal
+	}
 	{
-		sliceVal := command
-		query["command"] = sliceVal
+		sliceVal := []string{timezone}
+		query["timezone"] = sliceVal
 	}
-	if tty != nil {
-		sliceVal := []string{fmt.Sprintf("%v", *tty)}
-		query["tty"] = sliceVal
+	if window != nil {
+		sliceVal := []string{*window}
+		query["window"] = sliceVal
 	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/exec", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules", id),
 		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if action != nil {
+		sliceVal := []string{*action}
+		prms["action"] = sliceVal
+	}
+	if schedule != nil {
+		sliceVal := []string{*schedule}
+		prms["schedule"] = sliceVal
+	}
 	{
-		sliceVal := command
-		prms["command"] = sliceVal
+		sliceVal := []string{timezone}
+		prms["timezone"] = sliceVal
 	}
-	if tty != nil {
-		sliceVal := []string{fmt.Sprintf("%v", *tty)}
-		prms["tty"] = sliceVal
+	if window != nil {
+		sliceVal := []string{*window}
+		prms["window"] = sliceVal
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	execCtx, _err := app.NewExecContainerContext(goaCtx, req, service)
+	addScheduleCtx, _err := app.NewAddScheduleContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -1173,14 +1114,14 @@ func ExecContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 	}
 
 	// Perform action
-	_err = ctrl.Exec(execCtx)
+	_err = ctrl.AddSchedule(addScheduleCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 404 {
-		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
 	}
 	var mt error
 	if resp != nil {
@@ -1195,11 +1136,106 @@ func ExecContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 	return rw, mt
 }
 
-// GetConfigContainerInternalServerError runs the method GetConfig of the given controller with the given parameters.
+// AddScheduleContainerNotFound runs the method AddSchedule of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func AddScheduleContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, action *string, schedule *string, timezone string, window *string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if action != nil {
+		sliceVal := []string{*action}
+		query["action"] = sliceVal
+	}
+	if schedule != nil {
+		sliceVal := []string{*schedule}
+		query["schedule"] = sliceVal
+	}
+	{
+		sliceVal := []string{timezone}
+		query["timezone"] = sliceVal
+	}
+	if window != nil {
+		sliceVal := []string{*window}
+		query["window"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if action != nil {
+		sliceVal := []string{*action}
+		prms["action"] = sliceVal
+	}
+	if schedule != nil {
+		sliceVal := []string{*schedule}
+		prms["schedule"] = sliceVal
+	}
+	{
+		sliceVal := []string{timezone}
+		prms["timezone"] = sliceVal
+	}
+	if window != nil {
+		sliceVal := []string{*window}
+		prms["window"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	addScheduleCtx, _err := app.NewAddScheduleContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.AddSchedule(addScheduleCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// AddScheduleContainerOK runs the method AddSchedule of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func GetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+func AddScheduleContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, action *string, schedule *string, timezone string, window *string) (http.ResponseWriter, app.GoaContainerScheduleCollection) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1219,44 +1255,83 @@ func GetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Con
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if action != nil {
+		sliceVal := []string{*action}
+		query["action"] = sliceVal
+	}
+	if schedule != nil {
+		sliceVal := []string{*schedule}
+		query["schedule"] = sliceVal
+	}
+	{
+		sliceVal := []string{timezone}
+		query["timezone"] = sliceVal
+	}
+	if window != nil {
+		sliceVal := []string{*window}
+		query["window"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if action != nil {
+		sliceVal := []string{*action}
+		prms["action"] = sliceVal
+	}
+	if schedule != nil {
+		sliceVal := []string{*schedule}
+		prms["schedule"] = sliceVal
+	}
+	{
+		sliceVal := []string{timezone}
+		prms["timezone"] = sliceVal
+	}
+	if window != nil {
+		sliceVal := []string{*window}
+		prms["window"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	getConfigCtx, _err := app.NewGetConfigContainerContext(goaCtx, req, service)
+	addScheduleCtx, _err := app.NewAddScheduleContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
-		return nil, e
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
 	}
 
 	// Perform action
-	_err = ctrl.GetConfig(getConfigCtx)
+	_err = ctrl.AddSchedule(addScheduleCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 500 {
-		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
 	}
-	var mt error
+	var mt app.GoaContainerScheduleCollection
 	if resp != nil {
 		var _ok bool
-		mt, _ok = resp.(error)
+		mt, _ok = resp.(app.GoaContainerScheduleCollection)
 		if !_ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerScheduleCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
 		}
 	}
 
@@ -1264,11 +1339,11 @@ func GetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Con
 	return rw, mt
 }
 
-// GetConfigContainerNotFound runs the method GetConfig of the given controller with the given parameters.
+// AddSecretContainerAccepted runs the method AddSecret of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func GetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+func AddSecretContainerAccepted(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, env *string, file *string, name string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1287,20 +1362,54 @@ func GetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, servi
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		query["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		query["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		prms["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		prms["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	getConfigCtx, _err := app.NewGetConfigContainerContext(goaCtx, req, service)
+	addSecretCtx, _err := app.NewAddSecretContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -1311,25 +1420,25 @@ func GetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, servi
 	}
 
 	// Perform action
-	_err = ctrl.GetConfig(getConfigCtx)
+	_err = ctrl.AddSecret(addSecretCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 404 {
-		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	if rw.Code != 202 {
+		t.Errorf("invalid response status code: got %+v, expected 202", rw.Code)
 	}
 
 	// Return results
 	return rw
 }
 
-// GetConfigContainerOK runs the method GetConfig of the given controller with the given parameters.
+// AddSecretContainerBadRequest runs the method AddSecret of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func GetConfigContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, *app.GoaContainerConfig) {
+func AddSecretContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, env *string, file *string, name string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1349,45 +1458,78 @@ func GetConfigContainerOK(t goatest.TInterface, ctx context.Context, service *go
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		query["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		query["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		prms["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		prms["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	getConfigCtx, _err := app.NewGetConfigContainerContext(goaCtx, req, service)
+	addSecretCtx, _err := app.NewAddSecretContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
-		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil, nil
+		return nil, e
 	}
 
 	// Perform action
-	_err = ctrl.GetConfig(getConfigCtx)
+	_err = ctrl.AddSecret(addSecretCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 200 {
-		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
 	}
-	var mt *app.GoaContainerConfig
+	var mt error
 	if resp != nil {
 		var _ok bool
-		mt, _ok = resp.(*app.GoaContainerConfig)
+		mt, _ok = resp.(error)
 		if !_ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerConfig", resp, resp)
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
 		}
 	}
 
@@ -1395,11 +1537,11 @@ func GetConfigContainerOK(t goatest.TInterface, ctx context.Context, service *go
 	return rw, mt
 }
 
-// InspectContainerInternalServerError runs the method Inspect of the given controller with the given parameters.
+// AddSecretContainerInternalServerError runs the method AddSecret of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func InspectContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+func AddSecretContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, env *string, file *string, name string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1419,20 +1561,54 @@ func InspectContainerInternalServerError(t goatest.TInterface, ctx context.Conte
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		query["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		query["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/inspect", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		prms["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		prms["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	inspectCtx, _err := app.NewInspectContainerContext(goaCtx, req, service)
+	addSecretCtx, _err := app.NewAddSecretContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -1442,7 +1618,7 @@ func InspectContainerInternalServerError(t goatest.TInterface, ctx context.Conte
 	}
 
 	// Perform action
-	_err = ctrl.Inspect(inspectCtx)
+	_err = ctrl.AddSecret(addSecretCtx)
 
 	// Validate response
 	if _err != nil {
@@ -1464,11 +1640,11 @@ func InspectContainerInternalServerError(t goatest.TInterface, ctx context.Conte
 	return rw, mt
 }
 
-// InspectContainerNotFound runs the method Inspect of the given controller with the given parameters.
+// AddSecretContainerNoContent runs the method AddSecret of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func InspectContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+func AddSecretContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, env *string, file *string, name string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1487,20 +1663,54 @@ func InspectContainerNotFound(t goatest.TInterface, ctx context.Context, service
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		query["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		query["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/inspect", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		prms["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		prms["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	inspectCtx, _err := app.NewInspectContainerContext(goaCtx, req, service)
+	addSecretCtx, _err := app.NewAddSecretContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -1511,31 +1721,30 @@ func InspectContainerNotFound(t goatest.TInterface, ctx context.Context, service
 	}
 
 	// Perform action
-	_err = ctrl.Inspect(inspectCtx)
+	_err = ctrl.AddSecret(addSecretCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 404 {
-		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
 	}
 
 	// Return results
 	return rw
 }
 
-// InspectContainerOK runs the method Inspect of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// AddSecretContainerNotFound runs the method AddSecret of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func InspectContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, *app.GoaContainerInspect) {
+func AddSecretContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, env *string, file *string, name string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
-		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -1549,20 +1758,10667 @@ func InspectContainerOK(t goatest.TInterface, ctx context.Context, service *goa.
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		query["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		query["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/inspect", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if env != nil {
+		sliceVal := []string{*env}
+		prms["env"] = sliceVal
+	}
+	if file != nil {
+		sliceVal := []string{*file}
+		prms["file"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	addSecretCtx, _err := app.NewAddSecretContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.AddSecret(addSecretCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// CreateContainerBadRequest runs the method Create of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func CreateContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, cpu *int, entrypoint []string, env []string, expiresAt *time.Time, healthCheckCommand []string, healthCheckInterval int, healthCheckPath *string, healthCheckRetries int, healthCheckStartPeriod int, healthCheckTimeout int, idleTimeout int, image string, kind string, memory *string, metricsPath *string, metricsPort *int, name string, pids *int, port *int, ports []string, protocol string, replicas int, restartMaxRetries int, restartPolicy string, secrets []string, sslRedirect bool, storage *string, ttl *int, volumes []string, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		query["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		query["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		query["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		query["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		query["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		query["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		query["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		query["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		query["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		query["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		query["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		query["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		query["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		query["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		query["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		query["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		query["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		query["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		query["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/create"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		prms["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		prms["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		prms["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		prms["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		prms["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		prms["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		prms["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		prms["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		prms["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		prms["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		prms["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		prms["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		prms["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		prms["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		prms["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		prms["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		prms["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		prms["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		prms["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Create(createCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// CreateContainerConflict runs the method Create of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func CreateContainerConflict(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, cpu *int, entrypoint []string, env []string, expiresAt *time.Time, healthCheckCommand []string, healthCheckInterval int, healthCheckPath *string, healthCheckRetries int, healthCheckStartPeriod int, healthCheckTimeout int, idleTimeout int, image string, kind string, memory *string, metricsPath *string, metricsPort *int, name string, pids *int, port *int, ports []string, protocol string, replicas int, restartMaxRetries int, restartPolicy string, secrets []string, sslRedirect bool, storage *string, ttl *int, volumes []string, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		query["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		query["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		query["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		query["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		query["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		query["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		query["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		query["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		query["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		query["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		query["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		query["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		query["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		query["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		query["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		query["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		query["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		query["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		query["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/create"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		prms["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		prms["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		prms["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		prms["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		prms["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		prms["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		prms["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		prms["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		prms["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		prms["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		prms["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		prms["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		prms["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		prms["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		prms["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		prms["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		prms["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		prms["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		prms["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Create(createCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 409 {
+		t.Errorf("invalid response status code: got %+v, expected 409", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// CreateContainerForbidden runs the method Create of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func CreateContainerForbidden(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, cpu *int, entrypoint []string, env []string, expiresAt *time.Time, healthCheckCommand []string, healthCheckInterval int, healthCheckPath *string, healthCheckRetries int, healthCheckStartPeriod int, healthCheckTimeout int, idleTimeout int, image string, kind string, memory *string, metricsPath *string, metricsPort *int, name string, pids *int, port *int, ports []string, protocol string, replicas int, restartMaxRetries int, restartPolicy string, secrets []string, sslRedirect bool, storage *string, ttl *int, volumes []string, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		query["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		query["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		query["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		query["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		query["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		query["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		query["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		query["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		query["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		query["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		query["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		query["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		query["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		query["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		query["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		query["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		query["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		query["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		query["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/create"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		prms["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		prms["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		prms["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		prms["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		prms["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		prms["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		prms["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		prms["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		prms["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		prms["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		prms["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		prms["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		prms["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		prms["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		prms["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		prms["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		prms["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		prms["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		prms["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Create(createCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 403 {
+		t.Errorf("invalid response status code: got %+v, expected 403", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// CreateContainerInternalServerError runs the method Create of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func CreateContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, cpu *int, entrypoint []string, env []string, expiresAt *time.Time, healthCheckCommand []string, healthCheckInterval int, healthCheckPath *string, healthCheckRetries int, healthCheckStartPeriod int, healthCheckTimeout int, idleTimeout int, image string, kind string, memory *string, metricsPath *string, metricsPort *int, name string, pids *int, port *int, ports []string, protocol string, replicas int, restartMaxRetries int, restartPolicy string, secrets []string, sslRedirect bool, storage *string, ttl *int, volumes []string, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		query["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		query["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		query["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		query["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		query["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		query["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		query["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		query["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		query["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		query["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		query["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		query["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		query["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		query["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		query["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		query["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		query["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		query["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		query["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/create"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		prms["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		prms["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		prms["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		prms["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		prms["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		prms["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		prms["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		prms["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		prms["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		prms["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		prms["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		prms["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		prms["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		prms["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		prms["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		prms["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		prms["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		prms["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		prms["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Create(createCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// CreateContainerOK runs the method Create of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func CreateContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, cpu *int, entrypoint []string, env []string, expiresAt *time.Time, healthCheckCommand []string, healthCheckInterval int, healthCheckPath *string, healthCheckRetries int, healthCheckStartPeriod int, healthCheckTimeout int, idleTimeout int, image string, kind string, memory *string, metricsPath *string, metricsPort *int, name string, pids *int, port *int, ports []string, protocol string, replicas int, restartMaxRetries int, restartPolicy string, secrets []string, sslRedirect bool, storage *string, ttl *int, volumes []string, workingDir *string) (http.ResponseWriter, *app.GoaContainerCreateResults) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		query["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		query["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		query["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		query["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		query["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		query["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		query["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		query["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		query["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		query["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		query["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		query["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		query["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		query["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		query["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		query["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		query["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		query["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		query["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/create"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	{
+		sliceVal := healthCheckCommand
+		prms["healthCheckCommand"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckInterval)}
+		prms["healthCheckInterval"] = sliceVal
+	}
+	if healthCheckPath != nil {
+		sliceVal := []string{*healthCheckPath}
+		prms["healthCheckPath"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckRetries)}
+		prms["healthCheckRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckStartPeriod)}
+		prms["healthCheckStartPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthCheckTimeout)}
+		prms["healthCheckTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(idleTimeout)}
+		prms["idleTimeout"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{kind}
+		prms["kind"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if metricsPath != nil {
+		sliceVal := []string{*metricsPath}
+		prms["metricsPath"] = sliceVal
+	}
+	if metricsPort != nil {
+		sliceVal := []string{strconv.Itoa(*metricsPort)}
+		prms["metricsPort"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if port != nil {
+		sliceVal := []string{strconv.Itoa(*port)}
+		prms["port"] = sliceVal
+	}
+	{
+		sliceVal := ports
+		prms["ports"] = sliceVal
+	}
+	{
+		sliceVal := []string{protocol}
+		prms["protocol"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(restartMaxRetries)}
+		prms["restartMaxRetries"] = sliceVal
+	}
+	{
+		sliceVal := []string{restartPolicy}
+		prms["restartPolicy"] = sliceVal
+	}
+	{
+		sliceVal := secrets
+		prms["secrets"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", sslRedirect)}
+		prms["sslRedirect"] = sliceVal
+	}
+	if storage != nil {
+		sliceVal := []string{*storage}
+		prms["storage"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	{
+		sliceVal := volumes
+		prms["volumes"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	createCtx, _err := app.NewCreateContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.Create(createCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt *app.GoaContainerCreateResults
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(*app.GoaContainerCreateResults)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerCreateResults", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// DownloadContainerInternalServerError runs the method Download of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func DownloadContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, internalPath string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		query["internalPath"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/download", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{internalPath}
+		prms["internalPath"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Download(downloadCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// DownloadContainerInternalServerError1 runs the method Download of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func DownloadContainerInternalServerError1(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, internalPath string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		query["internalPath"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/download"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("HEAD", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		prms["internalPath"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Download(downloadCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// DownloadContainerNotFound runs the method Download of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func DownloadContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, internalPath string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		query["internalPath"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/download", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{internalPath}
+		prms["internalPath"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Download(downloadCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// DownloadContainerNotFound1 runs the method Download of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func DownloadContainerNotFound1(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, internalPath string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		query["internalPath"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/download"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("HEAD", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		prms["internalPath"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Download(downloadCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// DownloadContainerOK runs the method Download of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func DownloadContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, internalPath string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		query["internalPath"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/download", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{internalPath}
+		prms["internalPath"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Download(downloadCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// DownloadContainerOK1 runs the method Download of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func DownloadContainerOK1(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, internalPath string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		query["internalPath"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/download"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("HEAD", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := []string{internalPath}
+		prms["internalPath"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	downloadCtx, _err := app.NewDownloadContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Download(downloadCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// EventsContainerInternalServerError runs the method Events of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func EventsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, limit int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		query["limit"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/events", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		prms["limit"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	eventsCtx, _err := app.NewEventsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Events(eventsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// EventsContainerNotFound runs the method Events of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func EventsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, limit int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		query["limit"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/events", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		prms["limit"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	eventsCtx, _err := app.NewEventsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Events(eventsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// EventsContainerOK runs the method Events of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func EventsContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, limit int) (http.ResponseWriter, app.GoaContainerEventCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		query["limit"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/events", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		prms["limit"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	eventsCtx, _err := app.NewEventsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.Events(eventsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerEventCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerEventCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerEventCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ExecContainerInternalServerError runs the method Exec of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ExecContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, command []string, tty *bool) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	if tty != nil {
+		sliceVal := []string{fmt.Sprintf("%v", *tty)}
+		query["tty"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/exec", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	if tty != nil {
+		sliceVal := []string{fmt.Sprintf("%v", *tty)}
+		prms["tty"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	execCtx, _err := app.NewExecContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Exec(execCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ExecContainerNotFound runs the method Exec of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ExecContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, command []string, tty *bool) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	if tty != nil {
+		sliceVal := []string{fmt.Sprintf("%v", *tty)}
+		query["tty"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/exec", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	if tty != nil {
+		sliceVal := []string{fmt.Sprintf("%v", *tty)}
+		prms["tty"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	execCtx, _err := app.NewExecContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Exec(execCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ExtendContainerBadRequest runs the method Extend of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ExtendContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, expiresAt *time.Time, ttl *int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/extend", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	extendCtx, _err := app.NewExtendContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Extend(extendCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ExtendContainerInternalServerError runs the method Extend of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ExtendContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, expiresAt *time.Time, ttl *int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/extend", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	extendCtx, _err := app.NewExtendContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Extend(extendCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ExtendContainerNoContent runs the method Extend of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ExtendContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, expiresAt *time.Time, ttl *int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/extend", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	extendCtx, _err := app.NewExtendContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Extend(extendCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ExtendContainerNotFound runs the method Extend of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ExtendContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, expiresAt *time.Time, ttl *int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		query["expiresAt"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		query["ttl"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/extend", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if expiresAt != nil {
+		sliceVal := []string{(*expiresAt).Format(time.RFC3339)}
+		prms["expiresAt"] = sliceVal
+	}
+	if ttl != nil {
+		sliceVal := []string{strconv.Itoa(*ttl)}
+		prms["ttl"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	extendCtx, _err := app.NewExtendContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Extend(extendCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// GetConfigContainerInternalServerError runs the method GetConfig of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func GetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	getConfigCtx, _err := app.NewGetConfigContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.GetConfig(getConfigCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// GetConfigContainerNotFound runs the method GetConfig of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func GetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	getConfigCtx, _err := app.NewGetConfigContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.GetConfig(getConfigCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// GetConfigContainerOK runs the method GetConfig of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func GetConfigContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, *app.GoaContainerConfig) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	getConfigCtx, _err := app.NewGetConfigContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.GetConfig(getConfigCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt *app.GoaContainerConfig
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(*app.GoaContainerConfig)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerConfig", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// GetEnvContainerInternalServerError runs the method GetEnv of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func GetEnvContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/env", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	getEnvCtx, _err := app.NewGetEnvContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.GetEnv(getEnvCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// GetEnvContainerNotFound runs the method GetEnv of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func GetEnvContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/env", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	getEnvCtx, _err := app.NewGetEnvContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.GetEnv(getEnvCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// GetEnvContainerOK runs the method GetEnv of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func GetEnvContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, app.GoaContainerEnvCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/env", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	getEnvCtx, _err := app.NewGetEnvContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.GetEnv(getEnvCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerEnvCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerEnvCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerEnvCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// InspectContainerInternalServerError runs the method Inspect of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func InspectContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/inspect", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	inspectCtx, _err := app.NewInspectContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Inspect(inspectCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// InspectContainerNotFound runs the method Inspect of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func InspectContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/inspect", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	inspectCtx, _err := app.NewInspectContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Inspect(inspectCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// InspectContainerOK runs the method Inspect of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func InspectContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, *app.GoaContainerInspect) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/inspect", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	inspectCtx, _err := app.NewInspectContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.Inspect(inspectCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt *app.GoaContainerInspect
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(*app.GoaContainerInspect)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerInspect", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListContainerInternalServerError runs the method List of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/list"),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listCtx, _err := app.NewListContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.List(listCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListContainerOK runs the method List of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController) (http.ResponseWriter, app.GoaContainerListEachCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/list"),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listCtx, _err := app.NewListContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.List(listCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerListEachCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerListEachCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerListEachCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListDomainsContainerInternalServerError runs the method ListDomains of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListDomainsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/domains", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listDomainsCtx, _err := app.NewListDomainsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.ListDomains(listDomainsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListDomainsContainerNotFound runs the method ListDomains of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListDomainsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/domains", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listDomainsCtx, _err := app.NewListDomainsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.ListDomains(listDomainsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ListDomainsContainerOK runs the method ListDomains of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListDomainsContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, app.GoaContainerDomainCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/domains", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listDomainsCtx, _err := app.NewListDomainsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.ListDomains(listDomainsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerDomainCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerDomainCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerDomainCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListRoutesContainerInternalServerError runs the method ListRoutes of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListRoutesContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/routes", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listRoutesCtx, _err := app.NewListRoutesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.ListRoutes(listRoutesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListRoutesContainerNotFound runs the method ListRoutes of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListRoutesContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/routes", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listRoutesCtx, _err := app.NewListRoutesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.ListRoutes(listRoutesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ListRoutesContainerOK runs the method ListRoutes of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListRoutesContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, app.GoaContainerRouteCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/routes", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listRoutesCtx, _err := app.NewListRoutesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.ListRoutes(listRoutesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerRouteCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerRouteCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerRouteCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListSchedulesContainerInternalServerError runs the method ListSchedules of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListSchedulesContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/schedules", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listSchedulesCtx, _err := app.NewListSchedulesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.ListSchedules(listSchedulesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListSchedulesContainerNotFound runs the method ListSchedules of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListSchedulesContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/schedules", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listSchedulesCtx, _err := app.NewListSchedulesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.ListSchedules(listSchedulesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ListSchedulesContainerOK runs the method ListSchedules of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListSchedulesContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, app.GoaContainerScheduleCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/schedules", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listSchedulesCtx, _err := app.NewListSchedulesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.ListSchedules(listSchedulesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerScheduleCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerScheduleCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerScheduleCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListSecretsContainerInternalServerError runs the method ListSecrets of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListSecretsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/secrets", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listSecretsCtx, _err := app.NewListSecretsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.ListSecrets(listSecretsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ListSecretsContainerNotFound runs the method ListSecrets of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListSecretsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/secrets", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listSecretsCtx, _err := app.NewListSecretsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.ListSecrets(listSecretsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ListSecretsContainerOK runs the method ListSecrets of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ListSecretsContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, app.GoaContainerSecretCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/secrets", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	listSecretsCtx, _err := app.NewListSecretsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.ListSecrets(listSecretsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerSecretCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerSecretCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerSecretCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// LogsContainerInternalServerError runs the method Logs of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func LogsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, follow bool, since *time.Time, stderr bool, stdout bool, tail string, timestamps bool, until *time.Time) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", follow)}
+		query["follow"] = sliceVal
+	}
+	if since != nil {
+		sliceVal := []string{(*since).Format(time.RFC3339)}
+		query["since"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stderr)}
+		query["stderr"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stdout)}
+		query["stdout"] = sliceVal
+	}
+	{
+		sliceVal := []string{tail}
+		query["tail"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", timestamps)}
+		query["timestamps"] = sliceVal
+	}
+	if until != nil {
+		sliceVal := []string{(*until).Format(time.RFC3339)}
+		query["until"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/logs", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", follow)}
+		prms["follow"] = sliceVal
+	}
+	if since != nil {
+		sliceVal := []string{(*since).Format(time.RFC3339)}
+		prms["since"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stderr)}
+		prms["stderr"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stdout)}
+		prms["stdout"] = sliceVal
+	}
+	{
+		sliceVal := []string{tail}
+		prms["tail"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", timestamps)}
+		prms["timestamps"] = sliceVal
+	}
+	if until != nil {
+		sliceVal := []string{(*until).Format(time.RFC3339)}
+		prms["until"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	logsCtx, _err := app.NewLogsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Logs(logsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// LogsContainerNotFound runs the method Logs of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func LogsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, follow bool, since *time.Time, stderr bool, stdout bool, tail string, timestamps bool, until *time.Time) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", follow)}
+		query["follow"] = sliceVal
+	}
+	if since != nil {
+		sliceVal := []string{(*since).Format(time.RFC3339)}
+		query["since"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stderr)}
+		query["stderr"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stdout)}
+		query["stdout"] = sliceVal
+	}
+	{
+		sliceVal := []string{tail}
+		query["tail"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", timestamps)}
+		query["timestamps"] = sliceVal
+	}
+	if until != nil {
+		sliceVal := []string{(*until).Format(time.RFC3339)}
+		query["until"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/logs", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", follow)}
+		prms["follow"] = sliceVal
+	}
+	if since != nil {
+		sliceVal := []string{(*since).Format(time.RFC3339)}
+		prms["since"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stderr)}
+		prms["stderr"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", stdout)}
+		prms["stdout"] = sliceVal
+	}
+	{
+		sliceVal := []string{tail}
+		prms["tail"] = sliceVal
+	}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", timestamps)}
+		prms["timestamps"] = sliceVal
+	}
+	if until != nil {
+		sliceVal := []string{(*until).Format(time.RFC3339)}
+		prms["until"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	logsCtx, _err := app.NewLogsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Logs(logsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// MetricsContainerBadRequest runs the method Metrics of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func MetricsContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, from *time.Time, step int, to *time.Time) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if from != nil {
+		sliceVal := []string{(*from).Format(time.RFC3339)}
+		query["from"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(step)}
+		query["step"] = sliceVal
+	}
+	if to != nil {
+		sliceVal := []string{(*to).Format(time.RFC3339)}
+		query["to"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/metrics", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if from != nil {
+		sliceVal := []string{(*from).Format(time.RFC3339)}
+		prms["from"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(step)}
+		prms["step"] = sliceVal
+	}
+	if to != nil {
+		sliceVal := []string{(*to).Format(time.RFC3339)}
+		prms["to"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	metricsCtx, _err := app.NewMetricsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Metrics(metricsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// MetricsContainerInternalServerError runs the method Metrics of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func MetricsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, from *time.Time, step int, to *time.Time) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if from != nil {
+		sliceVal := []string{(*from).Format(time.RFC3339)}
+		query["from"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(step)}
+		query["step"] = sliceVal
+	}
+	if to != nil {
+		sliceVal := []string{(*to).Format(time.RFC3339)}
+		query["to"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/metrics", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if from != nil {
+		sliceVal := []string{(*from).Format(time.RFC3339)}
+		prms["from"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(step)}
+		prms["step"] = sliceVal
+	}
+	if to != nil {
+		sliceVal := []string{(*to).Format(time.RFC3339)}
+		prms["to"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	metricsCtx, _err := app.NewMetricsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Metrics(metricsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// MetricsContainerNotFound runs the method Metrics of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func MetricsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, from *time.Time, step int, to *time.Time) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if from != nil {
+		sliceVal := []string{(*from).Format(time.RFC3339)}
+		query["from"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(step)}
+		query["step"] = sliceVal
+	}
+	if to != nil {
+		sliceVal := []string{(*to).Format(time.RFC3339)}
+		query["to"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/metrics", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if from != nil {
+		sliceVal := []string{(*from).Format(time.RFC3339)}
+		prms["from"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(step)}
+		prms["step"] = sliceVal
+	}
+	if to != nil {
+		sliceVal := []string{(*to).Format(time.RFC3339)}
+		prms["to"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	metricsCtx, _err := app.NewMetricsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Metrics(metricsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// MetricsContainerOK runs the method Metrics of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func MetricsContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, from *time.Time, step int, to *time.Time) (http.ResponseWriter, *app.GoaContainerMetrics) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if from != nil {
+		sliceVal := []string{(*from).Format(time.RFC3339)}
+		query["from"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(step)}
+		query["step"] = sliceVal
+	}
+	if to != nil {
+		sliceVal := []string{(*to).Format(time.RFC3339)}
+		query["to"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/metrics", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if from != nil {
+		sliceVal := []string{(*from).Format(time.RFC3339)}
+		prms["from"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(step)}
+		prms["step"] = sliceVal
+	}
+	if to != nil {
+		sliceVal := []string{(*to).Format(time.RFC3339)}
+		prms["to"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	metricsCtx, _err := app.NewMetricsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.Metrics(metricsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt *app.GoaContainerMetrics
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(*app.GoaContainerMetrics)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerMetrics", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// PauseContainerInternalServerError runs the method Pause of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func PauseContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/pause", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	pauseCtx, _err := app.NewPauseContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Pause(pauseCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// PauseContainerNoContent runs the method Pause of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func PauseContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/pause", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	pauseCtx, _err := app.NewPauseContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Pause(pauseCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// PauseContainerNotFound runs the method Pause of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func PauseContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/pause", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	pauseCtx, _err := app.NewPauseContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Pause(pauseCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// PauseContainerNotRunning runs the method Pause of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func PauseContainerNotRunning(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/pause", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	pauseCtx, _err := app.NewPauseContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Pause(pauseCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 409 {
+		t.Errorf("invalid response status code: got %+v, expected 409", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RedeployContainerAccepted runs the method Redeploy of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RedeployContainerAccepted(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, command []string, drainPeriod int, entrypoint []string, env []string, healthTimeout int, image *string, strategy string, timeout int, workingDir *string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		query["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		query["healthTimeout"] = sliceVal
+	}
+	if image != nil {
+		sliceVal := []string{*image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		query["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/redeploy", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		prms["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		prms["healthTimeout"] = sliceVal
+	}
+	if image != nil {
+		sliceVal := []string{*image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		prms["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	redeployCtx, _err := app.NewRedeployContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Redeploy(redeployCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 202 {
+		t.Errorf("invalid response status code: got %+v, expected 202", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RedeployContainerInternalServerError runs the method Redeploy of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RedeployContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, command []string, drainPeriod int, entrypoint []string, env []string, healthTimeout int, image *string, strategy string, timeout int, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		query["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		query["healthTimeout"] = sliceVal
+	}
+	if image != nil {
+		sliceVal := []string{*image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		query["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/redeploy", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		prms["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		prms["healthTimeout"] = sliceVal
+	}
+	if image != nil {
+		sliceVal := []string{*image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		prms["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	redeployCtx, _err := app.NewRedeployContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Redeploy(redeployCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RedeployContainerNotFound runs the method Redeploy of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RedeployContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, command []string, drainPeriod int, entrypoint []string, env []string, healthTimeout int, image *string, strategy string, timeout int, workingDir *string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		query["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		query["healthTimeout"] = sliceVal
+	}
+	if image != nil {
+		sliceVal := []string{*image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		query["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/redeploy", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		prms["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		prms["healthTimeout"] = sliceVal
+	}
+	if image != nil {
+		sliceVal := []string{*image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		prms["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	redeployCtx, _err := app.NewRedeployContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Redeploy(redeployCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ReleasesContainerInternalServerError runs the method Releases of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ReleasesContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/releases", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	releasesCtx, _err := app.NewReleasesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Releases(releasesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ReleasesContainerNotFound runs the method Releases of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ReleasesContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/releases", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	releasesCtx, _err := app.NewReleasesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Releases(releasesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ReleasesContainerOK runs the method Releases of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ReleasesContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, app.GoaContainerReleaseCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/releases", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	releasesCtx, _err := app.NewReleasesContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.Releases(releasesCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerReleaseCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerReleaseCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerReleaseCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RemoveContainerInternalServerError runs the method Remove of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, force bool) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", force)}
+		query["force"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/remove", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", force)}
+		prms["force"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeCtx, _err := app.NewRemoveContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Remove(removeCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RemoveContainerNoContent runs the method Remove of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, force bool) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", force)}
+		query["force"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/remove", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", force)}
+		prms["force"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeCtx, _err := app.NewRemoveContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Remove(removeCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveContainerNotFound runs the method Remove of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, force bool) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", force)}
+		query["force"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/remove", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", force)}
+		prms["force"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeCtx, _err := app.NewRemoveContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Remove(removeCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveContainerRunningContainer runs the method Remove of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveContainerRunningContainer(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, force bool) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", force)}
+		query["force"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/remove", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", force)}
+		prms["force"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeCtx, _err := app.NewRemoveContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Remove(removeCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 409 {
+		t.Errorf("invalid response status code: got %+v, expected 409", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveDomainContainerInternalServerError runs the method RemoveDomain of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveDomainContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeDomainCtx, _err := app.NewRemoveDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.RemoveDomain(removeDomainCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RemoveDomainContainerNoContent runs the method RemoveDomain of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveDomainContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeDomainCtx, _err := app.NewRemoveDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveDomain(removeDomainCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveDomainContainerNotFound runs the method RemoveDomain of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveDomainContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeDomainCtx, _err := app.NewRemoveDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveDomain(removeDomainCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveEnvContainerAccepted runs the method RemoveEnv of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveEnvContainerAccepted(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, name []string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	{
+		sliceVal := name
+		query["name"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/env", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	{
+		sliceVal := name
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeEnvCtx, _err := app.NewRemoveEnvContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveEnv(removeEnvCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 202 {
+		t.Errorf("invalid response status code: got %+v, expected 202", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveEnvContainerInternalServerError runs the method RemoveEnv of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveEnvContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, name []string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	{
+		sliceVal := name
+		query["name"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/env", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	{
+		sliceVal := name
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeEnvCtx, _err := app.NewRemoveEnvContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.RemoveEnv(removeEnvCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RemoveEnvContainerNoContent runs the method RemoveEnv of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveEnvContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, name []string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	{
+		sliceVal := name
+		query["name"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/env", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	{
+		sliceVal := name
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeEnvCtx, _err := app.NewRemoveEnvContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveEnv(removeEnvCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveEnvContainerNotFound runs the method RemoveEnv of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveEnvContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, name []string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	{
+		sliceVal := name
+		query["name"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/env", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	{
+		sliceVal := name
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeEnvCtx, _err := app.NewRemoveEnvContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveEnv(removeEnvCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveRouteContainerInternalServerError runs the method RemoveRoute of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveRouteContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, route int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(route)}
+		query["route"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/routes", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(route)}
+		prms["route"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeRouteCtx, _err := app.NewRemoveRouteContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.RemoveRoute(removeRouteCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RemoveRouteContainerNoContent runs the method RemoveRoute of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveRouteContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, route int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(route)}
+		query["route"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/routes", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(route)}
+		prms["route"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeRouteCtx, _err := app.NewRemoveRouteContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveRoute(removeRouteCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveRouteContainerNotFound runs the method RemoveRoute of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveRouteContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, route int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(route)}
+		query["route"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/routes", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(route)}
+		prms["route"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeRouteCtx, _err := app.NewRemoveRouteContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveRoute(removeRouteCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveScheduleContainerInternalServerError runs the method RemoveSchedule of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveScheduleContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, schedule int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(schedule)}
+		query["schedule"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(schedule)}
+		prms["schedule"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeScheduleCtx, _err := app.NewRemoveScheduleContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.RemoveSchedule(removeScheduleCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RemoveScheduleContainerNoContent runs the method RemoveSchedule of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveScheduleContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, schedule int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(schedule)}
+		query["schedule"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(schedule)}
+		prms["schedule"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeScheduleCtx, _err := app.NewRemoveScheduleContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveSchedule(removeScheduleCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveScheduleContainerNotFound runs the method RemoveSchedule of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveScheduleContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, schedule int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(schedule)}
+		query["schedule"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(schedule)}
+		prms["schedule"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeScheduleCtx, _err := app.NewRemoveScheduleContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveSchedule(removeScheduleCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveSecretContainerAccepted runs the method RemoveSecret of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveSecretContainerAccepted(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, name string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeSecretCtx, _err := app.NewRemoveSecretContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveSecret(removeSecretCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 202 {
+		t.Errorf("invalid response status code: got %+v, expected 202", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveSecretContainerInternalServerError runs the method RemoveSecret of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveSecretContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, name string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeSecretCtx, _err := app.NewRemoveSecretContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.RemoveSecret(removeSecretCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RemoveSecretContainerNoContent runs the method RemoveSecret of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveSecretContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, name string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeSecretCtx, _err := app.NewRemoveSecretContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveSecret(removeSecretCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RemoveSecretContainerNotFound runs the method RemoveSecret of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RemoveSecretContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, name string) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		query["name"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/secrets", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	{
+		sliceVal := []string{name}
+		prms["name"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	removeSecretCtx, _err := app.NewRemoveSecretContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.RemoveSecret(removeSecretCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RestartContainerInternalServerError runs the method Restart of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RestartContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, timeout int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/restart", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	restartCtx, _err := app.NewRestartContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Restart(restartCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RestartContainerNoContent runs the method Restart of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RestartContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, timeout int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/restart", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	restartCtx, _err := app.NewRestartContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Restart(restartCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RestartContainerNotFound runs the method Restart of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RestartContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, timeout int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/restart", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	restartCtx, _err := app.NewRestartContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Restart(restartCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RollbackContainerAccepted runs the method Rollback of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RollbackContainerAccepted(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, drainPeriod int, healthTimeout int, release *int, strategy string, timeout int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		query["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		query["healthTimeout"] = sliceVal
+	}
+	if release != nil {
+		sliceVal := []string{strconv.Itoa(*release)}
+		query["release"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		query["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/rollback", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		prms["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		prms["healthTimeout"] = sliceVal
+	}
+	if release != nil {
+		sliceVal := []string{strconv.Itoa(*release)}
+		prms["release"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		prms["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	rollbackCtx, _err := app.NewRollbackContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Rollback(rollbackCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 202 {
+		t.Errorf("invalid response status code: got %+v, expected 202", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RollbackContainerBadRequest runs the method Rollback of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RollbackContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, drainPeriod int, healthTimeout int, release *int, strategy string, timeout int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		query["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		query["healthTimeout"] = sliceVal
+	}
+	if release != nil {
+		sliceVal := []string{strconv.Itoa(*release)}
+		query["release"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		query["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/rollback", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		prms["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		prms["healthTimeout"] = sliceVal
+	}
+	if release != nil {
+		sliceVal := []string{strconv.Itoa(*release)}
+		prms["release"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		prms["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	rollbackCtx, _err := app.NewRollbackContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Rollback(rollbackCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RollbackContainerInternalServerError runs the method Rollback of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RollbackContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, drainPeriod int, healthTimeout int, release *int, strategy string, timeout int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		query["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		query["healthTimeout"] = sliceVal
+	}
+	if release != nil {
+		sliceVal := []string{strconv.Itoa(*release)}
+		query["release"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		query["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/rollback", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		prms["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		prms["healthTimeout"] = sliceVal
+	}
+	if release != nil {
+		sliceVal := []string{strconv.Itoa(*release)}
+		prms["release"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		prms["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	rollbackCtx, _err := app.NewRollbackContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Rollback(rollbackCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RollbackContainerNotFound runs the method Rollback of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RollbackContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, drainPeriod int, healthTimeout int, release *int, strategy string, timeout int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		query["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		query["healthTimeout"] = sliceVal
+	}
+	if release != nil {
+		sliceVal := []string{strconv.Itoa(*release)}
+		query["release"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		query["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/rollback", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(drainPeriod)}
+		prms["drainPeriod"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(healthTimeout)}
+		prms["healthTimeout"] = sliceVal
+	}
+	if release != nil {
+		sliceVal := []string{strconv.Itoa(*release)}
+		prms["release"] = sliceVal
+	}
+	{
+		sliceVal := []string{strategy}
+		prms["strategy"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	rollbackCtx, _err := app.NewRollbackContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Rollback(rollbackCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// RunContainerBadRequest runs the method Run of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RunContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, timeout int, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/run"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	runCtx, _err := app.NewRunContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Run(runCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RunContainerForbidden runs the method Run of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RunContainerForbidden(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, timeout int, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/run"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	runCtx, _err := app.NewRunContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Run(runCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 403 {
+		t.Errorf("invalid response status code: got %+v, expected 403", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RunContainerInternalServerError runs the method Run of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RunContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, timeout int, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/run"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	runCtx, _err := app.NewRunContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Run(runCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RunContainerOK runs the method Run of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RunContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, timeout int, workingDir *string) (http.ResponseWriter, *app.GoaContainerRunResult) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/run"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	runCtx, _err := app.NewRunContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.Run(runCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt *app.GoaContainerRunResult
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(*app.GoaContainerRunResult)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerRunResult", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RunStreamContainerBadRequest runs the method RunStream of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RunStreamContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, timeout int, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/run/stream"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	runStreamCtx, _err := app.NewRunStreamContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.RunStream(runStreamCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RunStreamContainerForbidden runs the method RunStream of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RunStreamContainerForbidden(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, timeout int, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/run/stream"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	runStreamCtx, _err := app.NewRunStreamContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.RunStream(runStreamCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 403 {
+		t.Errorf("invalid response status code: got %+v, expected 403", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// RunStreamContainerInternalServerError runs the method RunStream of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func RunStreamContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, command []string, entrypoint []string, env []string, image string, timeout int, workingDir *string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := command
+		query["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		query["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		query["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		query["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		query["workingDir"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/run/stream"),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	{
+		sliceVal := command
+		prms["command"] = sliceVal
+	}
+	{
+		sliceVal := entrypoint
+		prms["entrypoint"] = sliceVal
+	}
+	{
+		sliceVal := env
+		prms["env"] = sliceVal
+	}
+	{
+		sliceVal := []string{image}
+		prms["image"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if workingDir != nil {
+		sliceVal := []string{*workingDir}
+		prms["workingDir"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	runStreamCtx, _err := app.NewRunStreamContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.RunStream(runStreamCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ScaleContainerForbidden runs the method Scale of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ScaleContainerForbidden(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, replicas int, timeout int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/scale", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	scaleCtx, _err := app.NewScaleContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Scale(scaleCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 403 {
+		t.Errorf("invalid response status code: got %+v, expected 403", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ScaleContainerInternalServerError runs the method Scale of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ScaleContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, replicas int, timeout int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/scale", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	scaleCtx, _err := app.NewScaleContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.Scale(scaleCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ScaleContainerNoContent runs the method Scale of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ScaleContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, replicas int, timeout int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/scale", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	scaleCtx, _err := app.NewScaleContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Scale(scaleCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ScaleContainerNotFound runs the method Scale of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ScaleContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, replicas int, timeout int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		query["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/scale", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(replicas)}
+		prms["replicas"] = sliceVal
+	}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	scaleCtx, _err := app.NewScaleContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Scale(scaleCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ScheduleLogsContainerInternalServerError runs the method ScheduleLogs of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ScheduleLogsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, limit int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		query["limit"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules/logs", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		prms["limit"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	scheduleLogsCtx, _err := app.NewScheduleLogsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.ScheduleLogs(scheduleLogsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// ScheduleLogsContainerNotFound runs the method ScheduleLogs of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ScheduleLogsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, limit int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		query["limit"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules/logs", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		prms["limit"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	scheduleLogsCtx, _err := app.NewScheduleLogsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.ScheduleLogs(scheduleLogsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// ScheduleLogsContainerOK runs the method ScheduleLogs of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func ScheduleLogsContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, limit int) (http.ResponseWriter, app.GoaContainerScheduleLogCollection) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		query["limit"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/schedules/logs", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(limit)}
+		prms["limit"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	scheduleLogsCtx, _err := app.NewScheduleLogsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
+	}
+
+	// Perform action
+	_err = ctrl.ScheduleLogs(scheduleLogsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerScheduleLogCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerScheduleLogCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerScheduleLogCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// SetConfigContainerBadRequest runs the method SetConfig of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetConfigContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.ContainerConfig) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		return nil, e
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+	}
+	req, _err := http.NewRequest("POST", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setConfigCtx, __err := app.NewSetConfigContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
+		}
+		return nil, _e
+	}
+	setConfigCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.SetConfig(setConfigCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var __ok bool
+		mt, __ok = resp.(error)
+		if !__ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// SetConfigContainerInternalServerError runs the method SetConfig of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.ContainerConfig) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		return nil, e
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+	}
+	req, _err := http.NewRequest("POST", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setConfigCtx, __err := app.NewSetConfigContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
+		}
+		return nil, _e
+	}
+	setConfigCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.SetConfig(setConfigCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var __ok bool
+		mt, __ok = resp.(error)
+		if !__ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// SetConfigContainerNoContent runs the method SetConfig of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetConfigContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.ContainerConfig) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		t.Errorf("unexpected payload validation error: %+v", e)
+		return nil
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+	}
+	req, _err := http.NewRequest("POST", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setConfigCtx, __err := app.NewSetConfigContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", _e)
+		return nil
+	}
+	setConfigCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.SetConfig(setConfigCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// SetConfigContainerNotFound runs the method SetConfig of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.ContainerConfig) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		t.Errorf("unexpected payload validation error: %+v", e)
+		return nil
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+	}
+	req, _err := http.NewRequest("POST", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setConfigCtx, __err := app.NewSetConfigContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", _e)
+		return nil
+	}
+	setConfigCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.SetConfig(setConfigCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// SetEnvContainerAccepted runs the method SetEnv of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetEnvContainerAccepted(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, payload app.SetEnvContainerPayload) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		t.Errorf("unexpected payload validation error: %+v", e)
+		return nil
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/env", id),
+		RawQuery: query.Encode(),
+	}
+	req, _err := http.NewRequest("PUT", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setEnvCtx, __err := app.NewSetEnvContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", _e)
+		return nil
+	}
+	setEnvCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.SetEnv(setEnvCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 202 {
+		t.Errorf("invalid response status code: got %+v, expected 202", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// SetEnvContainerInternalServerError runs the method SetEnv of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetEnvContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, payload app.SetEnvContainerPayload) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		return nil, e
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/env", id),
+		RawQuery: query.Encode(),
+	}
+	req, _err := http.NewRequest("PUT", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setEnvCtx, __err := app.NewSetEnvContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
+		}
+		return nil, _e
+	}
+	setEnvCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.SetEnv(setEnvCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var __ok bool
+		mt, __ok = resp.(error)
+		if !__ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// SetEnvContainerNoContent runs the method SetEnv of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetEnvContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, payload app.SetEnvContainerPayload) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		t.Errorf("unexpected payload validation error: %+v", e)
+		return nil
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/env", id),
+		RawQuery: query.Encode(),
+	}
+	req, _err := http.NewRequest("PUT", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setEnvCtx, __err := app.NewSetEnvContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", _e)
+		return nil
+	}
+	setEnvCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.SetEnv(setEnvCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// SetEnvContainerNotFound runs the method SetEnv of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetEnvContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, deferred bool, payload app.SetEnvContainerPayload) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		t.Errorf("unexpected payload validation error: %+v", e)
+		return nil
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		query["deferred"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/env", id),
+		RawQuery: query.Encode(),
+	}
+	req, _err := http.NewRequest("PUT", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{fmt.Sprintf("%v", deferred)}
+		prms["deferred"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setEnvCtx, __err := app.NewSetEnvContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", _e)
+		return nil
+	}
+	setEnvCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.SetEnv(setEnvCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// SetLimitsContainerBadRequest runs the method SetLimits of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetLimitsContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, cpu *int, memory *string, pids *int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/limits", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setLimitsCtx, _err := app.NewSetLimitsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.SetLimits(setLimitsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// SetLimitsContainerForbidden runs the method SetLimits of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetLimitsContainerForbidden(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, cpu *int, memory *string, pids *int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/limits", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setLimitsCtx, _err := app.NewSetLimitsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.SetLimits(setLimitsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 403 {
+		t.Errorf("invalid response status code: got %+v, expected 403", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// SetLimitsContainerInternalServerError runs the method SetLimits of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetLimitsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, cpu *int, memory *string, pids *int) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/limits", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setLimitsCtx, _err := app.NewSetLimitsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
+	}
+
+	// Perform action
+	_err = ctrl.SetLimits(setLimitsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
+
+	// Return results
+	return rw, mt
+}
+
+// SetLimitsContainerNotFound runs the method SetLimits of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetLimitsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, cpu *int, memory *string, pids *int) http.ResponseWriter {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/limits", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	setLimitsCtx, _err := app.NewSetLimitsContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.SetLimits(setLimitsCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// SetLimitsContainerOK runs the method SetLimits of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func SetLimitsContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, cpu *int, memory *string, pids *int) (http.ResponseWriter, *app.GoaContainerLimits) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		query["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		query["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		query["pids"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/limits", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if cpu != nil {
+		sliceVal := []string{strconv.Itoa(*cpu)}
+		prms["cpu"] = sliceVal
+	}
+	if memory != nil {
+		sliceVal := []string{*memory}
+		prms["memory"] = sliceVal
+	}
+	if pids != nil {
+		sliceVal := []string{strconv.Itoa(*pids)}
+		prms["pids"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	inspectCtx, _err := app.NewInspectContainerContext(goaCtx, req, service)
+	setLimitsCtx, _err := app.NewSetLimitsContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -1573,7 +12429,7 @@ func InspectContainerOK(t goatest.TInterface, ctx context.Context, service *goa.
 	}
 
 	// Perform action
-	_err = ctrl.Inspect(inspectCtx)
+	_err = ctrl.SetLimits(setLimitsCtx)
 
 	// Validate response
 	if _err != nil {
@@ -1582,16 +12438,12 @@ func InspectContainerOK(t goatest.TInterface, ctx context.Context, service *goa.
 	if rw.Code != 200 {
 		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
 	}
-	var mt *app.GoaContainerInspect
+	var mt *app.GoaContainerLimits
 	if resp != nil {
 		var _ok bool
-		mt, _ok = resp.(*app.GoaContainerInspect)
+		mt, _ok = resp.(*app.GoaContainerLimits)
 		if !_ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerInspect", resp, resp)
-		}
-		_err = mt.Validate()
-		if _err != nil {
-			t.Errorf("invalid response media type: %s", _err)
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerLimits", resp, resp)
 		}
 	}
 
@@ -1599,11 +12451,11 @@ func InspectContainerOK(t goatest.TInterface, ctx context.Context, service *goa.
 	return rw, mt
 }
 
-// ListContainerInternalServerError runs the method List of the given controller with the given parameters.
+// StartContainerInternalServerError runs the method Start of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func ListContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController) (http.ResponseWriter, error) {
+func StartContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1624,18 +12476,19 @@ func ListContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 	// Setup request context
 	rw := httptest.NewRecorder()
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/list"),
+		Path: fmt.Sprintf("/api/v2/container/%v/start", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	listCtx, _err := app.NewListContainerContext(goaCtx, req, service)
+	startCtx, _err := app.NewStartContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -1645,7 +12498,7 @@ func ListContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 	}
 
 	// Perform action
-	_err = ctrl.List(listCtx)
+	_err = ctrl.Start(startCtx)
 
 	// Validate response
 	if _err != nil {
@@ -1667,17 +12520,16 @@ func ListContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 	return rw, mt
 }
 
-// ListContainerOK runs the method List of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// StartContainerNoContent runs the method Start of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func ListContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController) (http.ResponseWriter, app.GoaContainerListEachCollection) {
+func StartContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
-		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -1692,65 +12544,53 @@ func ListContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Ser
 	// Setup request context
 	rw := httptest.NewRecorder()
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/list"),
+		Path: fmt.Sprintf("/api/v2/container/%v/start", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	listCtx, _err := app.NewListContainerContext(goaCtx, req, service)
+	startCtx, _err := app.NewStartContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
 		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil, nil
+		return nil
 	}
 
 	// Perform action
-	_err = ctrl.List(listCtx)
+	_err = ctrl.Start(startCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 200 {
-		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
-	}
-	var mt app.GoaContainerListEachCollection
-	if resp != nil {
-		var _ok bool
-		mt, _ok = resp.(app.GoaContainerListEachCollection)
-		if !_ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerListEachCollection", resp, resp)
-		}
-		_err = mt.Validate()
-		if _err != nil {
-			t.Errorf("invalid response media type: %s", _err)
-		}
+	if rw.Code != 204 {
+		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
 	}
 
 	// Return results
-	return rw, mt
+	return rw
 }
 
-// LogsContainerInternalServerError runs the method Logs of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// StartContainerNotFound runs the method Start of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func LogsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, follow bool, since *time.Time, stderr bool, stdout bool, tail string, timestamps bool, until *time.Time) (http.ResponseWriter, error) {
+func StartContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
-		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -1764,38 +12604,8 @@ func LogsContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 
 	// Setup request context
 	rw := httptest.NewRecorder()
-	query := url.Values{}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", follow)}
-		query["follow"] = sliceVal
-	}
-	if since != nil {
-		sliceVal := []string{(*since).Format(time.RFC3339)}
-		query["since"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", stderr)}
-		query["stderr"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", stdout)}
-		query["stdout"] = sliceVal
-	}
-	{
-		sliceVal := []string{tail}
-		query["tail"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", timestamps)}
-		query["timestamps"] = sliceVal
-	}
-	if until != nil {
-		sliceVal := []string{(*until).Format(time.RFC3339)}
-		query["until"] = sliceVal
-	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/logs", id),
-		RawQuery: query.Encode(),
+		Path: fmt.Sprintf("/api/v2/container/%v/start", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
@@ -1803,75 +12613,40 @@ func LogsContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", follow)}
-		prms["follow"] = sliceVal
-	}
-	if since != nil {
-		sliceVal := []string{(*since).Format(time.RFC3339)}
-		prms["since"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", stderr)}
-		prms["stderr"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", stdout)}
-		prms["stdout"] = sliceVal
-	}
-	{
-		sliceVal := []string{tail}
-		prms["tail"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", timestamps)}
-		prms["timestamps"] = sliceVal
-	}
-	if until != nil {
-		sliceVal := []string{(*until).Format(time.RFC3339)}
-		prms["until"] = sliceVal
-	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	logsCtx, _err := app.NewLogsContainerContext(goaCtx, req, service)
+	startCtx, _err := app.NewStartContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
-		return nil, e
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
 	}
 
 	// Perform action
-	_err = ctrl.Logs(logsCtx)
+	_err = ctrl.Start(startCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 500 {
-		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
-	}
-	var mt error
-	if resp != nil {
-		var _ok bool
-		mt, _ok = resp.(error)
-		if !_ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
-		}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
 	}
 
 	// Return results
-	return rw, mt
+	return rw
 }
 
-// LogsContainerNotFound runs the method Logs of the given controller with the given parameters.
+// StatsContainerInternalServerError runs the method Stats of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func LogsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, follow bool, since *time.Time, stderr bool, stdout bool, tail string, timestamps bool, until *time.Time) (http.ResponseWriter, error) {
+func StatsContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -1891,38 +12666,8 @@ func LogsContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 
 	// Setup request context
 	rw := httptest.NewRecorder()
-	query := url.Values{}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", follow)}
-		query["follow"] = sliceVal
-	}
-	if since != nil {
-		sliceVal := []string{(*since).Format(time.RFC3339)}
-		query["since"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", stderr)}
-		query["stderr"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", stdout)}
-		query["stdout"] = sliceVal
-	}
-	{
-		sliceVal := []string{tail}
-		query["tail"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", timestamps)}
-		query["timestamps"] = sliceVal
-	}
-	if until != nil {
-		sliceVal := []string{(*until).Format(time.RFC3339)}
-		query["until"] = sliceVal
-	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/logs", id),
-		RawQuery: query.Encode(),
+		Path: fmt.Sprintf("/api/v2/container/%v/stats", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
@@ -1930,39 +12675,11 @@ func LogsContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", follow)}
-		prms["follow"] = sliceVal
-	}
-	if since != nil {
-		sliceVal := []string{(*since).Format(time.RFC3339)}
-		prms["since"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", stderr)}
-		prms["stderr"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", stdout)}
-		prms["stdout"] = sliceVal
-	}
-	{
-		sliceVal := []string{tail}
-		prms["tail"] = sliceVal
-	}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", timestamps)}
-		prms["timestamps"] = sliceVal
-	}
-	if until != nil {
-		sliceVal := []string{(*until).Format(time.RFC3339)}
-		prms["until"] = sliceVal
-	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	logsCtx, _err := app.NewLogsContainerContext(goaCtx, req, service)
+	statsCtx, _err := app.NewStatsContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -1972,14 +12689,14 @@ func LogsContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 	}
 
 	// Perform action
-	_err = ctrl.Logs(logsCtx)
+	_err = ctrl.Stats(statsCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 404 {
-		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
 	}
 	var mt error
 	if resp != nil {
@@ -1994,17 +12711,16 @@ func LogsContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 	return rw, mt
 }
 
-// RemoveContainerInternalServerError runs the method Remove of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// StatsContainerNotFound runs the method Stats of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func RemoveContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, force bool) (http.ResponseWriter, error) {
+func StatsContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
-		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -2018,71 +12734,55 @@ func RemoveContainerInternalServerError(t goatest.TInterface, ctx context.Contex
 
 	// Setup request context
 	rw := httptest.NewRecorder()
-	query := url.Values{}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", force)}
-		query["force"] = sliceVal
-	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/remove", id),
-		RawQuery: query.Encode(),
+		Path: fmt.Sprintf("/api/v2/container/%v/stats", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
-	prms["id"] = []string{fmt.Sprintf("%v", id)}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", force)}
-		prms["force"] = sliceVal
-	}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	removeCtx, _err := app.NewRemoveContainerContext(goaCtx, req, service)
+	statsCtx, _err := app.NewStatsContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
-		return nil, e
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
 	}
 
 	// Perform action
-	_err = ctrl.Remove(removeCtx)
+	_err = ctrl.Stats(statsCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 500 {
-		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
-	}
-	var mt error
-	if resp != nil {
-		var _ok bool
-		mt, _ok = resp.(error)
-		if !_ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
-		}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
 	}
 
 	// Return results
-	return rw, mt
+	return rw
 }
 
-// RemoveContainerNoContent runs the method Remove of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers.
+// StatsContainerOK runs the method Stats of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func RemoveContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, force bool) http.ResponseWriter {
+func StatsContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, app.GoaContainerStatsCollection) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
+		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -2096,14 +12796,8 @@ func RemoveContainerNoContent(t goatest.TInterface, ctx context.Context, service
 
 	// Setup request context
 	rw := httptest.NewRecorder()
-	query := url.Values{}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", force)}
-		query["force"] = sliceVal
-	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/remove", id),
-		RawQuery: query.Encode(),
+		Path: fmt.Sprintf("/api/v2/container/%v/stats", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
@@ -2111,49 +12805,58 @@ func RemoveContainerNoContent(t goatest.TInterface, ctx context.Context, service
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", force)}
-		prms["force"] = sliceVal
-	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	removeCtx, _err := app.NewRemoveContainerContext(goaCtx, req, service)
+	statsCtx, _err := app.NewStatsContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
 		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil
+		return nil, nil
 	}
 
 	// Perform action
-	_err = ctrl.Remove(removeCtx)
+	_err = ctrl.Stats(statsCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 204 {
-		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt app.GoaContainerStatsCollection
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(app.GoaContainerStatsCollection)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerStatsCollection", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
 	}
 
 	// Return results
-	return rw
+	return rw, mt
 }
 
-// RemoveContainerNotFound runs the method Remove of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers.
+// StatsStreamContainerInternalServerError runs the method StatsStream of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func RemoveContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, force bool) http.ResponseWriter {
+func StatsStreamContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
+		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -2167,14 +12870,8 @@ func RemoveContainerNotFound(t goatest.TInterface, ctx context.Context, service
 
 	// Setup request context
 	rw := httptest.NewRecorder()
-	query := url.Values{}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", force)}
-		query["force"] = sliceVal
-	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/remove", id),
-		RawQuery: query.Encode(),
+		Path: fmt.Sprintf("/api/v2/container/%v/stats/stream", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
@@ -2182,49 +12879,53 @@ func RemoveContainerNotFound(t goatest.TInterface, ctx context.Context, service
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", force)}
-		prms["force"] = sliceVal
-	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	removeCtx, _err := app.NewRemoveContainerContext(goaCtx, req, service)
+	statsStreamCtx, _err := app.NewStatsStreamContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
-		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil
+		return nil, e
 	}
 
 	// Perform action
-	_err = ctrl.Remove(removeCtx)
+	_err = ctrl.StatsStream(statsStreamCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 404 {
-		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
 	}
 
 	// Return results
-	return rw
+	return rw, mt
 }
 
-// RemoveContainerRunningContainer runs the method Remove of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers.
+// StatsStreamContainerNotFound runs the method StatsStream of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func RemoveContainerRunningContainer(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, force bool) http.ResponseWriter {
+func StatsStreamContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
+		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -2238,14 +12939,8 @@ func RemoveContainerRunningContainer(t goatest.TInterface, ctx context.Context,
 
 	// Setup request context
 	rw := httptest.NewRecorder()
-	query := url.Values{}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", force)}
-		query["force"] = sliceVal
-	}
 	u := &url.URL{
-		Path:     fmt.Sprintf("/api/v2/container/%v/remove", id),
-		RawQuery: query.Encode(),
+		Path: fmt.Sprintf("/api/v2/container/%v/stats/stream", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
@@ -2253,44 +12948,47 @@ func RemoveContainerRunningContainer(t goatest.TInterface, ctx context.Context,
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
-	{
-		sliceVal := []string{fmt.Sprintf("%v", force)}
-		prms["force"] = sliceVal
-	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	removeCtx, _err := app.NewRemoveContainerContext(goaCtx, req, service)
+	statsStreamCtx, _err := app.NewStatsStreamContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
 			panic("invalid test data " + _err.Error()) // bug
 		}
-		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil
+		return nil, e
 	}
 
 	// Perform action
-	_err = ctrl.Remove(removeCtx)
+	_err = ctrl.StatsStream(statsStreamCtx)
 
 	// Validate response
 	if _err != nil {
 		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 409 {
-		t.Errorf("invalid response status code: got %+v, expected 409", rw.Code)
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
 	}
 
 	// Return results
-	return rw
+	return rw, mt
 }
 
-// SetConfigContainerInternalServerError runs the method SetConfig of the given controller with the given parameters and payload.
+// StopContainerInternalServerError runs the method Stop of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func SetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.ContainerConfig) (http.ResponseWriter, error) {
+func StopContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, timeout int) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2310,20 +13008,30 @@ func SetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Con
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/stop", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	setConfigCtx, _err := app.NewSetConfigContainerContext(goaCtx, req, service)
+	stopCtx, _err := app.NewStopContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -2331,10 +13039,9 @@ func SetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Con
 		}
 		return nil, e
 	}
-	setConfigCtx.Payload = payload
 
 	// Perform action
-	_err = ctrl.SetConfig(setConfigCtx)
+	_err = ctrl.Stop(stopCtx)
 
 	// Validate response
 	if _err != nil {
@@ -2356,11 +13063,11 @@ func SetConfigContainerInternalServerError(t goatest.TInterface, ctx context.Con
 	return rw, mt
 }
 
-// SetConfigContainerNoContent runs the method SetConfig of the given controller with the given parameters and payload.
+// StopContainerNoContent runs the method Stop of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func SetConfigContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.ContainerConfig) http.ResponseWriter {
+func StopContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, timeout int) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2379,20 +13086,30 @@ func SetConfigContainerNoContent(t goatest.TInterface, ctx context.Context, serv
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/stop", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	setConfigCtx, _err := app.NewSetConfigContainerContext(goaCtx, req, service)
+	stopCtx, _err := app.NewStopContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -2401,10 +13118,9 @@ func SetConfigContainerNoContent(t goatest.TInterface, ctx context.Context, serv
 		t.Errorf("unexpected parameter validation error: %+v", e)
 		return nil
 	}
-	setConfigCtx.Payload = payload
 
 	// Perform action
-	_err = ctrl.SetConfig(setConfigCtx)
+	_err = ctrl.Stop(stopCtx)
 
 	// Validate response
 	if _err != nil {
@@ -2418,11 +13134,11 @@ func SetConfigContainerNoContent(t goatest.TInterface, ctx context.Context, serv
 	return rw
 }
 
-// SetConfigContainerNotFound runs the method SetConfig of the given controller with the given parameters and payload.
+// StopContainerNotFound runs the method Stop of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func SetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.ContainerConfig) http.ResponseWriter {
+func StopContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, timeout int) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2441,20 +13157,30 @@ func SetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, servi
 
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		query["timeout"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/config", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/stop", id),
+		RawQuery: query.Encode(),
 	}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{strconv.Itoa(timeout)}
+		prms["timeout"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	setConfigCtx, _err := app.NewSetConfigContainerContext(goaCtx, req, service)
+	stopCtx, _err := app.NewStopContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -2463,10 +13189,9 @@ func SetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, servi
 		t.Errorf("unexpected parameter validation error: %+v", e)
 		return nil
 	}
-	setConfigCtx.Payload = payload
 
 	// Perform action
-	_err = ctrl.SetConfig(setConfigCtx)
+	_err = ctrl.Stop(stopCtx)
 
 	// Validate response
 	if _err != nil {
@@ -2480,11 +13205,11 @@ func SetConfigContainerNotFound(t goatest.TInterface, ctx context.Context, servi
 	return rw
 }
 
-// StartContainerInternalServerError runs the method Start of the given controller with the given parameters.
+// UnpauseContainerInternalServerError runs the method Unpause of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func StartContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+func UnpauseContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2505,7 +13230,7 @@ func StartContainerInternalServerError(t goatest.TInterface, ctx context.Context
 	// Setup request context
 	rw := httptest.NewRecorder()
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/start", id),
+		Path: fmt.Sprintf("/api/v2/container/%v/unpause", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
@@ -2517,7 +13242,7 @@ func StartContainerInternalServerError(t goatest.TInterface, ctx context.Context
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	startCtx, _err := app.NewStartContainerContext(goaCtx, req, service)
+	unpauseCtx, _err := app.NewUnpauseContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -2527,7 +13252,7 @@ func StartContainerInternalServerError(t goatest.TInterface, ctx context.Context
 	}
 
 	// Perform action
-	_err = ctrl.Start(startCtx)
+	_err = ctrl.Unpause(unpauseCtx)
 
 	// Validate response
 	if _err != nil {
@@ -2549,11 +13274,11 @@ func StartContainerInternalServerError(t goatest.TInterface, ctx context.Context
 	return rw, mt
 }
 
-// StartContainerNoContent runs the method Start of the given controller with the given parameters.
+// UnpauseContainerNoContent runs the method Unpause of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func StartContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+func UnpauseContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2573,7 +13298,7 @@ func StartContainerNoContent(t goatest.TInterface, ctx context.Context, service
 	// Setup request context
 	rw := httptest.NewRecorder()
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/start", id),
+		Path: fmt.Sprintf("/api/v2/container/%v/unpause", id),
 	}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
@@ -2585,7 +13310,7 @@ func StartContainerNoContent(t goatest.TInterface, ctx context.Context, service
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	startCtx, _err := app.NewStartContainerContext(goaCtx, req, service)
+	unpauseCtx, _err := app.NewUnpauseContainerContext(goaCtx, req, service)
 	if _err != nil {
 		e, ok := _err.(goa.ServiceError)
 		if !ok {
@@ -2596,7 +13321,7 @@ func StartContainerNoContent(t goatest.TInterface, ctx context.Context, service
 	}
 
 	// Perform action
-	_err = ctrl.Start(startCtx)
+	_err = ctrl.Unpause(unpauseCtx)
 
 	// Validate response
 	if _err != nil {
@@ -2610,11 +13335,11 @@ func StartContainerNoContent(t goatest.TInterface, ctx context.Context, service
 	return rw
 }
 
-// StartContainerNotFound runs the method Start of the given controller with the given parameters.
+// UnpauseContainerNotFound runs the method Unpause of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func StartContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+func UnpauseContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2634,11 +13359,83 @@ func StartContainerNotFound(t goatest.TInterface, ctx context.Context, service *
 	// Setup request context
 	rw := httptest.NewRecorder()
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/start", id),
+		Path: fmt.Sprintf("/api/v2/container/%v/unpause", id),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	unpauseCtx, _err := app.NewUnpauseContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
+	}
+
+	// Perform action
+	_err = ctrl.Unpause(unpauseCtx)
+
+	// Validate response
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	}
+	if rw.Code != 404 {
+		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// UploadContainerBadRequest runs the method Upload of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func UploadContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		return nil, e
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	u := &url.URL{
+		Path: fmt.Sprintf("/api/v2/container/%v/upload", id),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		panic("invalid test " + err.Error()) // bug
+	req, _err := http.NewRequest("POST", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
@@ -2646,36 +13443,44 @@ func StartContainerNotFound(t goatest.TInterface, ctx context.Context, service *
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	startCtx, _err := app.NewStartContainerContext(goaCtx, req, service)
-	if _err != nil {
-		e, ok := _err.(goa.ServiceError)
-		if !ok {
-			panic("invalid test data " + _err.Error()) // bug
+	uploadCtx, __err := app.NewUploadContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
 		}
-		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil
+		return nil, _e
 	}
+	uploadCtx.Payload = payload
 
 	// Perform action
-	_err = ctrl.Start(startCtx)
+	__err = ctrl.Upload(uploadCtx)
 
 	// Validate response
-	if _err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
 	}
-	if rw.Code != 404 {
-		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
+	if rw.Code != 400 {
+		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var __ok bool
+		mt, __ok = resp.(error)
+		if !__ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
 	}
 
 	// Return results
-	return rw
+	return rw, mt
 }
 
-// StopContainerInternalServerError runs the method Stop of the given controller with the given parameters.
+// UploadContainerInternalServerError runs the method Upload of the given controller with the given parameters and payload.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func StopContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) (http.ResponseWriter, error) {
+func UploadContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2693,14 +13498,24 @@ func StopContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 		service.Encoder.Register(newEncoder, "*/*")
 	}
 
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		return nil, e
+	}
+
 	// Setup request context
 	rw := httptest.NewRecorder()
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/stop", id),
+		Path: fmt.Sprintf("/api/v2/container/%v/upload", id),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		panic("invalid test " + err.Error()) // bug
+	req, _err := http.NewRequest("POST", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
@@ -2708,30 +13523,31 @@ func StopContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	stopCtx, _err := app.NewStopContainerContext(goaCtx, req, service)
-	if _err != nil {
-		e, ok := _err.(goa.ServiceError)
-		if !ok {
-			panic("invalid test data " + _err.Error()) // bug
+	uploadCtx, __err := app.NewUploadContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
 		}
-		return nil, e
+		return nil, _e
 	}
+	uploadCtx.Payload = payload
 
 	// Perform action
-	_err = ctrl.Stop(stopCtx)
+	__err = ctrl.Upload(uploadCtx)
 
 	// Validate response
-	if _err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
 	}
 	if rw.Code != 500 {
 		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
 	}
 	var mt error
 	if resp != nil {
-		var _ok bool
-		mt, _ok = resp.(error)
-		if !_ok {
+		var __ok bool
+		mt, __ok = resp.(error)
+		if !__ok {
 			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
 		}
 	}
@@ -2740,11 +13556,11 @@ func StopContainerInternalServerError(t goatest.TInterface, ctx context.Context,
 	return rw, mt
 }
 
-// StopContainerNoContent runs the method Stop of the given controller with the given parameters.
+// UploadContainerNoContent runs the method Upload of the given controller with the given parameters and payload.
 // It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func StopContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+func UploadContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2761,14 +13577,25 @@ func StopContainerNoContent(t goatest.TInterface, ctx context.Context, service *
 		service.Encoder.Register(newEncoder, "*/*")
 	}
 
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		t.Errorf("unexpected payload validation error: %+v", e)
+		return nil
+	}
+
 	// Setup request context
 	rw := httptest.NewRecorder()
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/stop", id),
+		Path: fmt.Sprintf("/api/v2/container/%v/upload", id),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		panic("invalid test " + err.Error()) // bug
+	req, _err := http.NewRequest("POST", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
@@ -2776,22 +13603,23 @@ func StopContainerNoContent(t goatest.TInterface, ctx context.Context, service *
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	stopCtx, _err := app.NewStopContainerContext(goaCtx, req, service)
-	if _err != nil {
-		e, ok := _err.(goa.ServiceError)
-		if !ok {
-			panic("invalid test data " + _err.Error()) // bug
+	uploadCtx, __err := app.NewUploadContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
 		}
-		t.Errorf("unexpected parameter validation error: %+v", e)
+		t.Errorf("unexpected parameter validation error: %+v", _e)
 		return nil
 	}
+	uploadCtx.Payload = payload
 
 	// Perform action
-	_err = ctrl.Stop(stopCtx)
+	__err = ctrl.Upload(uploadCtx)
 
 	// Validate response
-	if _err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
 	}
 	if rw.Code != 204 {
 		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
@@ -2801,16 +13629,17 @@ func StopContainerNoContent(t goatest.TInterface, ctx context.Context, service *
 	return rw
 }
 
-// StopContainerNotFound runs the method Stop of the given controller with the given parameters.
-// It returns the response writer so it's possible to inspect the response headers.
+// UploadContainerNotFound runs the method Upload of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func StopContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string) http.ResponseWriter {
+func UploadContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
+		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -2822,14 +13651,24 @@ func StopContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 		service.Encoder.Register(newEncoder, "*/*")
 	}
 
+	// Validate payload
+	err := payload.Validate()
+	if err != nil {
+		e, ok := err.(goa.ServiceError)
+		if !ok {
+			panic(err) // bug
+		}
+		return nil, e
+	}
+
 	// Setup request context
 	rw := httptest.NewRecorder()
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/stop", id),
+		Path: fmt.Sprintf("/api/v2/container/%v/upload", id),
 	}
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		panic("invalid test " + err.Error()) // bug
+	req, _err := http.NewRequest("POST", u.String(), nil)
+	if _err != nil {
+		panic("invalid test " + _err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
@@ -2837,42 +13676,49 @@ func StopContainerNotFound(t goatest.TInterface, ctx context.Context, service *g
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	stopCtx, _err := app.NewStopContainerContext(goaCtx, req, service)
-	if _err != nil {
-		e, ok := _err.(goa.ServiceError)
-		if !ok {
-			panic("invalid test data " + _err.Error()) // bug
+	uploadCtx, __err := app.NewUploadContainerContext(goaCtx, req, service)
+	if __err != nil {
+		_e, _ok := __err.(goa.ServiceError)
+		if !_ok {
+			panic("invalid test data " + __err.Error()) // bug
 		}
-		t.Errorf("unexpected parameter validation error: %+v", e)
-		return nil
+		return nil, _e
 	}
+	uploadCtx.Payload = payload
 
 	// Perform action
-	_err = ctrl.Stop(stopCtx)
+	__err = ctrl.Upload(uploadCtx)
 
 	// Validate response
-	if _err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
 	}
 	if rw.Code != 404 {
 		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
 	}
+	var mt error
+	if resp != nil {
+		var __ok bool
+		mt, __ok = resp.(error)
+		if !__ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
+	}
 
 	// Return results
-	return rw
+	return rw, mt
 }
 
-// UploadContainerBadRequest runs the method Upload of the given controller with the given parameters and payload.
-// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// UploadContainerRequestEntityTooLarge runs the method Upload of the given controller with the given parameters and payload.
+// It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func UploadContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) (http.ResponseWriter, error) {
+func UploadContainerRequestEntityTooLarge(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
-		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -2891,7 +13737,8 @@ func UploadContainerBadRequest(t goatest.TInterface, ctx context.Context, servic
 		if !ok {
 			panic(err) // bug
 		}
-		return nil, e
+		t.Errorf("unexpected payload validation error: %+v", e)
+		return nil
 	}
 
 	// Setup request context
@@ -2915,25 +13762,97 @@ func UploadContainerBadRequest(t goatest.TInterface, ctx context.Context, servic
 		if !_ok {
 			panic("invalid test data " + __err.Error()) // bug
 		}
-		return nil, _e
+		t.Errorf("unexpected parameter validation error: %+v", _e)
+		return nil
+	}
+	uploadCtx.Payload = payload
+
+	// Perform action
+	__err = ctrl.Upload(uploadCtx)
+
+	// Validate response
+	if __err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	}
+	if rw.Code != 413 {
+		t.Errorf("invalid response status code: got %+v, expected 413", rw.Code)
+	}
+
+	// Return results
+	return rw
+}
+
+// VerifyDomainContainerBadRequest runs the method VerifyDomain of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// If ctx is nil then context.Background() is used.
+// If service is nil then a default service is created.
+func VerifyDomainContainerBadRequest(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, error) {
+	// Setup service
+	var (
+		logBuf bytes.Buffer
+		resp   interface{}
+
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	)
+	if service == nil {
+		service = goatest.Service(&logBuf, respSetter)
+	} else {
+		logger := log.New(&logBuf, "", log.Ltime)
+		service.WithLogger(goa.NewLogger(logger))
+		newEncoder := func(io.Writer) goa.Encoder { return respSetter }
+		service.Encoder = goa.NewHTTPEncoder() // Make sure the code ends up using this decoder
+		service.Encoder.Register(newEncoder, "*/*")
+	}
+
+	// Setup request context
+	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
+	}
+	u := &url.URL{
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains/verify", id),
+		RawQuery: query.Encode(),
+	}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
+	}
+	prms := url.Values{}
+	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
+	verifyDomainCtx, _err := app.NewVerifyDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
+		}
+		return nil, e
 	}
-	uploadCtx.Payload = payload
 
 	// Perform action
-	__err = ctrl.Upload(uploadCtx)
+	_err = ctrl.VerifyDomain(verifyDomainCtx)
 
 	// Validate response
-	if __err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
 	if rw.Code != 400 {
 		t.Errorf("invalid response status code: got %+v, expected 400", rw.Code)
 	}
 	var mt error
 	if resp != nil {
-		var __ok bool
-		mt, __ok = resp.(error)
-		if !__ok {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
 			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
 		}
 	}
@@ -2942,11 +13861,11 @@ func UploadContainerBadRequest(t goatest.TInterface, ctx context.Context, servic
 	return rw, mt
 }
 
-// UploadContainerInternalServerError runs the method Upload of the given controller with the given parameters and payload.
+// VerifyDomainContainerConflict runs the method VerifyDomain of the given controller with the given parameters.
 // It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func UploadContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) (http.ResponseWriter, error) {
+func VerifyDomainContainerConflict(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
@@ -2964,56 +13883,55 @@ func UploadContainerInternalServerError(t goatest.TInterface, ctx context.Contex
 		service.Encoder.Register(newEncoder, "*/*")
 	}
 
-	// Validate payload
-	err := payload.Validate()
-	if err != nil {
-		e, ok := err.(goa.ServiceError)
-		if !ok {
-			panic(err) // bug
-		}
-		return nil, e
-	}
-
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/upload", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains/verify", id),
+		RawQuery: query.Encode(),
 	}
-	req, _err := http.NewRequest("POST", u.String(), nil)
-	if _err != nil {
-		panic("invalid test " + _err.Error()) // bug
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	uploadCtx, __err := app.NewUploadContainerContext(goaCtx, req, service)
-	if __err != nil {
-		_e, _ok := __err.(goa.ServiceError)
-		if !_ok {
-			panic("invalid test data " + __err.Error()) // bug
+	verifyDomainCtx, _err := app.NewVerifyDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
 		}
-		return nil, _e
+		return nil, e
 	}
-	uploadCtx.Payload = payload
 
 	// Perform action
-	__err = ctrl.Upload(uploadCtx)
+	_err = ctrl.VerifyDomain(verifyDomainCtx)
 
 	// Validate response
-	if __err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 500 {
-		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	if rw.Code != 409 {
+		t.Errorf("invalid response status code: got %+v, expected 409", rw.Code)
 	}
 	var mt error
 	if resp != nil {
-		var __ok bool
-		mt, __ok = resp.(error)
-		if !__ok {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
 			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
 		}
 	}
@@ -3022,16 +13940,17 @@ func UploadContainerInternalServerError(t goatest.TInterface, ctx context.Contex
 	return rw, mt
 }
 
-// UploadContainerNoContent runs the method Upload of the given controller with the given parameters and payload.
-// It returns the response writer so it's possible to inspect the response headers.
+// VerifyDomainContainerInternalServerError runs the method VerifyDomain of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func UploadContainerNoContent(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) http.ResponseWriter {
+func VerifyDomainContainerInternalServerError(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, error) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
+		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -3043,69 +13962,73 @@ func UploadContainerNoContent(t goatest.TInterface, ctx context.Context, service
 		service.Encoder.Register(newEncoder, "*/*")
 	}
 
-	// Validate payload
-	err := payload.Validate()
-	if err != nil {
-		e, ok := err.(goa.ServiceError)
-		if !ok {
-			panic(err) // bug
-		}
-		t.Errorf("unexpected payload validation error: %+v", e)
-		return nil
-	}
-
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/upload", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains/verify", id),
+		RawQuery: query.Encode(),
 	}
-	req, _err := http.NewRequest("POST", u.String(), nil)
-	if _err != nil {
-		panic("invalid test " + _err.Error()) // bug
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	uploadCtx, __err := app.NewUploadContainerContext(goaCtx, req, service)
-	if __err != nil {
-		_e, _ok := __err.(goa.ServiceError)
-		if !_ok {
-			panic("invalid test data " + __err.Error()) // bug
+	verifyDomainCtx, _err := app.NewVerifyDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
 		}
-		t.Errorf("unexpected parameter validation error: %+v", _e)
-		return nil
+		return nil, e
 	}
-	uploadCtx.Payload = payload
 
 	// Perform action
-	__err = ctrl.Upload(uploadCtx)
+	_err = ctrl.VerifyDomain(verifyDomainCtx)
 
 	// Validate response
-	if __err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 204 {
-		t.Errorf("invalid response status code: got %+v, expected 204", rw.Code)
+	if rw.Code != 500 {
+		t.Errorf("invalid response status code: got %+v, expected 500", rw.Code)
+	}
+	var mt error
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(error)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
+		}
 	}
 
 	// Return results
-	return rw
+	return rw, mt
 }
 
-// UploadContainerNotFound runs the method Upload of the given controller with the given parameters and payload.
-// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
+// VerifyDomainContainerNotFound runs the method VerifyDomain of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func UploadContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) (http.ResponseWriter, error) {
+func VerifyDomainContainerNotFound(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) http.ResponseWriter {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
-		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -3117,74 +14040,67 @@ func UploadContainerNotFound(t goatest.TInterface, ctx context.Context, service
 		service.Encoder.Register(newEncoder, "*/*")
 	}
 
-	// Validate payload
-	err := payload.Validate()
-	if err != nil {
-		e, ok := err.(goa.ServiceError)
-		if !ok {
-			panic(err) // bug
-		}
-		return nil, e
-	}
-
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/upload", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains/verify", id),
+		RawQuery: query.Encode(),
 	}
-	req, _err := http.NewRequest("POST", u.String(), nil)
-	if _err != nil {
-		panic("invalid test " + _err.Error()) // bug
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	uploadCtx, __err := app.NewUploadContainerContext(goaCtx, req, service)
-	if __err != nil {
-		_e, _ok := __err.(goa.ServiceError)
-		if !_ok {
-			panic("invalid test data " + __err.Error()) // bug
+	verifyDomainCtx, _err := app.NewVerifyDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
 		}
-		return nil, _e
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil
 	}
-	uploadCtx.Payload = payload
 
 	// Perform action
-	__err = ctrl.Upload(uploadCtx)
+	_err = ctrl.VerifyDomain(verifyDomainCtx)
 
 	// Validate response
-	if __err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
 	if rw.Code != 404 {
 		t.Errorf("invalid response status code: got %+v, expected 404", rw.Code)
 	}
-	var mt error
-	if resp != nil {
-		var __ok bool
-		mt, __ok = resp.(error)
-		if !__ok {
-			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of error", resp, resp)
-		}
-	}
 
 	// Return results
-	return rw, mt
+	return rw
 }
 
-// UploadContainerRequestEntityTooLarge runs the method Upload of the given controller with the given parameters and payload.
-// It returns the response writer so it's possible to inspect the response headers.
+// VerifyDomainContainerOK runs the method VerifyDomain of the given controller with the given parameters.
+// It returns the response writer so it's possible to inspect the response headers and the media type struct written to the response.
 // If ctx is nil then context.Background() is used.
 // If service is nil then a default service is created.
-func UploadContainerRequestEntityTooLarge(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, payload *app.UploadPayload) http.ResponseWriter {
+func VerifyDomainContainerOK(t goatest.TInterface, ctx context.Context, service *goa.Service, ctrl app.ContainerController, id string, domain string) (http.ResponseWriter, *app.GoaContainerDomain) {
 	// Setup service
 	var (
 		logBuf bytes.Buffer
+		resp   interface{}
 
-		respSetter goatest.ResponseSetterFunc = func(r interface{}) {}
+		respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
 	)
 	if service == nil {
 		service = goatest.Service(&logBuf, respSetter)
@@ -3196,54 +14112,64 @@ func UploadContainerRequestEntityTooLarge(t goatest.TInterface, ctx context.Cont
 		service.Encoder.Register(newEncoder, "*/*")
 	}
 
-	// Validate payload
-	err := payload.Validate()
-	if err != nil {
-		e, ok := err.(goa.ServiceError)
-		if !ok {
-			panic(err) // bug
-		}
-		t.Errorf("unexpected payload validation error: %+v", e)
-		return nil
-	}
-
 	// Setup request context
 	rw := httptest.NewRecorder()
+	query := url.Values{}
+	{
+		sliceVal := []string{domain}
+		query["domain"] = sliceVal
+	}
 	u := &url.URL{
-		Path: fmt.Sprintf("/api/v2/container/%v/upload", id),
+		Path:     fmt.Sprintf("/api/v2/container/%v/domains/verify", id),
+		RawQuery: query.Encode(),
 	}
-	req, _err := http.NewRequest("POST", u.String(), nil)
-	if _err != nil {
-		panic("invalid test " + _err.Error()) // bug
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		panic("invalid test " + err.Error()) // bug
 	}
 	prms := url.Values{}
 	prms["id"] = []string{fmt.Sprintf("%v", id)}
+	{
+		sliceVal := []string{domain}
+		prms["domain"] = sliceVal
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	goaCtx := goa.NewContext(goa.WithAction(ctx, "ContainerTest"), rw, req, prms)
-	uploadCtx, __err := app.NewUploadContainerContext(goaCtx, req, service)
-	if __err != nil {
-		_e, _ok := __err.(goa.ServiceError)
-		if !_ok {
-			panic("invalid test data " + __err.Error()) // bug
+	verifyDomainCtx, _err := app.NewVerifyDomainContainerContext(goaCtx, req, service)
+	if _err != nil {
+		e, ok := _err.(goa.ServiceError)
+		if !ok {
+			panic("invalid test data " + _err.Error()) // bug
 		}
-		t.Errorf("unexpected parameter validation error: %+v", _e)
-		return nil
+		t.Errorf("unexpected parameter validation error: %+v", e)
+		return nil, nil
 	}
-	uploadCtx.Payload = payload
 
 	// Perform action
-	__err = ctrl.Upload(uploadCtx)
+	_err = ctrl.VerifyDomain(verifyDomainCtx)
 
 	// Validate response
-	if __err != nil {
-		t.Fatalf("controller returned %+v, logs:\n%s", __err, logBuf.String())
+	if _err != nil {
+		t.Fatalf("controller returned %+v, logs:\n%s", _err, logBuf.String())
 	}
-	if rw.Code != 413 {
-		t.Errorf("invalid response status code: got %+v, expected 413", rw.Code)
+	if rw.Code != 200 {
+		t.Errorf("invalid response status code: got %+v, expected 200", rw.Code)
+	}
+	var mt *app.GoaContainerDomain
+	if resp != nil {
+		var _ok bool
+		mt, _ok = resp.(*app.GoaContainerDomain)
+		if !_ok {
+			t.Fatalf("invalid response media: got variable of type %T, value %+v, expected instance of app.GoaContainerDomain", resp, resp)
+		}
+		_err = mt.Validate()
+		if _err != nil {
+			t.Errorf("invalid response media type: %s", _err)
+		}
 	}
 
 	// Return results
-	return rw
+	return rw, mt
 }
@@ -25,6 +25,188 @@ import (
 	"time"
 )
 
+// AddDomainContainerPath computes a request path to the addDomain action of container.
+func AddDomainContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/domains", param0)
+}
+
+// Claim a custom domain for a container. Requests for the domain are routed to the container after the ownership is verified
+func (c *Client) AddDomainContainer(ctx context.Context, path string, domain string) (*http.Response, error) {
+	req, err := c.NewAddDomainContainerRequest(ctx, path, domain)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewAddDomainContainerRequest create the request corresponding to the addDomain action endpoint of the container resource.
+func (c *Client) NewAddDomainContainerRequest(ctx context.Context, path string, domain string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("domain", domain)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// AddRouteContainerPath computes a request path to the addRoute action of container.
+func AddRouteContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/routes", param0)
+}
+
+// Route requests for the path prefix on the host to a container. The host must be a subdomain of your container or a verified custom domain
+func (c *Client) AddRouteContainer(ctx context.Context, path string, host string, pathPrefix string, priority *int, stripPrefix *bool) (*http.Response, error) {
+	req, err := c.NewAddRouteContainerRequest(ctx, path, host, pathPrefix, priority, stripPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewAddRouteContainerRequest create the request corresponding to the addRoute action endpoint of the container resource.
+func (c *Client) NewAddRouteContainerRequest(ctx context.Context, path string, host string, pathPrefix string, priority *int, stripPrefix *bool) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("host", host)
+	values.Set("pathPrefix", pathPrefix)
+	if priority != nil {
+		tmp81 := strconv.Itoa(*priority)
+		values.Set("priority", tmp81)
+	}
+	if stripPrefix != nil {
+		tmp82 := strconv.FormatBool(*stripPrefix)
+		values.Set("stripPrefix", tmp82)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// AddScheduleContainerPath computes a request path to the addSchedule action of container.
+func AddScheduleContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/schedules", param0)
+}
+
+// Start or stop a container on a schedule. Specify action and schedule, or window which adds a pair of schedules starting and stopping it
+func (c *Client) AddScheduleContainer(ctx context.Context, path string, action *string, schedule *string, timezone *string, window *string) (*http.Response, error) {
+	req, err := c.NewAddScheduleContainerRequest(ctx, path, action, schedule, timezone, window)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewAddScheduleContainerRequest create the request corresponding to the addSchedule action endpoint of the container resource.
+func (c *Client) NewAddScheduleContainerRequest(ctx context.Context, path string, action *string, schedule *string, timezone *string, window *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if action != nil {
+		values.Set("action", *action)
+	}
+	if schedule != nil {
+		values.Set("schedule", *schedule)
+	}
+	if timezone != nil {
+		values.Set("timezone", *timezone)
+	}
+	if window != nil {
+		values.Set("window", *window)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// AddSecretContainerPath computes a request path to the addSecret action of container.
+func AddSecretContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/secrets", param0)
+}
+
+// Inject your secret into a container as an environment variable or a file. The container is recreated to apply it
+func (c *Client) AddSecretContainer(ctx context.Context, path string, name string, deferred *bool, env *string, file *string) (*http.Response, error) {
+	req, err := c.NewAddSecretContainerRequest(ctx, path, name, deferred, env, file)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewAddSecretContainerRequest create the request corresponding to the addSecret action endpoint of the container resource.
+func (c *Client) NewAddSecretContainerRequest(ctx context.Context, path string, name string, deferred *bool, env *string, file *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("name", name)
+	if deferred != nil {
+		tmp83 := strconv.FormatBool(*deferred)
+		values.Set("deferred", tmp83)
+	}
+	if env != nil {
+		values.Set("env", *env)
+	}
+	if file != nil {
+		values.Set("file", *file)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
 // CreateContainerPath computes a request path to the create action of container.
 func CreateContainerPath() string {
 
@@ -32,8 +214,8 @@ func CreateContainerPath() string {
 }
 
 // create a new container
-func (c *Client) CreateContainer(ctx context.Context, path string, image string, name string, command []string, entrypoint []string, env []string, sslRedirect *bool, volumes []string, workingDir *string) (*http.Response, error) {
-	req, err := c.NewCreateContainerRequest(ctx, path, image, name, command, entrypoint, env, sslRedirect, volumes, workingDir)
+func (c *Client) CreateContainer(ctx context.Context, path string, image string, name string, command []string, cpu *int, entrypoint []string, env []string, expiresAt *time.Time, healthCheckCommand []string, healthCheckInterval *int, healthCheckPath *string, healthCheckRetries *int, healthCheckStartPeriod *int, healthCheckTimeout *int, idleTimeout *int, kind *string, memory *string, metricsPath *string, metricsPort *int, pids *int, port *int, ports []string, protocol *string, replicas *int, restartMaxRetries *int, restartPolicy *string, secrets []string, sslRedirect *bool, storage *string, ttl *int, volumes []string, workingDir *string) (*http.Response, error) {
+	req, err := c.NewCreateContainerRequest(ctx, path, image, name, command, cpu, entrypoint, env, expiresAt, healthCheckCommand, healthCheckInterval, healthCheckPath, healthCheckRetries, healthCheckStartPeriod, healthCheckTimeout, idleTimeout, kind, memory, metricsPath, metricsPort, pids, port, ports, protocol, replicas, restartMaxRetries, restartPolicy, secrets, sslRedirect, storage, ttl, volumes, workingDir)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +223,7 @@ func (c *Client) CreateContainer(ctx context.Context, path string, image string,
 }
 
 // NewCreateContainerRequest create the request corresponding to the create action endpoint of the container resource.
-func (c *Client) NewCreateContainerRequest(ctx context.Context, path string, image string, name string, command []string, entrypoint []string, env []string, sslRedirect *bool, volumes []string, workingDir *string) (*http.Request, error) {
+func (c *Client) NewCreateContainerRequest(ctx context.Context, path string, image string, name string, command []string, cpu *int, entrypoint []string, env []string, expiresAt *time.Time, healthCheckCommand []string, healthCheckInterval *int, healthCheckPath *string, healthCheckRetries *int, healthCheckStartPeriod *int, healthCheckTimeout *int, idleTimeout *int, kind *string, memory *string, metricsPath *string, metricsPort *int, pids *int, port *int, ports []string, protocol *string, replicas *int, restartMaxRetries *int, restartPolicy *string, secrets []string, sslRedirect *bool, storage *string, ttl *int, volumes []string, workingDir *string) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "https"
@@ -51,24 +233,109 @@ func (c *Client) NewCreateContainerRequest(ctx context.Context, path string, ima
 	values.Set("image", image)
 	values.Set("name", name)
 	for _, p := range command {
-		tmp29 := p
-		values.Add("command", tmp29)
+		tmp84 := p
+		values.Add("command", tmp84)
+	}
+	if cpu != nil {
+		tmp85 := strconv.Itoa(*cpu)
+		values.Set("cpu", tmp85)
 	}
 	for _, p := range entrypoint {
-		tmp30 := p
-		values.Add("entrypoint", tmp30)
+		tmp86 := p
+		values.Add("entrypoint", tmp86)
 	}
 	for _, p := range env {
-		tmp31 := p
-		values.Add("env", tmp31)
+		tmp87 := p
+		values.Add("env", tmp87)
+	}
+	if expiresAt != nil {
+		tmp88 := expiresAt.Format(time.RFC3339)
+		values.Set("expiresAt", tmp88)
+	}
+	for _, p := range healthCheckCommand {
+		tmp89 := p
+		values.Add("healthCheckCommand", tmp89)
+	}
+	if healthCheckInterval != nil {
+		tmp90 := strconv.Itoa(*healthCheckInterval)
+		values.Set("healthCheckInterval", tmp90)
+	}
+	if healthCheckPath != nil {
+		values.Set("healthCheckPath", *healthCheckPath)
+	}
+	if healthCheckRetries != nil {
+		tmp91 := strconv.Itoa(*healthCheckRetries)
+		values.Set("healthCheckRetries", tmp91)
+	}
+	if healthCheckStartPeriod != nil {
+		tmp92 := strconv.Itoa(*healthCheckStartPeriod)
+		values.Set("healthCheckStartPeriod", tmp92)
+	}
+	if healthCheckTimeout != nil {
+		tmp93 := strconv.Itoa(*healthCheckTimeout)
+		values.Set("healthCheckTimeout", tmp93)
+	}
+	if idleTimeout != nil {
+		tmp94 := strconv.Itoa(*idleTimeout)
+		values.Set("idleTimeout", tmp94)
+	}
+	if kind != nil {
+		values.Set("kind", *kind)
+	}
+	if memory != nil {
+		values.Set("memory", *memory)
+	}
+	if metricsPath != nil {
+		values.Set("metricsPath", *metricsPath)
+	}
+	if metricsPort != nil {
+		tmp95 := strconv.Itoa(*metricsPort)
+		values.Set("metricsPort", tmp95)
+	}
+	if pids != nil {
+		tmp96 := strconv.Itoa(*pids)
+		values.Set("pids", tmp96)
+	}
+	if port != nil {
+		tmp97 := strconv.Itoa(*port)
+		values.Set("port", tmp97)
+	}
+	for _, p := range ports {
+		tmp98 := p
+		values.Add("ports", tmp98)
+	}
+	if protocol != nil {
+		values.Set("protocol", *protocol)
+	}
+	if replicas != nil {
+		tmp99 := strconv.Itoa(*replicas)
+		values.Set("replicas", tmp99)
+	}
+	if restartMaxRetries != nil {
+		tmp100 := strconv.Itoa(*restartMaxRetries)
+		values.Set("restartMaxRetries", tmp100)
+	}
+	if restartPolicy != nil {
+		values.Set("restartPolicy", *restartPolicy)
+	}
+	for _, p := range secrets {
+		tmp101 := p
+		values.Add("secrets", tmp101)
 	}
 	if sslRedirect != nil {
-		tmp32 := strconv.FormatBool(*sslRedirect)
-		values.Set("sslRedirect", tmp32)
+		tmp102 := strconv.FormatBool(*sslRedirect)
+		values.Set("sslRedirect", tmp102)
+	}
+	if storage != nil {
+		values.Set("storage", *storage)
+	}
+	if ttl != nil {
+		tmp103 := strconv.Itoa(*ttl)
+		values.Set("ttl", tmp103)
 	}
 	for _, p := range volumes {
-		tmp33 := p
-		values.Add("volumes", tmp33)
+		tmp104 := p
+		values.Add("volumes", tmp104)
 	}
 	if workingDir != nil {
 		values.Set("workingDir", *workingDir)
@@ -114,11 +381,1045 @@ func (c *Client) NewDownloadContainerRequest(ctx context.Context, path string, i
 	if scheme == "" {
 		scheme = "https"
 	}
-	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
-	values := u.Query()
-	values.Set("internalPath", internalPath)
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("internalPath", internalPath)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// EventsContainerPath computes a request path to the events action of container.
+func EventsContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/events", param0)
+}
+
+// Return events of a container, newest first
+func (c *Client) EventsContainer(ctx context.Context, path string, limit *int) (*http.Response, error) {
+	req, err := c.NewEventsContainerRequest(ctx, path, limit)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewEventsContainerRequest create the request corresponding to the events action endpoint of the container resource.
+func (c *Client) NewEventsContainerRequest(ctx context.Context, path string, limit *int) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if limit != nil {
+		tmp105 := strconv.Itoa(*limit)
+		values.Set("limit", tmp105)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// ExecContainerPath computes a request path to the exec action of container.
+func ExecContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/exec", param0)
+}
+
+// Exec a command with attaching to a container using WebSocket(Mainly for xterm.js, using a protocol for terminado)
+func (c *Client) ExecContainer(ctx context.Context, path string, command []string, tty *bool) (*websocket.Conn, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "ws"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if command != nil {
+		for _, p := range command {
+			tmp106 := p
+			values.Add("command", tmp106)
+		}
+	}
+	if tty != nil {
+		tmp107 := strconv.FormatBool(*tty)
+		values.Set("tty", tmp107)
+	}
+	u.RawQuery = values.Encode()
+	url_ := u.String()
+	cfg, err := websocket.NewConfig(url_, url_)
+	if err != nil {
+		return nil, err
+	}
+	return websocket.DialConfig(cfg)
+}
+
+// ExtendContainerPath computes a request path to the extend action of container.
+func ExtendContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/extend", param0)
+}
+
+// Change the time the container is removed at. The container is kept if neither ttl nor expiresAt is specified
+func (c *Client) ExtendContainer(ctx context.Context, path string, expiresAt *time.Time, ttl *int) (*http.Response, error) {
+	req, err := c.NewExtendContainerRequest(ctx, path, expiresAt, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewExtendContainerRequest create the request corresponding to the extend action endpoint of the container resource.
+func (c *Client) NewExtendContainerRequest(ctx context.Context, path string, expiresAt *time.Time, ttl *int) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if expiresAt != nil {
+		tmp108 := expiresAt.Format(time.RFC3339)
+		values.Set("expiresAt", tmp108)
+	}
+	if ttl != nil {
+		tmp109 := strconv.Itoa(*ttl)
+		values.Set("ttl", tmp109)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// GetConfigContainerPath computes a request path to the getConfig action of container.
+func GetConfigContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/config", param0)
+}
+
+// Get the config of a container
+func (c *Client) GetConfigContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewGetConfigContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewGetConfigContainerRequest create the request corresponding to the getConfig action endpoint of the container resource.
+func (c *Client) NewGetConfigContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// GetEnvContainerPath computes a request path to the getEnv action of container.
+func GetEnvContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/env", param0)
+}
+
+// Return environment variables of a container
+func (c *Client) GetEnvContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewGetEnvContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewGetEnvContainerRequest create the request corresponding to the getEnv action endpoint of the container resource.
+func (c *Client) NewGetEnvContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// InspectContainerPath computes a request path to the inspect action of container.
+func InspectContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/inspect", param0)
+}
+
+// Return details of a container
+func (c *Client) InspectContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewInspectContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewInspectContainerRequest create the request corresponding to the inspect action endpoint of the container resource.
+func (c *Client) NewInspectContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// ListContainerPath computes a request path to the list action of container.
+func ListContainerPath() string {
+
+	return fmt.Sprintf("/api/v2/container/list")
+}
+
+// Return a list of containers
+func (c *Client) ListContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewListContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewListContainerRequest create the request corresponding to the list action endpoint of the container resource.
+func (c *Client) NewListContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// ListDomainsContainerPath computes a request path to the listDomains action of container.
+func ListDomainsContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/domains", param0)
+}
+
+// Return custom domains of a container
+func (c *Client) ListDomainsContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewListDomainsContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewListDomainsContainerRequest create the request corresponding to the listDomains action endpoint of the container resource.
+func (c *Client) NewListDomainsContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// ListRoutesContainerPath computes a request path to the listRoutes action of container.
+func ListRoutesContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/routes", param0)
+}
+
+// Return path-prefix routes to a container
+func (c *Client) ListRoutesContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewListRoutesContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewListRoutesContainerRequest create the request corresponding to the listRoutes action endpoint of the container resource.
+func (c *Client) NewListRoutesContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// ListSchedulesContainerPath computes a request path to the listSchedules action of container.
+func ListSchedulesContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/schedules", param0)
+}
+
+// Return schedules starting or stopping a container
+func (c *Client) ListSchedulesContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewListSchedulesContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewListSchedulesContainerRequest create the request corresponding to the listSchedules action endpoint of the container resource.
+func (c *Client) NewListSchedulesContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// ListSecretsContainerPath computes a request path to the listSecrets action of container.
+func ListSecretsContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/secrets", param0)
+}
+
+// Return secrets injected into a container
+func (c *Client) ListSecretsContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewListSecretsContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewListSecretsContainerRequest create the request corresponding to the listSecrets action endpoint of the container resource.
+func (c *Client) NewListSecretsContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// LogsContainerPath computes a request path to the logs action of container.
+func LogsContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/logs", param0)
+}
+
+// Get stdout and stderr logs from a container. Lines are prefixed with [replica <n>] if the container has multiple replicas
+func (c *Client) LogsContainer(ctx context.Context, path string, follow *bool, since *time.Time, stderr *bool, stdout *bool, tail *string, timestamps *bool, until *time.Time) (*websocket.Conn, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "ws"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if follow != nil {
+		tmp110 := strconv.FormatBool(*follow)
+		values.Set("follow", tmp110)
+	}
+	if since != nil {
+		tmp111 := since.Format(time.RFC3339)
+		values.Set("since", tmp111)
+	}
+	if stderr != nil {
+		tmp112 := strconv.FormatBool(*stderr)
+		values.Set("stderr", tmp112)
+	}
+	if stdout != nil {
+		tmp113 := strconv.FormatBool(*stdout)
+		values.Set("stdout", tmp113)
+	}
+	if tail != nil {
+		values.Set("tail", *tail)
+	}
+	if timestamps != nil {
+		tmp114 := strconv.FormatBool(*timestamps)
+		values.Set("timestamps", tmp114)
+	}
+	if until != nil {
+		tmp115 := until.Format(time.RFC3339)
+		values.Set("until", tmp115)
+	}
+	u.RawQuery = values.Encode()
+	url_ := u.String()
+	cfg, err := websocket.NewConfig(url_, url_)
+	if err != nil {
+		return nil, err
+	}
+	return websocket.DialConfig(cfg)
+}
+
+// MetricsContainerPath computes a request path to the metrics action of container.
+func MetricsContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/metrics", param0)
+}
+
+// Return the history of the resource usage of a container. Samples are taken every minute and rolled up into hours after a day
+func (c *Client) MetricsContainer(ctx context.Context, path string, from *time.Time, step *int, to *time.Time) (*http.Response, error) {
+	req, err := c.NewMetricsContainerRequest(ctx, path, from, step, to)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewMetricsContainerRequest create the request corresponding to the metrics action endpoint of the container resource.
+func (c *Client) NewMetricsContainerRequest(ctx context.Context, path string, from *time.Time, step *int, to *time.Time) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if from != nil {
+		tmp116 := from.Format(time.RFC3339)
+		values.Set("from", tmp116)
+	}
+	if step != nil {
+		tmp117 := strconv.Itoa(*step)
+		values.Set("step", tmp117)
+	}
+	if to != nil {
+		tmp118 := to.Format(time.RFC3339)
+		values.Set("to", tmp118)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// PauseContainerPath computes a request path to the pause action of container.
+func PauseContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/pause", param0)
+}
+
+// pause all processes in a container
+func (c *Client) PauseContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewPauseContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewPauseContainerRequest create the request corresponding to the pause action endpoint of the container resource.
+func (c *Client) NewPauseContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RedeployContainerPath computes a request path to the redeploy action of container.
+func RedeployContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/redeploy", param0)
+}
+
+// Recreate a container with a new image or configuration keeping its id, name and volumes. Omitted parameters are taken over from the current container
+func (c *Client) RedeployContainer(ctx context.Context, path string, command []string, drainPeriod *int, entrypoint []string, env []string, healthTimeout *int, image *string, strategy *string, timeout *int, workingDir *string) (*http.Response, error) {
+	req, err := c.NewRedeployContainerRequest(ctx, path, command, drainPeriod, entrypoint, env, healthTimeout, image, strategy, timeout, workingDir)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRedeployContainerRequest create the request corresponding to the redeploy action endpoint of the container resource.
+func (c *Client) NewRedeployContainerRequest(ctx context.Context, path string, command []string, drainPeriod *int, entrypoint []string, env []string, healthTimeout *int, image *string, strategy *string, timeout *int, workingDir *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	for _, p := range command {
+		tmp119 := p
+		values.Add("command", tmp119)
+	}
+	if drainPeriod != nil {
+		tmp120 := strconv.Itoa(*drainPeriod)
+		values.Set("drainPeriod", tmp120)
+	}
+	for _, p := range entrypoint {
+		tmp121 := p
+		values.Add("entrypoint", tmp121)
+	}
+	for _, p := range env {
+		tmp122 := p
+		values.Add("env", tmp122)
+	}
+	if healthTimeout != nil {
+		tmp123 := strconv.Itoa(*healthTimeout)
+		values.Set("healthTimeout", tmp123)
+	}
+	if image != nil {
+		values.Set("image", *image)
+	}
+	if strategy != nil {
+		values.Set("strategy", *strategy)
+	}
+	if timeout != nil {
+		tmp124 := strconv.Itoa(*timeout)
+		values.Set("timeout", tmp124)
+	}
+	if workingDir != nil {
+		values.Set("workingDir", *workingDir)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// ReleasesContainerPath computes a request path to the releases action of container.
+func ReleasesContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/releases", param0)
+}
+
+// Return the release history of a container, newest first
+func (c *Client) ReleasesContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewReleasesContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewReleasesContainerRequest create the request corresponding to the releases action endpoint of the container resource.
+func (c *Client) NewReleasesContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RemoveContainerPath computes a request path to the remove action of container.
+func RemoveContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/remove", param0)
+}
+
+// remove a container
+func (c *Client) RemoveContainer(ctx context.Context, path string, force bool) (*http.Response, error) {
+	req, err := c.NewRemoveContainerRequest(ctx, path, force)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveContainerRequest create the request corresponding to the remove action endpoint of the container resource.
+func (c *Client) NewRemoveContainerRequest(ctx context.Context, path string, force bool) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	tmp125 := strconv.FormatBool(force)
+	values.Set("force", tmp125)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RemoveDomainContainerPath computes a request path to the removeDomain action of container.
+func RemoveDomainContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/domains", param0)
+}
+
+// Remove a custom domain from a container
+func (c *Client) RemoveDomainContainer(ctx context.Context, path string, domain string) (*http.Response, error) {
+	req, err := c.NewRemoveDomainContainerRequest(ctx, path, domain)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveDomainContainerRequest create the request corresponding to the removeDomain action endpoint of the container resource.
+func (c *Client) NewRemoveDomainContainerRequest(ctx context.Context, path string, domain string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("domain", domain)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RemoveEnvContainerPath computes a request path to the removeEnv action of container.
+func RemoveEnvContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/env", param0)
+}
+
+// Remove environment variables from a container. The container is recreated to apply them
+func (c *Client) RemoveEnvContainer(ctx context.Context, path string, name []string, deferred *bool) (*http.Response, error) {
+	req, err := c.NewRemoveEnvContainerRequest(ctx, path, name, deferred)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveEnvContainerRequest create the request corresponding to the removeEnv action endpoint of the container resource.
+func (c *Client) NewRemoveEnvContainerRequest(ctx context.Context, path string, name []string, deferred *bool) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	for _, p := range name {
+		tmp126 := p
+		values.Add("name", tmp126)
+	}
+	if deferred != nil {
+		tmp127 := strconv.FormatBool(*deferred)
+		values.Set("deferred", tmp127)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RemoveRouteContainerPath computes a request path to the removeRoute action of container.
+func RemoveRouteContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/routes", param0)
+}
+
+// Remove a path-prefix route from a container
+func (c *Client) RemoveRouteContainer(ctx context.Context, path string, route int) (*http.Response, error) {
+	req, err := c.NewRemoveRouteContainerRequest(ctx, path, route)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveRouteContainerRequest create the request corresponding to the removeRoute action endpoint of the container resource.
+func (c *Client) NewRemoveRouteContainerRequest(ctx context.Context, path string, route int) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	tmp128 := strconv.Itoa(route)
+	values.Set("route", tmp128)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RemoveScheduleContainerPath computes a request path to the removeSchedule action of container.
+func RemoveScheduleContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/schedules", param0)
+}
+
+// Remove a schedule from a container
+func (c *Client) RemoveScheduleContainer(ctx context.Context, path string, schedule int) (*http.Response, error) {
+	req, err := c.NewRemoveScheduleContainerRequest(ctx, path, schedule)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveScheduleContainerRequest create the request corresponding to the removeSchedule action endpoint of the container resource.
+func (c *Client) NewRemoveScheduleContainerRequest(ctx context.Context, path string, schedule int) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	tmp129 := strconv.Itoa(schedule)
+	values.Set("schedule", tmp129)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RemoveSecretContainerPath computes a request path to the removeSecret action of container.
+func RemoveSecretContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/secrets", param0)
+}
+
+// Stop injecting a secret into a container. The container is recreated to apply it
+func (c *Client) RemoveSecretContainer(ctx context.Context, path string, name string, deferred *bool) (*http.Response, error) {
+	req, err := c.NewRemoveSecretContainerRequest(ctx, path, name, deferred)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveSecretContainerRequest create the request corresponding to the removeSecret action endpoint of the container resource.
+func (c *Client) NewRemoveSecretContainerRequest(ctx context.Context, path string, name string, deferred *bool) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("name", name)
+	if deferred != nil {
+		tmp130 := strconv.FormatBool(*deferred)
+		values.Set("deferred", tmp130)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RestartContainerPath computes a request path to the restart action of container.
+func RestartContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/restart", param0)
+}
+
+// restart a container
+func (c *Client) RestartContainer(ctx context.Context, path string, timeout *int) (*http.Response, error) {
+	req, err := c.NewRestartContainerRequest(ctx, path, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRestartContainerRequest create the request corresponding to the restart action endpoint of the container resource.
+func (c *Client) NewRestartContainerRequest(ctx context.Context, path string, timeout *int) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if timeout != nil {
+		tmp131 := strconv.Itoa(*timeout)
+		values.Set("timeout", tmp131)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RollbackContainerPath computes a request path to the rollback action of container.
+func RollbackContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/rollback", param0)
+}
+
+// Recreate a container from a prior release
+func (c *Client) RollbackContainer(ctx context.Context, path string, drainPeriod *int, healthTimeout *int, release *int, strategy *string, timeout *int) (*http.Response, error) {
+	req, err := c.NewRollbackContainerRequest(ctx, path, drainPeriod, healthTimeout, release, strategy, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRollbackContainerRequest create the request corresponding to the rollback action endpoint of the container resource.
+func (c *Client) NewRollbackContainerRequest(ctx context.Context, path string, drainPeriod *int, healthTimeout *int, release *int, strategy *string, timeout *int) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if drainPeriod != nil {
+		tmp132 := strconv.Itoa(*drainPeriod)
+		values.Set("drainPeriod", tmp132)
+	}
+	if healthTimeout != nil {
+		tmp133 := strconv.Itoa(*healthTimeout)
+		values.Set("healthTimeout", tmp133)
+	}
+	if release != nil {
+		tmp134 := strconv.Itoa(*release)
+		values.Set("release", tmp134)
+	}
+	if strategy != nil {
+		values.Set("strategy", *strategy)
+	}
+	if timeout != nil {
+		tmp135 := strconv.Itoa(*timeout)
+		values.Set("timeout", tmp135)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RunContainerPath computes a request path to the run action of container.
+func RunContainerPath() string {
+
+	return fmt.Sprintf("/api/v2/container/run")
+}
+
+// Run a command in a one-off container without a public endpoint and return the result after it exits. The container is removed afterwards
+func (c *Client) RunContainer(ctx context.Context, path string, image string, command []string, entrypoint []string, env []string, timeout *int, workingDir *string) (*http.Response, error) {
+	req, err := c.NewRunContainerRequest(ctx, path, image, command, entrypoint, env, timeout, workingDir)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRunContainerRequest create the request corresponding to the run action endpoint of the container resource.
+func (c *Client) NewRunContainerRequest(ctx context.Context, path string, image string, command []string, entrypoint []string, env []string, timeout *int, workingDir *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("image", image)
+	for _, p := range command {
+		tmp136 := p
+		values.Add("command", tmp136)
+	}
+	for _, p := range entrypoint {
+		tmp137 := p
+		values.Add("entrypoint", tmp137)
+	}
+	for _, p := range env {
+		tmp138 := p
+		values.Add("env", tmp138)
+	}
+	if timeout != nil {
+		tmp139 := strconv.Itoa(*timeout)
+		values.Set("timeout", tmp139)
+	}
+	if workingDir != nil {
+		values.Set("workingDir", *workingDir)
+	}
 	u.RawQuery = values.Encode()
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,30 +1431,45 @@ func (c *Client) NewDownloadContainerRequest(ctx context.Context, path string, i
 	return req, nil
 }
 
-// ExecContainerPath computes a request path to the exec action of container.
-func ExecContainerPath(id string) string {
-	param0 := id
+// RunStreamContainerPath computes a request path to the runStream action of container.
+func RunStreamContainerPath() string {
 
-	return fmt.Sprintf("/api/v2/container/%s/exec", param0)
+	return fmt.Sprintf("/api/v2/container/run/stream")
 }
 
-// Exec a command with attaching to a container using WebSocket(Mainly for xterm.js, using a protocol for terminado)
-func (c *Client) ExecContainer(ctx context.Context, path string, command []string, tty *bool) (*websocket.Conn, error) {
+// Run a command in a one-off container streaming stdout and stderr as binary messages. The result is sent as a JSON text message(vpn.application/goa.container.run.result+json without output) at the end
+func (c *Client) RunStreamContainer(ctx context.Context, path string, image string, command []string, entrypoint []string, env []string, timeout *int, workingDir *string) (*websocket.Conn, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "ws"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
 	values := u.Query()
+	values.Set("image", image)
 	if command != nil {
 		for _, p := range command {
-			tmp34 := p
-			values.Add("command", tmp34)
+			tmp140 := p
+			values.Add("command", tmp140)
 		}
 	}
-	if tty != nil {
-		tmp35 := strconv.FormatBool(*tty)
-		values.Set("tty", tmp35)
+	if entrypoint != nil {
+		for _, p := range entrypoint {
+			tmp141 := p
+			values.Add("entrypoint", tmp141)
+		}
+	}
+	if env != nil {
+		for _, p := range env {
+			tmp142 := p
+			values.Add("env", tmp142)
+		}
+	}
+	if timeout != nil {
+		tmp143 := strconv.Itoa(*timeout)
+		values.Set("timeout", tmp143)
+	}
+	if workingDir != nil {
+		values.Set("workingDir", *workingDir)
 	}
 	u.RawQuery = values.Encode()
 	url_ := u.String()
@@ -164,29 +1480,37 @@ func (c *Client) ExecContainer(ctx context.Context, path string, command []strin
 	return websocket.DialConfig(cfg)
 }
 
-// GetConfigContainerPath computes a request path to the getConfig action of container.
-func GetConfigContainerPath(id string) string {
+// ScaleContainerPath computes a request path to the scale action of container.
+func ScaleContainerPath(id string) string {
 	param0 := id
 
-	return fmt.Sprintf("/api/v2/container/%s/config", param0)
+	return fmt.Sprintf("/api/v2/container/%s/scale", param0)
 }
 
-// Get the config of a container
-func (c *Client) GetConfigContainer(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewGetConfigContainerRequest(ctx, path)
+// Change the number of replicas of a container. Requests are balanced among the replicas, which share the volumes
+func (c *Client) ScaleContainer(ctx context.Context, path string, replicas int, timeout *int) (*http.Response, error) {
+	req, err := c.NewScaleContainerRequest(ctx, path, replicas, timeout)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewGetConfigContainerRequest create the request corresponding to the getConfig action endpoint of the container resource.
-func (c *Client) NewGetConfigContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+// NewScaleContainerRequest create the request corresponding to the scale action endpoint of the container resource.
+func (c *Client) NewScaleContainerRequest(ctx context.Context, path string, replicas int, timeout *int) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "https"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	tmp144 := strconv.Itoa(replicas)
+	values.Set("replicas", tmp144)
+	if timeout != nil {
+		tmp145 := strconv.Itoa(*timeout)
+		values.Set("timeout", tmp145)
+	}
+	u.RawQuery = values.Encode()
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
@@ -199,29 +1523,35 @@ func (c *Client) NewGetConfigContainerRequest(ctx context.Context, path string)
 	return req, nil
 }
 
-// InspectContainerPath computes a request path to the inspect action of container.
-func InspectContainerPath(id string) string {
+// ScheduleLogsContainerPath computes a request path to the scheduleLogs action of container.
+func ScheduleLogsContainerPath(id string) string {
 	param0 := id
 
-	return fmt.Sprintf("/api/v2/container/%s/inspect", param0)
+	return fmt.Sprintf("/api/v2/container/%s/schedules/logs", param0)
 }
 
-// Return details of a container
-func (c *Client) InspectContainer(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewInspectContainerRequest(ctx, path)
+// Return the actions taken by the schedules of a container, newest first
+func (c *Client) ScheduleLogsContainer(ctx context.Context, path string, limit *int) (*http.Response, error) {
+	req, err := c.NewScheduleLogsContainerRequest(ctx, path, limit)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewInspectContainerRequest create the request corresponding to the inspect action endpoint of the container resource.
-func (c *Client) NewInspectContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+// NewScheduleLogsContainerRequest create the request corresponding to the scheduleLogs action endpoint of the container resource.
+func (c *Client) NewScheduleLogsContainerRequest(ctx context.Context, path string, limit *int) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "https"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if limit != nil {
+		tmp146 := strconv.Itoa(*limit)
+		values.Set("limit", tmp146)
+	}
+	u.RawQuery = values.Encode()
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
@@ -234,32 +1564,47 @@ func (c *Client) NewInspectContainerRequest(ctx context.Context, path string) (*
 	return req, nil
 }
 
-// ListContainerPath computes a request path to the list action of container.
-func ListContainerPath() string {
+// SetConfigContainerPath computes a request path to the setConfig action of container.
+func SetConfigContainerPath(id string) string {
+	param0 := id
 
-	return fmt.Sprintf("/api/v2/container/list")
+	return fmt.Sprintf("/api/v2/container/%s/config", param0)
 }
 
-// Return a list of containers
-func (c *Client) ListContainer(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewListContainerRequest(ctx, path)
+// Change the config of a container
+func (c *Client) SetConfigContainer(ctx context.Context, path string, payload *ContainerConfig, contentType string) (*http.Response, error) {
+	req, err := c.NewSetConfigContainerRequest(ctx, path, payload, contentType)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewListContainerRequest create the request corresponding to the list action endpoint of the container resource.
-func (c *Client) NewListContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+// NewSetConfigContainerRequest create the request corresponding to the setConfig action endpoint of the container resource.
+func (c *Client) NewSetConfigContainerRequest(ctx context.Context, path string, payload *ContainerConfig, contentType string) (*http.Request, error) {
+	var body bytes.Buffer
+	if contentType == "" {
+		contentType = "*/*" // Use default encoder
+	}
+	err := c.Encoder.Encode(payload, &body, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %s", err)
+	}
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "https"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("POST", u.String(), &body)
 	if err != nil {
 		return nil, err
 	}
+	header := req.Header
+	if contentType == "*/*" {
+		header.Set("Content-Type", "application/json")
+	} else {
+		header.Set("Content-Type", contentType)
+	}
 	if c.JWTSigner != nil {
 		if err := c.JWTSigner.Sign(req); err != nil {
 			return nil, err
@@ -268,85 +1613,101 @@ func (c *Client) NewListContainerRequest(ctx context.Context, path string) (*htt
 	return req, nil
 }
 
-// LogsContainerPath computes a request path to the logs action of container.
-func LogsContainerPath(id string) string {
+// SetEnvContainerPayload is the container setEnv action payload.
+type SetEnvContainerPayload []*ContainerEnv
+
+// SetEnvContainerPath computes a request path to the setEnv action of container.
+func SetEnvContainerPath(id string) string {
 	param0 := id
 
-	return fmt.Sprintf("/api/v2/container/%s/logs", param0)
+	return fmt.Sprintf("/api/v2/container/%s/env", param0)
 }
 
-// Get stdout and stderr logs from a container.
-func (c *Client) LogsContainer(ctx context.Context, path string, follow *bool, since *time.Time, stderr *bool, stdout *bool, tail *string, timestamps *bool, until *time.Time) (*websocket.Conn, error) {
+// Add or update environment variables of a container. The container is recreated to apply them
+func (c *Client) SetEnvContainer(ctx context.Context, path string, payload SetEnvContainerPayload, deferred *bool, contentType string) (*http.Response, error) {
+	req, err := c.NewSetEnvContainerRequest(ctx, path, payload, deferred, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewSetEnvContainerRequest create the request corresponding to the setEnv action endpoint of the container resource.
+func (c *Client) NewSetEnvContainerRequest(ctx context.Context, path string, payload SetEnvContainerPayload, deferred *bool, contentType string) (*http.Request, error) {
+	var body bytes.Buffer
+	if contentType == "" {
+		contentType = "*/*" // Use default encoder
+	}
+	err := c.Encoder.Encode(payload, &body, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %s", err)
+	}
 	scheme := c.Scheme
 	if scheme == "" {
-		scheme = "ws"
+		scheme = "https"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
 	values := u.Query()
-	if follow != nil {
-		tmp36 := strconv.FormatBool(*follow)
-		values.Set("follow", tmp36)
-	}
-	if since != nil {
-		tmp37 := since.Format(time.RFC3339)
-		values.Set("since", tmp37)
-	}
-	if stderr != nil {
-		tmp38 := strconv.FormatBool(*stderr)
-		values.Set("stderr", tmp38)
-	}
-	if stdout != nil {
-		tmp39 := strconv.FormatBool(*stdout)
-		values.Set("stdout", tmp39)
-	}
-	if tail != nil {
-		values.Set("tail", *tail)
-	}
-	if timestamps != nil {
-		tmp40 := strconv.FormatBool(*timestamps)
-		values.Set("timestamps", tmp40)
-	}
-	if until != nil {
-		tmp41 := until.Format(time.RFC3339)
-		values.Set("until", tmp41)
+	if deferred != nil {
+		tmp147 := strconv.FormatBool(*deferred)
+		values.Set("deferred", tmp147)
 	}
 	u.RawQuery = values.Encode()
-	url_ := u.String()
-	cfg, err := websocket.NewConfig(url_, url_)
+	req, err := http.NewRequest("PUT", u.String(), &body)
 	if err != nil {
 		return nil, err
 	}
-	return websocket.DialConfig(cfg)
+	header := req.Header
+	if contentType == "*/*" {
+		header.Set("Content-Type", "application/json")
+	} else {
+		header.Set("Content-Type", contentType)
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
 }
 
-// RemoveContainerPath computes a request path to the remove action of container.
-func RemoveContainerPath(id string) string {
+// SetLimitsContainerPath computes a request path to the setLimits action of container.
+func SetLimitsContainerPath(id string) string {
 	param0 := id
 
-	return fmt.Sprintf("/api/v2/container/%s/remove", param0)
+	return fmt.Sprintf("/api/v2/container/%s/limits", param0)
 }
 
-// remove a container
-func (c *Client) RemoveContainer(ctx context.Context, path string, force bool) (*http.Response, error) {
-	req, err := c.NewRemoveContainerRequest(ctx, path, force)
+// Update the resource limits of a container without restarting it. Limits not specified are left unchanged and 0 removes them up to the maximum set by the administrator. The storage can not be changed
+func (c *Client) SetLimitsContainer(ctx context.Context, path string, cpu *int, memory *string, pids *int) (*http.Response, error) {
+	req, err := c.NewSetLimitsContainerRequest(ctx, path, cpu, memory, pids)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewRemoveContainerRequest create the request corresponding to the remove action endpoint of the container resource.
-func (c *Client) NewRemoveContainerRequest(ctx context.Context, path string, force bool) (*http.Request, error) {
+// NewSetLimitsContainerRequest create the request corresponding to the setLimits action endpoint of the container resource.
+func (c *Client) NewSetLimitsContainerRequest(ctx context.Context, path string, cpu *int, memory *string, pids *int) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "https"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
 	values := u.Query()
-	tmp42 := strconv.FormatBool(force)
-	values.Set("force", tmp42)
+	if cpu != nil {
+		tmp148 := strconv.Itoa(*cpu)
+		values.Set("cpu", tmp148)
+	}
+	if memory != nil {
+		values.Set("memory", *memory)
+	}
+	if pids != nil {
+		tmp149 := strconv.Itoa(*pids)
+		values.Set("pids", tmp149)
+	}
 	u.RawQuery = values.Encode()
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -358,47 +1719,33 @@ func (c *Client) NewRemoveContainerRequest(ctx context.Context, path string, for
 	return req, nil
 }
 
-// SetConfigContainerPath computes a request path to the setConfig action of container.
-func SetConfigContainerPath(id string) string {
+// StartContainerPath computes a request path to the start action of container.
+func StartContainerPath(id string) string {
 	param0 := id
 
-	return fmt.Sprintf("/api/v2/container/%s/config", param0)
+	return fmt.Sprintf("/api/v2/container/%s/start", param0)
 }
 
-// Change the config of a container
-func (c *Client) SetConfigContainer(ctx context.Context, path string, payload *ContainerConfig, contentType string) (*http.Response, error) {
-	req, err := c.NewSetConfigContainerRequest(ctx, path, payload, contentType)
+// start a container
+func (c *Client) StartContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewStartContainerRequest(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewSetConfigContainerRequest create the request corresponding to the setConfig action endpoint of the container resource.
-func (c *Client) NewSetConfigContainerRequest(ctx context.Context, path string, payload *ContainerConfig, contentType string) (*http.Request, error) {
-	var body bytes.Buffer
-	if contentType == "" {
-		contentType = "*/*" // Use default encoder
-	}
-	err := c.Encoder.Encode(payload, &body, contentType)
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode body: %s", err)
-	}
+// NewStartContainerRequest create the request corresponding to the start action endpoint of the container resource.
+func (c *Client) NewStartContainerRequest(ctx context.Context, path string) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "https"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
-	req, err := http.NewRequest("POST", u.String(), &body)
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	header := req.Header
-	if contentType == "*/*" {
-		header.Set("Content-Type", "application/json")
-	} else {
-		header.Set("Content-Type", contentType)
-	}
 	if c.JWTSigner != nil {
 		if err := c.JWTSigner.Sign(req); err != nil {
 			return nil, err
@@ -407,24 +1754,24 @@ func (c *Client) NewSetConfigContainerRequest(ctx context.Context, path string,
 	return req, nil
 }
 
-// StartContainerPath computes a request path to the start action of container.
-func StartContainerPath(id string) string {
+// StatsContainerPath computes a request path to the stats action of container.
+func StatsContainerPath(id string) string {
 	param0 := id
 
-	return fmt.Sprintf("/api/v2/container/%s/start", param0)
+	return fmt.Sprintf("/api/v2/container/%s/stats", param0)
 }
 
-// start a container
-func (c *Client) StartContainer(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewStartContainerRequest(ctx, path)
+// Return the current resource usage of each replica of a container
+func (c *Client) StatsContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewStatsContainerRequest(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewStartContainerRequest create the request corresponding to the start action endpoint of the container resource.
-func (c *Client) NewStartContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+// NewStatsContainerRequest create the request corresponding to the stats action endpoint of the container resource.
+func (c *Client) NewStatsContainerRequest(ctx context.Context, path string) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "https"
@@ -442,6 +1789,28 @@ func (c *Client) NewStartContainerRequest(ctx context.Context, path string) (*ht
 	return req, nil
 }
 
+// StatsStreamContainerPath computes a request path to the statsStream action of container.
+func StatsStreamContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/stats/stream", param0)
+}
+
+// Stream the resource usage of each replica of a container. Every message is a JSON of vpn.application/goa.container.stats+json sent about every second per replica
+func (c *Client) StatsStreamContainer(ctx context.Context, path string) (*websocket.Conn, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "ws"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	url_ := u.String()
+	cfg, err := websocket.NewConfig(url_, url_)
+	if err != nil {
+		return nil, err
+	}
+	return websocket.DialConfig(cfg)
+}
+
 // StopContainerPath computes a request path to the stop action of container.
 func StopContainerPath(id string) string {
 	param0 := id
@@ -450,8 +1819,8 @@ func StopContainerPath(id string) string {
 }
 
 // stop a container
-func (c *Client) StopContainer(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewStopContainerRequest(ctx, path)
+func (c *Client) StopContainer(ctx context.Context, path string, timeout *int) (*http.Response, error) {
+	req, err := c.NewStopContainerRequest(ctx, path, timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -459,7 +1828,48 @@ func (c *Client) StopContainer(ctx context.Context, path string) (*http.Response
 }
 
 // NewStopContainerRequest create the request corresponding to the stop action endpoint of the container resource.
-func (c *Client) NewStopContainerRequest(ctx context.Context, path string) (*http.Request, error) {
+func (c *Client) NewStopContainerRequest(ctx context.Context, path string, timeout *int) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if timeout != nil {
+		tmp150 := strconv.Itoa(*timeout)
+		values.Set("timeout", tmp150)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// UnpauseContainerPath computes a request path to the unpause action of container.
+func UnpauseContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/unpause", param0)
+}
+
+// unpause all processes in a container
+func (c *Client) UnpauseContainer(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewUnpauseContainerRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewUnpauseContainerRequest create the request corresponding to the unpause action endpoint of the container resource.
+func (c *Client) NewUnpauseContainerRequest(ctx context.Context, path string) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "https"
@@ -563,3 +1973,41 @@ func (c *Client) NewUploadContainerRequest(ctx context.Context, path string, pay
 	}
 	return req, nil
 }
+
+// VerifyDomainContainerPath computes a request path to the verifyDomain action of container.
+func VerifyDomainContainerPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/container/%s/domains/verify", param0)
+}
+
+// Verify the ownership of a custom domain with the TXT record or the HTTP token
+func (c *Client) VerifyDomainContainer(ctx context.Context, path string, domain string) (*http.Response, error) {
+	req, err := c.NewVerifyDomainContainerRequest(ctx, path, domain)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewVerifyDomainContainerRequest create the request corresponding to the verifyDomain action endpoint of the container resource.
+func (c *Client) NewVerifyDomainContainerRequest(ctx context.Context, path string, domain string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("domain", domain)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
@@ -0,0 +1,251 @@
+// Code generated by goagen v1.3.1, DO NOT EDIT.
+//
+// API "Modoki API": job Resource Client
+//
+// Command:
+// $ goagen
+// --design=github.com/modoki-paas/modoki/design
+// --out=$(GOPATH)/src/github.com/modoki-paas/modoki
+// --version=v1.3.1
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CreateJobPath computes a request path to the create action of job.
+func CreateJobPath() string {
+
+	return fmt.Sprintf("/api/v2/job/create")
+}
+
+// Create a job which executes the command in the container, or runs the image as a one-off container on the schedule
+func (c *Client) CreateJob(ctx context.Context, path string, name string, schedule string, command []string, container *string, env []string, image *string) (*http.Response, error) {
+	req, err := c.NewCreateJobRequest(ctx, path, name, schedule, command, container, env, image)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewCreateJobRequest create the request corresponding to the create action endpoint of the job resource.
+func (c *Client) NewCreateJobRequest(ctx context.Context, path string, name string, schedule string, command []string, container *string, env []string, image *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("name", name)
+	values.Set("schedule", schedule)
+	for _, p := range command {
+		tmp151 := p
+		values.Add("command", tmp151)
+	}
+	if container != nil {
+		values.Set("container", *container)
+	}
+	for _, p := range env {
+		tmp152 := p
+		values.Add("env", tmp152)
+	}
+	if image != nil {
+		values.Set("image", *image)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// InspectJobPath computes a request path to the inspect action of job.
+func InspectJobPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/job/%s/inspect", param0)
+}
+
+// Return the job
+func (c *Client) InspectJob(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewInspectJobRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewInspectJobRequest create the request corresponding to the inspect action endpoint of the job resource.
+func (c *Client) NewInspectJobRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// ListJobPath computes a request path to the list action of job.
+func ListJobPath() string {
+
+	return fmt.Sprintf("/api/v2/job/list")
+}
+
+// Return jobs
+func (c *Client) ListJob(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewListJobRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewListJobRequest create the request corresponding to the list action endpoint of the job resource.
+func (c *Client) NewListJobRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RemoveJobPath computes a request path to the remove action of job.
+func RemoveJobPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/job/%s/remove", param0)
+}
+
+// Remove the job and its history
+func (c *Client) RemoveJob(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewRemoveJobRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveJobRequest create the request corresponding to the remove action endpoint of the job resource.
+func (c *Client) NewRemoveJobRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RunJobPath computes a request path to the run action of job.
+func RunJobPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/job/%s/run", param0)
+}
+
+// Run the job now in the background
+func (c *Client) RunJob(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewRunJobRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRunJobRequest create the request corresponding to the run action endpoint of the job resource.
+func (c *Client) NewRunJobRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// RunsJobPath computes a request path to the runs action of job.
+func RunsJobPath(id string) string {
+	param0 := id
+
+	return fmt.Sprintf("/api/v2/job/%s/runs", param0)
+}
+
+// Return the run history of the job, newest first
+func (c *Client) RunsJob(ctx context.Context, path string, limit *int) (*http.Response, error) {
+	req, err := c.NewRunsJobRequest(ctx, path, limit)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRunsJobRequest create the request corresponding to the runs action endpoint of the job resource.
+func (c *Client) NewRunsJobRequest(ctx context.Context, path string, limit *int) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if limit != nil {
+		tmp153 := strconv.Itoa(*limit)
+		values.Set("limit", tmp153)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
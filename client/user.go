@@ -134,6 +134,40 @@ func (c *Client) NewGetDefaultShellUserRequest(ctx context.Context, path string)
 	return req, nil
 }
 
+// GetQuotaUserPath computes a request path to the getQuota action of user.
+func GetQuotaUserPath() string {
+
+	return fmt.Sprintf("/api/v2/user/quota")
+}
+
+// Return the quota and the current usage
+func (c *Client) GetQuotaUser(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewGetQuotaUserRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewGetQuotaUserRequest create the request corresponding to the getQuota action endpoint of the user resource.
+func (c *Client) NewGetQuotaUserRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
 // ListAuthorizedKeysUserPath computes a request path to the listAuthorizedKeys action of user.
 func ListAuthorizedKeysUserPath() string {
 
@@ -168,6 +202,40 @@ func (c *Client) NewListAuthorizedKeysUserRequest(ctx context.Context, path stri
 	return req, nil
 }
 
+// ListSecretsUserPath computes a request path to the listSecrets action of user.
+func ListSecretsUserPath() string {
+
+	return fmt.Sprintf("/api/v2/user/secrets")
+}
+
+// Return names of your secrets
+func (c *Client) ListSecretsUser(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewListSecretsUserRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewListSecretsUserRequest create the request corresponding to the listSecrets action endpoint of the user resource.
+func (c *Client) NewListSecretsUserRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
 // RemoveAuthorizedKeysUserPath computes a request path to the removeAuthorizedKeys action of user.
 func RemoveAuthorizedKeysUserPath() string {
 
@@ -205,6 +273,43 @@ func (c *Client) NewRemoveAuthorizedKeysUserRequest(ctx context.Context, path st
 	return req, nil
 }
 
+// RemoveSecretUserPath computes a request path to the removeSecret action of user.
+func RemoveSecretUserPath() string {
+
+	return fmt.Sprintf("/api/v2/user/secrets")
+}
+
+// Remove a secret which is not used by any container
+func (c *Client) RemoveSecretUser(ctx context.Context, path string, name string) (*http.Response, error) {
+	req, err := c.NewRemoveSecretUserRequest(ctx, path, name)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveSecretUserRequest create the request corresponding to the removeSecret action endpoint of the user resource.
+func (c *Client) NewRemoveSecretUserRequest(ctx context.Context, path string, name string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("name", name)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
 // SetAuthorizedKeysUserPayload is the user setAuthorizedKeys action payload.
 type SetAuthorizedKeysUserPayload []*UserAuthorizedKey
 
@@ -292,3 +397,100 @@ func (c *Client) NewSetDefaultShellUserRequest(ctx context.Context, path string,
 	}
 	return req, nil
 }
+
+// SetQuotaUserPath computes a request path to the setQuota action of user.
+func SetQuotaUserPath(uid string) string {
+	param0 := uid
+
+	return fmt.Sprintf("/api/v2/user/quota/%s", param0)
+}
+
+// Override the default quota of a user. Missing ones use the defaults and 0 is unlimited. Only the administrators listed in modoki/admins on consul can set quotas
+func (c *Client) SetQuotaUser(ctx context.Context, path string, payload *UserQuota, contentType string) (*http.Response, error) {
+	req, err := c.NewSetQuotaUserRequest(ctx, path, payload, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewSetQuotaUserRequest create the request corresponding to the setQuota action endpoint of the user resource.
+func (c *Client) NewSetQuotaUserRequest(ctx context.Context, path string, payload *UserQuota, contentType string) (*http.Request, error) {
+	var body bytes.Buffer
+	if contentType == "" {
+		contentType = "*/*" // Use default encoder
+	}
+	err := c.Encoder.Encode(payload, &body, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %s", err)
+	}
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("PUT", u.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	header := req.Header
+	if contentType == "*/*" {
+		header.Set("Content-Type", "application/json")
+	} else {
+		header.Set("Content-Type", contentType)
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// SetSecretUserPath computes a request path to the setSecret action of user.
+func SetSecretUserPath() string {
+
+	return fmt.Sprintf("/api/v2/user/secrets")
+}
+
+// Add or update a secret. Containers using it get the new value when they are recreated
+func (c *Client) SetSecretUser(ctx context.Context, path string, payload *UserSecret, contentType string) (*http.Response, error) {
+	req, err := c.NewSetSecretUserRequest(ctx, path, payload, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewSetSecretUserRequest create the request corresponding to the setSecret action endpoint of the user resource.
+func (c *Client) NewSetSecretUserRequest(ctx context.Context, path string, payload *UserSecret, contentType string) (*http.Request, error) {
+	var body bytes.Buffer
+	if contentType == "" {
+		contentType = "*/*" // Use default encoder
+	}
+	err := c.Encoder.Encode(payload, &body, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %s", err)
+	}
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("PUT", u.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	header := req.Header
+	if contentType == "*/*" {
+		header.Set("Content-Type", "application/json")
+	} else {
+		header.Set("Content-Type", contentType)
+	}
+	if c.JWTSigner != nil {
+		if err := c.JWTSigner.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
@@ -18,6 +18,18 @@ import (
 // containerConfig user type.
 type containerConfig struct {
 	DefaultShell *string `form:"defaultShell,omitempty" json:"defaultShell,omitempty" yaml:"defaultShell,omitempty" xml:"defaultShell,omitempty"`
+	// Seconds without incoming traffic until the container sleeps. A sleeping container is started by the next request. 0 disables sleeping
+	IdleTimeout *int `form:"idleTimeout,omitempty" json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty" xml:"idleTimeout,omitempty"`
+}
+
+// Validate validates the containerConfig type instance.
+func (ut *containerConfig) Validate() (err error) {
+	if ut.IdleTimeout != nil {
+		if *ut.IdleTimeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.idleTimeout`, *ut.IdleTimeout, 0, true))
+		}
+	}
+	return
 }
 
 // Publicize creates ContainerConfig from containerConfig
@@ -26,12 +38,108 @@ func (ut *containerConfig) Publicize() *ContainerConfig {
 	if ut.DefaultShell != nil {
 		pub.DefaultShell = ut.DefaultShell
 	}
+	if ut.IdleTimeout != nil {
+		pub.IdleTimeout = ut.IdleTimeout
+	}
 	return &pub
 }
 
 // ContainerConfig user type.
 type ContainerConfig struct {
 	DefaultShell *string `form:"defaultShell,omitempty" json:"defaultShell,omitempty" yaml:"defaultShell,omitempty" xml:"defaultShell,omitempty"`
+	// Seconds without incoming traffic until the container sleeps. A sleeping container is started by the next request. 0 disables sleeping
+	IdleTimeout *int `form:"idleTimeout,omitempty" json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty" xml:"idleTimeout,omitempty"`
+}
+
+// Validate validates the ContainerConfig type instance.
+func (ut *ContainerConfig) Validate() (err error) {
+	if ut.IdleTimeout != nil {
+		if *ut.IdleTimeout < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.idleTimeout`, *ut.IdleTimeout, 0, true))
+		}
+	}
+	return
+}
+
+// containerEnv user type.
+type containerEnv struct {
+	// Name of the environment variable
+	Name *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty"`
+	// Mask the value in responses
+	Secret *bool `form:"secret,omitempty" json:"secret,omitempty" yaml:"secret,omitempty" xml:"secret,omitempty"`
+	// Value of the environment variable
+	Value *string `form:"value,omitempty" json:"value,omitempty" yaml:"value,omitempty" xml:"value,omitempty"`
+}
+
+// Finalize sets the default values for containerEnv type instance.
+func (ut *containerEnv) Finalize() {
+	var defaultSecret = false
+	if ut.Secret == nil {
+		ut.Secret = &defaultSecret
+	}
+}
+
+// Validate validates the containerEnv type instance.
+func (ut *containerEnv) Validate() (err error) {
+	if ut.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "name"))
+	}
+	if ut.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "value"))
+	}
+	if ut.Name != nil {
+		if ok := goa.ValidatePattern(`^[a-zA-Z_][a-zA-Z0-9_]*$`, *ut.Name); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.name`, *ut.Name, `^[a-zA-Z_][a-zA-Z0-9_]*$`))
+		}
+	}
+	if ut.Name != nil {
+		if utf8.RuneCountInString(*ut.Name) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.name`, *ut.Name, utf8.RuneCountInString(*ut.Name), 255, false))
+		}
+	}
+	return
+}
+
+// Publicize creates ContainerEnv from containerEnv
+func (ut *containerEnv) Publicize() *ContainerEnv {
+	var pub ContainerEnv
+	if ut.Name != nil {
+		pub.Name = *ut.Name
+	}
+	if ut.Secret != nil {
+		pub.Secret = *ut.Secret
+	}
+	if ut.Value != nil {
+		pub.Value = *ut.Value
+	}
+	return &pub
+}
+
+// ContainerEnv user type.
+type ContainerEnv struct {
+	// Name of the environment variable
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Mask the value in responses
+	Secret bool `form:"secret" json:"secret" yaml:"secret" xml:"secret"`
+	// Value of the environment variable
+	Value string `form:"value" json:"value" yaml:"value" xml:"value"`
+}
+
+// Validate validates the ContainerEnv type instance.
+func (ut *ContainerEnv) Validate() (err error) {
+	if ut.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "name"))
+	}
+	if ut.Value == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "value"))
+	}
+	if ok := goa.ValidatePattern(`^[a-zA-Z_][a-zA-Z0-9_]*$`, ut.Name); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.name`, ut.Name, `^[a-zA-Z_][a-zA-Z0-9_]*$`))
+	}
+	if utf8.RuneCountInString(ut.Name) > 255 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.name`, ut.Name, utf8.RuneCountInString(ut.Name), 255, false))
+	}
+	return
 }
 
 // uploadPayload user type.
@@ -242,3 +350,174 @@ func (ut *UserConfig) Validate() (err error) {
 	}
 	return
 }
+
+// userQuota user type.
+type userQuota struct {
+	// Number of containers
+	Containers *int `form:"containers,omitempty" json:"containers,omitempty" yaml:"containers,omitempty" xml:"containers,omitempty"`
+	// Sum of the percentages of a CPU of the containers and their replicas
+	CPU *int `form:"cpu,omitempty" json:"cpu,omitempty" yaml:"cpu,omitempty" xml:"cpu,omitempty"`
+	// Sum of the memory limits of the containers and their replicas such as 4G
+	Memory *string `form:"memory,omitempty" json:"memory,omitempty" yaml:"memory,omitempty" xml:"memory,omitempty"`
+	// Sum of the storage limits of the containers and their replicas such as 100G
+	Storage *string `form:"storage,omitempty" json:"storage,omitempty" yaml:"storage,omitempty" xml:"storage,omitempty"`
+	// Number of volumes
+	Volumes *int `form:"volumes,omitempty" json:"volumes,omitempty" yaml:"volumes,omitempty" xml:"volumes,omitempty"`
+}
+
+// Validate validates the userQuota type instance.
+func (ut *userQuota) Validate() (err error) {
+	if ut.Containers != nil {
+		if *ut.Containers < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.containers`, *ut.Containers, 0, true))
+		}
+	}
+	if ut.CPU != nil {
+		if *ut.CPU < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.cpu`, *ut.CPU, 0, true))
+		}
+	}
+	if ut.Volumes != nil {
+		if *ut.Volumes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.volumes`, *ut.Volumes, 0, true))
+		}
+	}
+	return
+}
+
+// Publicize creates UserQuota from userQuota
+func (ut *userQuota) Publicize() *UserQuota {
+	var pub UserQuota
+	if ut.Containers != nil {
+		pub.Containers = ut.Containers
+	}
+	if ut.CPU != nil {
+		pub.CPU = ut.CPU
+	}
+	if ut.Memory != nil {
+		pub.Memory = ut.Memory
+	}
+	if ut.Storage != nil {
+		pub.Storage = ut.Storage
+	}
+	if ut.Volumes != nil {
+		pub.Volumes = ut.Volumes
+	}
+	return &pub
+}
+
+// UserQuota user type.
+type UserQuota struct {
+	// Number of containers
+	Containers *int `form:"containers,omitempty" json:"containers,omitempty" yaml:"containers,omitempty" xml:"containers,omitempty"`
+	// Sum of the percentages of a CPU of the containers and their replicas
+	CPU *int `form:"cpu,omitempty" json:"cpu,omitempty" yaml:"cpu,omitempty" xml:"cpu,omitempty"`
+	// Sum of the memory limits of the containers and their replicas such as 4G
+	Memory *string `form:"memory,omitempty" json:"memory,omitempty" yaml:"memory,omitempty" xml:"memory,omitempty"`
+	// Sum of the storage limits of the containers and their replicas such as 100G
+	Storage *string `form:"storage,omitempty" json:"storage,omitempty" yaml:"storage,omitempty" xml:"storage,omitempty"`
+	// Number of volumes
+	Volumes *int `form:"volumes,omitempty" json:"volumes,omitempty" yaml:"volumes,omitempty" xml:"volumes,omitempty"`
+}
+
+// Validate validates the UserQuota type instance.
+func (ut *UserQuota) Validate() (err error) {
+	if ut.Containers != nil {
+		if *ut.Containers < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.containers`, *ut.Containers, 0, true))
+		}
+	}
+	if ut.CPU != nil {
+		if *ut.CPU < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.cpu`, *ut.CPU, 0, true))
+		}
+	}
+	if ut.Volumes != nil {
+		if *ut.Volumes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.volumes`, *ut.Volumes, 0, true))
+		}
+	}
+	return
+}
+
+// userSecret user type.
+type userSecret struct {
+	// Name of the secret
+	Name *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty"`
+	// Value of the secret
+	Value *string `form:"value,omitempty" json:"value,omitempty" yaml:"value,omitempty" xml:"value,omitempty"`
+}
+
+// Validate validates the userSecret type instance.
+func (ut *userSecret) Validate() (err error) {
+	if ut.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "name"))
+	}
+	if ut.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "value"))
+	}
+	if ut.Name != nil {
+		if ok := goa.ValidatePattern(`^[a-zA-Z0-9_.-]+$`, *ut.Name); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.name`, *ut.Name, `^[a-zA-Z0-9_.-]+$`))
+		}
+	}
+	if ut.Name != nil {
+		if utf8.RuneCountInString(*ut.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.name`, *ut.Name, utf8.RuneCountInString(*ut.Name), 1, true))
+		}
+	}
+	if ut.Name != nil {
+		if utf8.RuneCountInString(*ut.Name) > 64 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.name`, *ut.Name, utf8.RuneCountInString(*ut.Name), 64, false))
+		}
+	}
+	if ut.Value != nil {
+		if utf8.RuneCountInString(*ut.Value) > 65536 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.value`, *ut.Value, utf8.RuneCountInString(*ut.Value), 65536, false))
+		}
+	}
+	return
+}
+
+// Publicize creates UserSecret from userSecret
+func (ut *userSecret) Publicize() *UserSecret {
+	var pub UserSecret
+	if ut.Name != nil {
+		pub.Name = *ut.Name
+	}
+	if ut.Value != nil {
+		pub.Value = *ut.Value
+	}
+	return &pub
+}
+
+// UserSecret user type.
+type UserSecret struct {
+	// Name of the secret
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Value of the secret
+	Value string `form:"value" json:"value" yaml:"value" xml:"value"`
+}
+
+// Validate validates the UserSecret type instance.
+func (ut *UserSecret) Validate() (err error) {
+	if ut.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "name"))
+	}
+	if ut.Value == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "value"))
+	}
+	if ok := goa.ValidatePattern(`^[a-zA-Z0-9_.-]+$`, ut.Name); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.name`, ut.Name, `^[a-zA-Z0-9_.-]+$`))
+	}
+	if utf8.RuneCountInString(ut.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.name`, ut.Name, utf8.RuneCountInString(ut.Name), 1, true))
+	}
+	if utf8.RuneCountInString(ut.Name) > 64 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.name`, ut.Name, utf8.RuneCountInString(ut.Name), 64, false))
+	}
+	if utf8.RuneCountInString(ut.Value) > 65536 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.value`, ut.Value, utf8.RuneCountInString(ut.Value), 65536, false))
+	}
+	return
+}
@@ -1,17 +1,74 @@
 package main
 
+import "time"
+
+// Kinds of containers
+const (
+	containerKindWeb     = "web"
+	containerKindWorker  = "worker"
+	containerKindPrivate = "private"
+)
+
 const (
 	jwtKeyUID           = "sub"
 	traefikFrontendName = "modoki"
 	traefikBackendName  = "modoki_backend"
 
-	frontendFormat = "modokif_%d"
-	backendFormat  = "modokib_%d"
-	serverName     = "main"
+	frontendFormat      = "modokif_%d"
+	backendFormat       = "modokib_%d"
+	portFrontendFormat  = "modokif_%d_%s"
+	portBackendFormat   = "modokib_%d_%s"
+	routeFrontendFormat = "modokir_%d_%d"
+	serverName          = "main"
+	nextServerName      = "next"
+	replicaServerFormat = "replica_%d"
+	defaultServerWeight = 1
+
+	defaultContainerPort = 80
+	defaultStopTimeout   = 15 * time.Second
+
+	crashLoopWindow    = 10 * time.Second
+	crashLoopThreshold = 3
+	restartBackoffBase = time.Second
+	restartBackoffMax  = 5 * time.Minute
+
+	idleCheckInterval = time.Minute
+	wakeTimeout       = time.Minute
+
+	expiryCheckInterval = time.Minute
+	expiryWarningBefore = time.Hour
+	expiryConcurrency   = 4
+
+	metricsInterval       = time.Minute
+	metricsRollupInterval = time.Hour
+	metricsRollupAfter    = 24 * time.Hour // samples older than this are rolled up into metricsRollupInterval
+	metricsMaxPoints      = 1500
+	metricsConcurrency    = 8
+
+	jobCheckInterval      = 10 * time.Second
+	scheduleCheckInterval = 10 * time.Second
+	runOutputLimit        = 64 * 1024 // bytes of output kept from jobs and one-off containers
+	jobTimeout            = time.Hour
+	jobRunsKept           = 100 // runs of each job kept in jobRuns
+
+	releaseCauseCreate   = "create"
+	releaseCauseRedeploy = "redeploy"
+	releaseCauseRollback = "rollback"
 
-	dockerLabelModokiID   = "com.cs3238.modoki.id"
-	dockerLabelModokiUID  = "com.cs3238.modoki.uid"
-	dockerLabelModokiName = "com.cs3238.modoki.name"
+	jobTriggerSchedule = "schedule"
+	jobTriggerManual   = "manual"
+
+	domainChallengeTXTPrefix = "_modoki-challenge."
+	domainChallengeHTTPPath  = "/.well-known/modoki-challenge/"
+
+	dockerLabelModokiID      = "com.cs3238.modoki.id"
+	dockerLabelModokiUID     = "com.cs3238.modoki.uid"
+	dockerLabelModokiName    = "com.cs3238.modoki.name"
+	dockerLabelModokiReplica = "com.cs3238.modoki.replica"
+	dockerLabelModokiJob     = "com.cs3238.modoki.job"
+
+	dockerLabelModokiMetricsPort = "com.cs3238.modoki.metrics.port"
+	dockerLabelModokiMetricsPath = "com.cs3238.modoki.metrics.path"
 
 	// user.go
 	defaultShellKVFormat = "modoki/users/%s/defaultShell" // TODO: encode for security
@@ -30,6 +87,248 @@ CREATE TABLE IF NOT EXISTS containers (
 	INDEX(cid, name, uid)
 );`
 
+// containerColumns are added to the containers table created by older versions
+var containerColumns = []tableColumn{
+	{"port", "INT"},
+	{"protocol", `VARCHAR(16) NOT NULL DEFAULT "http"`},
+	{"sslRedirect", "BOOLEAN NOT NULL DEFAULT TRUE"},
+	{"envPending", "BOOLEAN NOT NULL DEFAULT FALSE"},
+	{"restartPolicy", `VARCHAR(16) NOT NULL DEFAULT "no"`},
+	{"restartMaxRetries", "INT NOT NULL DEFAULT 0"},
+	{"restartCount", "INT NOT NULL DEFAULT 0"},
+	{"crashCount", "INT NOT NULL DEFAULT 0"},
+	{"exitCode", "INT"},
+	{"manualStop", "BOOLEAN NOT NULL DEFAULT FALSE"},
+	{"replicas", "INT NOT NULL DEFAULT 1"},
+	{"idleTimeout", "INT NOT NULL DEFAULT 0"},
+	{"sleeping", "BOOLEAN NOT NULL DEFAULT FALSE"},
+	{"rxBytes", "BIGINT UNSIGNED NOT NULL DEFAULT 0"},
+	{"activeAt", "DATETIME"},
+	{"kind", `VARCHAR(16) NOT NULL DEFAULT "web"`},
+	{"expiresAt", "DATETIME"},
+	{"expiryWarned", "BOOLEAN NOT NULL DEFAULT FALSE"},
+	{"cpuLimit", "INT NOT NULL DEFAULT 0"},
+	{"memoryLimit", "BIGINT NOT NULL DEFAULT 0"},
+	{"storageLimit", "BIGINT NOT NULL DEFAULT 0"},
+	{"volumeCount", "INT NOT NULL DEFAULT 0"},
+}
+
+const containerPortsSchema = `
+CREATE TABLE IF NOT EXISTS containerPorts (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	name VARCHAR(32) NOT NULL,
+	port INT NOT NULL,
+	protocol VARCHAR(16) NOT NULL DEFAULT "http",
+	PRIMARY KEY (id),
+	UNIQUE (containerID, name)
+);`
+
+// verifiedDomain is the domain only while verified so that unverified claims of a domain can coexist
+const containerDomainsSchema = `
+CREATE TABLE IF NOT EXISTS containerDomains (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	domain VARCHAR(253) NOT NULL,
+	token VARCHAR(64) NOT NULL,
+	verified BOOLEAN NOT NULL DEFAULT FALSE,
+	verifiedDomain VARCHAR(253) UNIQUE,
+	PRIMARY KEY (id),
+	UNIQUE (containerID, domain),
+	INDEX(domain)
+);`
+
+const containerRoutesSchema = `
+CREATE TABLE IF NOT EXISTS containerRoutes (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	host VARCHAR(253) NOT NULL,
+	pathPrefix VARCHAR(255) NOT NULL,
+	stripPrefix BOOLEAN NOT NULL DEFAULT FALSE,
+	priority INT NOT NULL DEFAULT 0,
+	PRIMARY KEY (id),
+	UNIQUE (host, pathPrefix),
+	INDEX(containerID)
+);`
+
+const containerReleasesSchema = `
+CREATE TABLE IF NOT EXISTS containerReleases (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	version INT NOT NULL,
+	image TEXT NOT NULL,
+	imageDigest TEXT NOT NULL,
+	env TEXT NOT NULL,
+	command TEXT NOT NULL,
+	entrypoint TEXT NOT NULL,
+	workingDir TEXT NOT NULL,
+	port INT,
+	protocol VARCHAR(16) NOT NULL DEFAULT "http",
+	ports TEXT NOT NULL,
+	cause VARCHAR(16) NOT NULL,
+	createdAt DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (id),
+	UNIQUE (containerID, version)
+);`
+
+const containerEnvSchema = `
+CREATE TABLE IF NOT EXISTS containerEnv (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	value TEXT NOT NULL,
+	secret BOOLEAN NOT NULL DEFAULT FALSE,
+	PRIMARY KEY (id),
+	UNIQUE (containerID, name)
+);`
+
+const containerSecretsSchema = `
+CREATE TABLE IF NOT EXISTS containerSecrets (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	name VARCHAR(64) NOT NULL,
+	env VARCHAR(255),
+	file VARCHAR(1024),
+	PRIMARY KEY (id),
+	UNIQUE (containerID, name)
+);`
+
+const containerReplicasSchema = `
+CREATE TABLE IF NOT EXISTS containerReplicas (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	replica INT NOT NULL,
+	cid VARCHAR(128) NOT NULL UNIQUE,
+	PRIMARY KEY (id),
+	UNIQUE (containerID, replica)
+);`
+
+const containerEventsSchema = `
+CREATE TABLE IF NOT EXISTS containerEvents (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	type VARCHAR(32) NOT NULL,
+	message TEXT NOT NULL,
+	createdAt DATETIME NOT NULL,
+	PRIMARY KEY (id),
+	INDEX(containerID)
+);`
+
+// containerMetricsSchema holds the resource usage of the containers summed over the replicas.
+// The byte counters are the bytes in the resolution(seconds) and the others are the averages.
+const containerMetricsSchema = `
+CREATE TABLE IF NOT EXISTS containerMetrics (
+	id BIGINT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	sampledAt DATETIME NOT NULL,
+	resolution INT NOT NULL,
+	cpuPercent DOUBLE NOT NULL,
+	memoryUsage BIGINT NOT NULL,
+	memoryLimit BIGINT NOT NULL,
+	rxBytes BIGINT NOT NULL,
+	txBytes BIGINT NOT NULL,
+	blockReadBytes BIGINT NOT NULL,
+	blockWriteBytes BIGINT NOT NULL,
+	pids INT NOT NULL,
+	PRIMARY KEY (id),
+	INDEX(containerID, sampledAt),
+	INDEX(resolution, sampledAt)
+);`
+
+const containerSchedulesSchema = `
+CREATE TABLE IF NOT EXISTS containerSchedules (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	action VARCHAR(8) NOT NULL,
+	schedule VARCHAR(255) NOT NULL,
+	timezone VARCHAR(64) NOT NULL,
+	nextRunAt DATETIME NOT NULL,
+	lastRunAt DATETIME,
+	PRIMARY KEY (id),
+	INDEX(containerID),
+	INDEX(nextRunAt)
+);`
+
+const containerScheduleLogsSchema = `
+CREATE TABLE IF NOT EXISTS containerScheduleLogs (
+	id INT NOT NULL AUTO_INCREMENT,
+	containerID INT NOT NULL,
+	scheduleID INT NOT NULL,
+	action VARCHAR(8) NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	message TEXT,
+	createdAt DATETIME NOT NULL,
+	PRIMARY KEY (id),
+	INDEX(containerID)
+);`
+
+const jobsSchema = `
+CREATE TABLE IF NOT EXISTS jobs (
+	id INT NOT NULL AUTO_INCREMENT,
+	uid VARCHAR(128) NOT NULL,
+	name VARCHAR(64) NOT NULL,
+	schedule VARCHAR(255) NOT NULL,
+	containerID INT,
+	image TEXT,
+	command TEXT NOT NULL,
+	env TEXT NOT NULL,
+	nextRunAt DATETIME NOT NULL,
+	PRIMARY KEY (id),
+	UNIQUE (uid, name),
+	INDEX(containerID),
+	INDEX(nextRunAt)
+);`
+
+const jobRunsSchema = `
+CREATE TABLE IF NOT EXISTS jobRuns (
+	id INT NOT NULL AUTO_INCREMENT,
+	jobID INT NOT NULL,
+	` + "`trigger`" + ` VARCHAR(16) NOT NULL,
+	status VARCHAR(16) NOT NULL,
+	startedAt DATETIME NOT NULL,
+	finishedAt DATETIME,
+	exitCode INT,
+	output MEDIUMTEXT NOT NULL,
+	message TEXT,
+	PRIMARY KEY (id),
+	INDEX(jobID)
+);`
+
+// oneOffContainersSchema holds the limits of the running one-off containers counted in the quotas
+const oneOffContainersSchema = `
+CREATE TABLE IF NOT EXISTS oneOffContainers (
+	id INT NOT NULL AUTO_INCREMENT,
+	uid VARCHAR(128) NOT NULL,
+	cpuLimit INT NOT NULL DEFAULT 0,
+	memoryLimit BIGINT NOT NULL DEFAULT 0,
+	storageLimit BIGINT NOT NULL DEFAULT 0,
+	PRIMARY KEY (id),
+	INDEX(uid)
+);`
+
+const userSecretsSchema = `
+CREATE TABLE IF NOT EXISTS userSecrets (
+	id INT NOT NULL AUTO_INCREMENT,
+	uid VARCHAR(128) NOT NULL,
+	name VARCHAR(64) NOT NULL,
+	value BLOB NOT NULL,
+	updatedAt DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+	PRIMARY KEY (id),
+	UNIQUE (uid, name)
+);`
+
+// userQuotasSchema holds the per-user overrides of the default quotas. NULL columns use the defaults.
+const userQuotasSchema = `
+CREATE TABLE IF NOT EXISTS userQuotas (
+	uid VARCHAR(128) NOT NULL,
+	containers INT,
+	cpu INT,
+	memory BIGINT,
+	storage BIGINT,
+	volumes INT,
+	PRIMARY KEY (uid)
+);`
+
 const authorizedKeysSchema = `
 CREATE TABLE IF NOT EXISTS authorizedKeys (
 	id INT NOT NULL AUTO_INCREMENT,
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/docker/libkv/store/consul"
@@ -39,6 +40,18 @@ func (c *Client) NewBackend(backend, serverName, addr string) error {
 	return err
 }
 
+func (c *Client) SetServerWeight(backend, serverName string, weight int) error {
+	return c.Client.Put(
+		c.Prefix+"/backends/"+backend+"/servers/"+serverName+"/weight",
+		[]byte(strconv.Itoa(weight)),
+		nil,
+	)
+}
+
+func (c *Client) DeleteServer(backend, serverName string) error {
+	return c.Client.DeleteTree(c.Prefix + "/backends/" + backend + "/servers/" + serverName)
+}
+
 func (c *Client) BackupBackend(backend, serverName string) ([]byte, error) {
 	keyPrefix := c.Prefix + "/backends/" + backend + "/servers/" + serverName + "/"
 	pairs, err := c.Client.List(keyPrefix)
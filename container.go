@@ -6,21 +6,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
-	"code.cloudfoundry.org/bytefmt"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
-	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/api/types/strslice"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/libkv/store"
 	"github.com/goadesign/goa"
+	"github.com/jmoiron/sqlx"
 	"github.com/modoki-paas/modoki/app"
 	"github.com/pkg/errors"
 	"golang.org/x/net/websocket"
@@ -47,9 +47,90 @@ func (c *ContainerController) Create(ctx *app.CreateContainerContext) error {
 		return ctx.BadRequest(goa.ErrBadRequest(err))
 	}
 
-	res, err := c.DB.ExecContext(ctx, `INSERT INTO containers (name, uid, status) VALUES (?, ?, "Waiting")`, ctx.Name, uid)
+	ports, err := parseContainerPorts(ctx.Ports, ctx.Protocol)
 
 	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	expiresAt, err := expirationTime(ctx.TTL, ctx.ExpiresAt, time.Now())
+
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	ceilings := c.resourceCeilings()
+	limits, err := requestedLimits(ceilings, ceilings, ctx.CPU, ctx.Memory, ctx.Storage, ctx.Pids)
+
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	if ctx.Kind != containerKindWeb {
+		if len(ports) != 0 {
+			return ctx.BadRequest(goa.ErrBadRequest(errors.New("Additional ports are available only for web containers")))
+		}
+
+		if ctx.IdleTimeout != 0 {
+			return ctx.BadRequest(goa.ErrBadRequest(errors.New("Only web containers can sleep")))
+		}
+	}
+
+	var health *healthCheck
+	if ctx.HealthCheckPath != nil && ctx.HealthCheckCommand != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.New("Only one of healthCheckPath and healthCheckCommand can be specified")))
+	} else if ctx.HealthCheckPath != nil || ctx.HealthCheckCommand != nil {
+		health = &healthCheck{
+			Command:     ctx.HealthCheckCommand,
+			Interval:    time.Duration(ctx.HealthCheckInterval) * time.Second,
+			Timeout:     time.Duration(ctx.HealthCheckTimeout) * time.Second,
+			Retries:     ctx.HealthCheckRetries,
+			StartPeriod: time.Duration(ctx.HealthCheckStartPeriod) * time.Second,
+		}
+
+		if ctx.HealthCheckPath != nil {
+			health.Path = *ctx.HealthCheckPath
+		}
+	}
+
+	secrets := make([]*containerSecret, 0, len(ctx.Secrets))
+	for i := range ctx.Secrets {
+		secret, err := parseContainerSecret(ctx.Secrets[i])
+
+		if err != nil {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+
+		if ok, err := c.Secrets.Exists(ctx, uid, secret.Name); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		} else if !ok {
+			return ctx.BadRequest(goa.ErrBadRequest(fmt.Errorf("The secret %s is not found", secret.Name)))
+		}
+
+		secrets = append(secrets, secret)
+	}
+
+	tx, err := c.DB.Begin()
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := reserveQuota(ctx, tx, c.Consul, uid, 0, containerUsage(limits, ctx.Replicas, len(ctx.Volumes))); err != nil {
+		tx.Rollback()
+
+		if _, ok := err.(*quotaExceededError); ok {
+			return ctx.Forbidden(goa.ErrInvalidRequest(err))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res, err := tx.ExecContext(ctx, `INSERT INTO containers (name, uid, status, port, protocol, sslRedirect, kind, restartPolicy, restartMaxRetries, replicas, idleTimeout, expiresAt, cpuLimit, memoryLimit, storageLimit, volumeCount) VALUES (?, ?, "Waiting", ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, ctx.Name, uid, ctx.Port, ctx.Protocol, ctx.SslRedirect, ctx.Kind, ctx.RestartPolicy, ctx.RestartMaxRetries, ctx.Replicas, ctx.IdleTimeout, expiresAt, limits.CPU, limits.Memory, limits.Storage, len(ctx.Volumes))
+
+	if err != nil {
+		tx.Rollback()
+
 		if strings.Contains(err.Error(), "Duplicate") && strings.Contains(err.Error(), "'name'") {
 			return ctx.Conflict(goa.ErrInvalidRequest(errors.New("The name is already used by another container")))
 		}
@@ -59,51 +140,92 @@ func (c *ContainerController) Create(ctx *app.CreateContainerContext) error {
 
 	var id int
 	if id64, err := res.LastInsertId(); err != nil {
+		tx.Rollback()
+
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	} else {
 		id = int(id64)
 	}
 
-	go func() {
-		c.must(c.updateStatus(context.Background(), "Creating", "", id))
+	for i := range ctx.Env {
+		name, value := parseEnv(ctx.Env[i])
+
+		_, err := tx.ExecContext(ctx, "INSERT INTO containerEnv (containerID, name, value) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE value=VALUES(value)", id, name, value)
+
+		if err != nil {
+			tx.Rollback()
+
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+
+	for i := range ports {
+		_, err := tx.ExecContext(ctx, "INSERT INTO containerPorts (containerID, name, port, protocol) VALUES (?, ?, ?, ?)", id, ports[i].Name, ports[i].Port, ports[i].Protocol)
+
+		if err != nil {
+			tx.Rollback()
+
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
 
-		type ImagePullProgress struct {
-			Status         string `json:"status"`
-			ProgressDetail struct {
-				Current int `json:"current"`
-				Total   int `json:"total"`
-			} `json:"progressDetail,omitempty"`
-			Progress string `json:"progress,omitempty"`
-			ID       string `json:"id,omitempty"`
+	for i := range secrets {
+		_, err := tx.ExecContext(ctx, "INSERT INTO containerSecrets (containerID, name, env, file) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE env=VALUES(env), file=VALUES(file)", id, secrets[i].Name, secrets[i].Env, secrets[i].File)
+
+		if err != nil {
+			tx.Rollback()
+
+			return ctx.InternalServerError(goa.ErrInternal(err))
 		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	go func() {
+		defer c.locks.lock(id)()
+
+		c.must(c.updateStatus(context.Background(), "Creating", "", id))
 
-		if rc, err := c.DockerClient.ImagePull(context.Background(), ctx.Image, types.ImagePullOptions{}); err != nil {
-			c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Downloading the image error: %v", err), id))
+		if err := c.pullImage(context.Background(), ctx.Image); err != nil {
+			c.must(c.updateStatus(context.Background(), "Error", err.Error(), id))
 
 			return
-		} else {
-			defer rc.Close()
+		}
 
-			decoder := json.NewDecoder(rc)
+		var port int
+		if ctx.Port != nil {
+			port = *ctx.Port
+		} else {
+			exposed, err := c.imageExposedPort(context.Background(), ctx.Image)
 
-			var status string
-			for {
-				var progress ImagePullProgress
+			if err != nil {
+				c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Inspecting the image error: %v", err), id))
 
-				if err := decoder.Decode(&progress); err != nil {
-					break
-				}
+				return
+			}
 
-				status = progress.Status
+			port = exposed
+			if port == 0 {
+				port = defaultContainerPort
 			}
 
-			if !(strings.Contains(status, "Downloaded") || strings.Contains(status, "up to date")) {
-				c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Image downloading error: %v", status), id))
+			if _, err := c.DB.Exec("UPDATE containers SET port=? WHERE id=?", port, id); err != nil {
+				c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Update containers table error: %v", err), id))
 
 				return
 			}
 		}
 
+		secretEnv, secretFiles, err := c.decryptContainerSecrets(context.Background(), id)
+
+		if err != nil {
+			c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Decrypting secrets error: %v", err), id))
+
+			return
+		}
+
 		volumesMap := make(map[string]struct{})
 
 		for i := range ctx.Volumes {
@@ -114,7 +236,7 @@ func (c *ContainerController) Create(ctx *app.CreateContainerContext) error {
 			Image:      ctx.Image,
 			Cmd:        strslice.StrSlice(ctx.Command),
 			Entrypoint: ctx.Entrypoint,
-			Env:        ctx.Env,
+			Env:        append(append([]string{}, ctx.Env...), secretEnv...),
 			Volumes:    volumesMap,
 			Labels: map[string]string{
 				dockerLabelModokiID:   strconv.Itoa(id),
@@ -127,55 +249,25 @@ func (c *ContainerController) Create(ctx *app.CreateContainerContext) error {
 			config.WorkingDir = *ctx.WorkingDir
 		}
 
-		var cpuMaxUsage int64 = 100
-		pair, err := c.Consul.Client.Get("modoki/cpu/max_usage")
-
-		if err == nil {
-			if c, err := strconv.Atoi(string(pair.Value)); err == nil && c > 0 && c <= 100 {
-				cpuMaxUsage = int64(c)
-			}
+		if ctx.MetricsPort != nil {
+			config.Labels[dockerLabelModokiMetricsPort] = strconv.Itoa(*ctx.MetricsPort)
 		}
-
-		var memMaxUsage int64
-		pair, err = c.Consul.Client.Get("modoki/memory/max_usage")
-
-		if err == nil {
-			if u, err := bytefmt.ToBytes(string(pair.Value)); err == nil && u > 0 {
-				memMaxUsage = int64(u)
-			}
+		if ctx.MetricsPath != nil {
+			config.Labels[dockerLabelModokiMetricsPath] = *ctx.MetricsPath
 		}
 
-		var storageMaxSize string
-		pair, err = c.Consul.Client.Get("modoki/storage/max_usage")
-
-		if err == nil {
-			if v, err := bytefmt.ToBytes(string(pair.Value)); err == nil && v > 0 {
-				storageMaxSize = string(pair.Value)
-			}
+		if health != nil {
+			config.Healthcheck = health.config(ctx.Protocol, port)
 		}
 
-		hostConfig := &container.HostConfig{}
+		hostConfig := limits.hostConfig()
 
-		if cpuMaxUsage != 100 {
-			hostConfig.Resources.CPUPeriod = 100000
-			hostConfig.Resources.CPUQuota = cpuMaxUsage * 1000
-		}
-		if memMaxUsage != 0 {
-			hostConfig.Resources.Memory = memMaxUsage
-		}
-
-		if storageMaxSize != "" {
-			hostConfig.StorageOpt = map[string]string{
-				"size": storageMaxSize,
-			}
-		}
+		networkingConfig, err := c.networkingConfig(context.Background(), id)
 
-		networkingConfig := &network.NetworkingConfig{}
+		if err != nil {
+			c.must(c.updateStatus(context.Background(), "Error", err.Error(), id))
 
-		if networkName != nil {
-			networkingConfig.EndpointsConfig = map[string]*network.EndpointSettings{
-				*networkName: &network.EndpointSettings{},
-			}
+			return
 		}
 
 		body, err := c.DockerClient.ContainerCreate(context.Background(), config, hostConfig, networkingConfig, "")
@@ -186,6 +278,12 @@ func (c *ContainerController) Create(ctx *app.CreateContainerContext) error {
 			return
 		}
 
+		if err := c.copySecretFiles(context.Background(), body.ID, secretFiles); err != nil {
+			c.must(c.updateStatus(context.Background(), "Error", err.Error(), id))
+
+			return
+		}
+
 		_, err = c.DB.Exec("UPDATE containers SET cid=? where id=?", body.ID, id)
 
 		if err != nil {
@@ -194,29 +292,48 @@ func (c *ContainerController) Create(ctx *app.CreateContainerContext) error {
 			return
 		}
 
-		frontendName := fmt.Sprintf(frontendFormat, id)
-		backendName := fmt.Sprintf(backendFormat, id)
-		if err := c.Consul.NewFrontend(frontendName, "Host: "+ctx.Name+"."+*publicAddr); err != nil {
-			c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Update traefik error: %v", err), id))
+		// Workers and private containers are not exposed on the subdomain
+		if ctx.Kind == containerKindWeb {
+			frontendName := fmt.Sprintf(frontendFormat, id)
+			backendName := fmt.Sprintf(backendFormat, id)
+			if err := c.newFrontend(frontendName, backendName, ctx.Name+"."+*publicAddr, ctx.SslRedirect); err != nil {
+				c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Update traefik error: %v", err), id))
 
-			return
+				return
+			}
 		}
-		if err := c.Consul.AddValueForFrontend(frontendName, "passHostHeader", true); err != nil {
-			c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Update traefik error: %v", err), id))
 
-			return
-		}
+		for i := range ports {
+			frontendName := fmt.Sprintf(portFrontendFormat, id, ports[i].Name)
+			backendName := fmt.Sprintf(portBackendFormat, id, ports[i].Name)
 
-		if *https {
-			if err := c.Consul.AddValueForFrontend(frontendName, "headers", "sslredirect", ctx.SslRedirect); err != nil {
+			if err := c.newFrontend(frontendName, backendName, ports[i].Name+"."+ctx.Name+"."+*publicAddr, ctx.SslRedirect); err != nil {
 				c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Update traefik error: %v", err), id))
 
 				return
 			}
 		}
 
-		if err := c.Consul.AddValueForFrontend(frontendName, "backend", backendName); err != nil {
-			c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Update traefik error: %v", err), id))
+		release := &containerRelease{
+			Image:      ctx.Image,
+			Env:        ctx.Env,
+			Command:    ctx.Command,
+			Entrypoint: ctx.Entrypoint,
+			Cause:      releaseCauseCreate,
+		}
+
+		if ctx.WorkingDir != nil {
+			release.WorkingDir = *ctx.WorkingDir
+		}
+
+		if err := c.addRelease(context.Background(), id, release); err != nil {
+			c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Recording the release error: %v", err), id))
+
+			return
+		}
+
+		if err := c.scaleReplicasLocked(context.Background(), id, ctx.Replicas, defaultStopTimeout); err != nil {
+			c.must(c.updateStatus(context.Background(), "Error", fmt.Sprintf("Creating replicas error: %v", err), id))
 
 			return
 		}
@@ -224,17 +341,14 @@ func (c *ContainerController) Create(ctx *app.CreateContainerContext) error {
 		c.must(c.updateStatus(context.Background(), "Created", "", id))
 	}()
 
-	var eps []string
+	eps := []string{}
 
-	if *https {
-		eps = []string{
-			"https://" + ctx.Name + "." + *publicAddr,
-			"http://" + ctx.Name + "." + *publicAddr,
-		}
-	} else {
-		eps = []string{
-			"http://" + ctx.Name + "." + *publicAddr,
-		}
+	if ctx.Kind == containerKindWeb {
+		eps = endpoints(ctx.Name + "." + *publicAddr)
+	}
+
+	for i := range ports {
+		eps = append(eps, endpoints(ports[i].Name+"."+ctx.Name+"."+*publicAddr)...)
 	}
 
 	cres := &app.GoaContainerCreateResults{
@@ -322,61 +436,20 @@ func (c *ContainerController) Remove(ctx *app.RemoveContainerContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	rows, err := c.DB.Query("SELECT id, cid, status FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
 
-	if err != nil {
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
 
-	rows.Next()
-
-	var id int
-	var cid sql.NullString
-	var status string
-	if err := rows.Scan(&id, &cid, &status); err != nil {
-		rows.Close()
+	if err := c.removeContainer(ctx, id, ctx.Force); err == errContainerNotFound {
 		return ctx.NotFound()
-	}
-	rows.Close()
-
-	if status != "Error" {
-		if !cid.Valid {
-			return ctx.NotFound()
-		}
-
-		if err := c.DockerClient.ContainerRemove(
-			context.Background(),
-			cid.String,
-			types.ContainerRemoveOptions{
-				RemoveVolumes: true,
-				Force:         ctx.Force,
-			},
-		); err != nil {
-			if strings.Contains(err.Error(), "You cannot remove a running container") {
-				return ctx.RunningContainer()
-			} else {
-				return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API Error")))
-			}
-		}
-	}
-
-	if _, err := c.DB.Exec("DELETE FROM containers WHERE id=?", id); err != nil {
-		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Deletion From Database Error")))
-	}
-
-	frontendName := fmt.Sprintf(frontendFormat, id)
-	backendName := fmt.Sprintf(backendFormat, id)
-
-	if err := c.Consul.DeleteBackend(backendName); err != nil {
-		if err != store.ErrKeyNotFound {
-			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Consul Error")))
-		}
-
-	}
-	if err := c.Consul.DeleteFrontend(frontendName); err != nil {
-		if err != store.ErrKeyNotFound {
-			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Consul Error")))
-		}
+	} else if err == errContainerRunning {
+		return ctx.RunningContainer()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
 	return ctx.NoContent()
@@ -414,35 +487,9 @@ func (c *ContainerController) Start(ctx *app.StartContainerContext) error {
 		return ctx.NotFound()
 	}
 
-	resp, err := c.DockerClient.HTTPClient().Post("http://"+*dockerAPIVersion+"/containers/"+cid.String+"/start", "", nil)
-
-	if err != nil {
-		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
-	}
-	defer func() {
-		if resp.Body != nil {
-			resp.Body.Close()
-		}
-	}()
-	switch resp.StatusCode {
-	case http.StatusOK:
-		// Do nothing
-	case 304: // Already started
-		return ctx.NoContent()
-	case http.StatusNotFound:
+	if err := c.startContainer(ctx, id, cid.String); err == errContainerNotFound {
 		return ctx.NotFound()
-	case http.StatusInternalServerError:
-		type message struct {
-			Message string `json:"message"`
-		}
-
-		var msg message
-		json.NewDecoder(resp.Body).Decode(&msg)
-
-		return ctx.InternalServerError(fmt.Errorf("Container starting error: %s", msg.Message))
-	}
-
-	if err := c.updateContainerStatus(context.Background(), cid.String); err != nil {
+	} else if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
@@ -481,13 +528,7 @@ func (c *ContainerController) Stop(ctx *app.StopContainerContext) error {
 		return ctx.NotFound()
 	}
 
-	d := 15 * time.Second
-
-	if err := c.DockerClient.ContainerStop(context.Background(), cid.String, &d); err != nil {
-		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
-	}
-
-	if err := c.updateContainerStatus(context.Background(), cid.String); err != nil {
+	if err := c.stopContainer(ctx, id, cid.String, time.Duration(ctx.Timeout)*time.Second); err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
@@ -496,9 +537,9 @@ func (c *ContainerController) Stop(ctx *app.StopContainerContext) error {
 	// ContainerController_Stop: end_implement
 }
 
-// Inspect runs the inspect action.
-func (c *ContainerController) Inspect(ctx *app.InspectContainerContext) error {
-	// ContainerController_Inspect: start_implement
+// Restart runs the restart action.
+func (c *ContainerController) Restart(ctx *app.RestartContainerContext) error {
+	// ContainerController_Restart: start_implement
 
 	uid, err := GetUIDFromJWT(ctx)
 
@@ -506,95 +547,85 @@ func (c *ContainerController) Inspect(ctx *app.InspectContainerContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	rows, err := c.DB.Query("SELECT id, cid, name, status FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+	var id int
+	var cid sql.NullString
+	var envPending bool
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid, envPending FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid, &envPending)
 
-	if err != nil {
+	if err == sql.ErrNoRows || (err == nil && !cid.Valid) {
+		return ctx.NotFound()
+	} else if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
 
-	rows.Next()
+	d := time.Duration(ctx.Timeout) * time.Second
 
-	var id int
-	var cid sql.NullString
-	var name, status string
-	if err := rows.Scan(&id, &cid, &name, &status); err != nil {
-		rows.Close()
-		return ctx.NotFound()
+	// The exit by restarting is not handled by the restart policy. manualStop is cleared when the container starts
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET restartCount=0, crashCount=0, manualStop=TRUE, sleeping=FALSE WHERE id=?", id); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
-	rows.Close()
 
-	if status == "Error" || status == "Creating" {
-		insp := &app.GoaContainerInspect{
-			ID:     id,
-			Name:   name,
-			Status: status,
+	// Deferred environment variables are applied by recreating the container instead
+	if envPending {
+		rc := &redeployConfig{
+			Timeout:  d,
+			Strategy: "recreate",
+
+			// Restarting never upgrades the image
+			KeepImage: true,
 		}
 
-		return ctx.OK(insp)
-	}
+		if err := c.redeploy(context.Background(), id, rc); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
 
-	if !cid.Valid {
-		return ctx.NotFound()
-	}
+		if err := c.DB.QueryRowContext(ctx, "SELECT cid FROM containers WHERE id=?", id).Scan(&cid); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+		}
 
-	j, err := c.DockerClient.ContainerInspect(ctx, cid.String)
+		if err := c.DockerClient.ContainerStart(context.Background(), cid.String, types.ContainerStartOptions{}); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
+		}
 
-	if err != nil {
-		return errors.Wrap(err, "Container Inspect Error")
-	}
+		err = c.forEachReplica(ctx, id, func(cid string) error {
+			return c.DockerClient.ContainerStart(context.Background(), cid, types.ContainerStartOptions{})
+		})
 
-	t, _ := time.Parse(time.RFC3339Nano, j.Created)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
+		}
 
-	vols := make([]string, 0, len(j.Config.Volumes))
-	for k := range j.Config.Volumes {
-		vols = append(vols, k)
+		if err := c.updateContainerStatus(context.Background(), cid.String); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+
+		return ctx.NoContent()
 	}
 
-	insp := &app.GoaContainerInspect{
-		Args:    j.Args,
-		Created: t,
-		ID:      id,
-		Image:   j.Config.Image,
-		ImageID: j.Image,
-		Name:    name,
-		Path:    j.Path,
-		Volumes: vols,
+	if err := c.DockerClient.ContainerRestart(context.Background(), cid.String, &d); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
 	}
 
-	rawState := j.State
+	err = c.forEachReplica(ctx, id, func(cid string) error {
+		return c.DockerClient.ContainerRestart(context.Background(), cid, &d)
+	})
 
-	switch rawState.Status {
-	case "created":
-		insp.Status = "Created"
-	case "Running":
-		insp.Status = "Running"
-	default:
-		insp.Status = "Stopped"
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
 	}
 
-	s, _ := time.Parse(time.RFC3339Nano, rawState.StartedAt)
-	f, _ := time.Parse(time.RFC3339Nano, rawState.FinishedAt)
-
-	insp.RawState = &app.GoaContainerInspectRawState{
-		Dead:       rawState.Dead,
-		ExitCode:   rawState.ExitCode,
-		FinishedAt: f,
-		OomKilled:  rawState.OOMKilled,
-		Paused:     rawState.Paused,
-		Pid:        rawState.Pid,
-		Restarting: rawState.Restarting,
-		Running:    rawState.Running,
-		StartedAt:  s,
-		Status:     rawState.Status,
+	if err := c.updateContainerStatus(context.Background(), cid.String); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	return ctx.OK(insp)
-	// ContainerController_Inspect: end_implement
+	return ctx.NoContent()
+
+	// ContainerController_Restart: end_implement
 }
 
-// Inspect runs the inspect action.
-func (c *ContainerController) List(ctx *app.ListContainerContext) error {
-	// ContainerController_List: start_implement
+// Scale runs the scale action.
+func (c *ContainerController) Scale(ctx *app.ScaleContainerContext) error {
+	// ContainerController_Scale: start_implement
 
 	uid, err := GetUIDFromJWT(ctx)
 
@@ -602,89 +633,1808 @@ func (c *ContainerController) List(ctx *app.ListContainerContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	filter := filters.NewArgs()
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	limits, _, volumes, err := lockContainerLimits(ctx, tx, id)
+
+	if err != nil {
+		tx.Rollback()
+
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := reserveQuota(ctx, tx, c.Consul, uid, id, containerUsage(limits, ctx.Replicas, volumes)); err != nil {
+		tx.Rollback()
+
+		if _, ok := err.(*quotaExceededError); ok {
+			return ctx.Forbidden(goa.ErrInvalidRequest(err))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE containers SET replicas=? WHERE id=?", ctx.Replicas, id); err != nil {
+		tx.Rollback()
+
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if err := c.scaleReplicas(ctx, id, ctx.Replicas, time.Duration(ctx.Timeout)*time.Second); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.NoContent()
+
+	// ContainerController_Scale: end_implement
+}
+
+// Redeploy runs the redeploy action.
+func (c *ContainerController) Redeploy(ctx *app.RedeployContainerContext) error {
+	// ContainerController_Redeploy: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows || (err == nil && !cid.Valid) {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	rc := &redeployConfig{
+		Image:      ctx.Image,
+		Command:    ctx.Command,
+		Entrypoint: ctx.Entrypoint,
+		Env:        ctx.Env,
+		WorkingDir: ctx.WorkingDir,
+		Timeout:    time.Duration(ctx.Timeout) * time.Second,
+
+		Strategy:      ctx.Strategy,
+		HealthTimeout: time.Duration(ctx.HealthTimeout) * time.Second,
+		DrainPeriod:   time.Duration(ctx.DrainPeriod) * time.Second,
+	}
+
+	go func() {
+		if err := c.redeploy(context.Background(), id, rc); err != nil {
+			log.Println("Redeploying error:", err)
+
+			c.must(c.updateMessage(context.Background(), fmt.Sprintf("Redeploying error: %v", err), id))
+		}
+	}()
+
+	return ctx.Accepted()
+
+	// ContainerController_Redeploy: end_implement
+}
+
+// Releases runs the releases action.
+func (c *ContainerController) Releases(ctx *app.ReleasesContainerContext) error {
+	// ContainerController_Releases: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	releases, err := c.listReleases(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	secrets, err := c.secretEnvNames(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaContainerReleaseCollection, len(releases))
+	for i := range releases {
+		res[i] = releases[i].media()
+		res[i].Env = maskEnv(res[i].Env, secrets)
+	}
+
+	return ctx.OK(res)
+
+	// ContainerController_Releases: end_implement
+}
+
+// Rollback runs the rollback action.
+func (c *ContainerController) Rollback(ctx *app.RollbackContainerContext) error {
+	// ContainerController_Rollback: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows || (err == nil && !cid.Valid) {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	releases, err := c.listReleases(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var release *containerRelease
+	if ctx.Release == nil {
+		if len(releases) < 2 {
+			return ctx.BadRequest(goa.ErrBadRequest(errors.New("There is no previous release")))
+		}
+
+		release = releases[1]
+	} else {
+		for i := range releases {
+			if releases[i].Version == *ctx.Release {
+				release = releases[i]
+
+				break
+			}
+		}
+
+		if release == nil {
+			return ctx.NotFound()
+		}
+	}
+
+	ports, err := release.portsConfig()
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	image := release.imageReference()
+	rc := &redeployConfig{
+		Image:      &image,
+		Command:    append([]string{}, release.Command...),
+		Entrypoint: append([]string{}, release.Entrypoint...),
+		Env:        append([]string{}, release.Env...),
+		WorkingDir: &release.WorkingDir,
+		Ports:      ports,
+		Timeout:    time.Duration(ctx.Timeout) * time.Second,
+
+		Strategy:      ctx.Strategy,
+		HealthTimeout: time.Duration(ctx.HealthTimeout) * time.Second,
+		DrainPeriod:   time.Duration(ctx.DrainPeriod) * time.Second,
+
+		Cause: releaseCauseRollback,
+	}
+
+	go func() {
+		if err := c.redeploy(context.Background(), id, rc); err != nil {
+			log.Println("Rolling back error:", err)
+
+			c.must(c.updateMessage(context.Background(), fmt.Sprintf("Rolling back error: %v", err), id))
+		}
+	}()
+
+	return ctx.Accepted()
+
+	// ContainerController_Rollback: end_implement
+}
+
+// GetEnv runs the getEnv action.
+func (c *ContainerController) GetEnv(ctx *app.GetEnvContainerContext) error {
+	// ContainerController_GetEnv: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	envs, err := c.listContainerEnv(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaContainerEnvCollection, len(envs))
+	for i := range envs {
+		res[i] = envs[i].media()
+	}
+
+	return ctx.OK(res)
+
+	// ContainerController_GetEnv: end_implement
+}
+
+// SetEnv runs the setEnv action.
+func (c *ContainerController) SetEnv(ctx *app.SetEnvContainerContext) error {
+	// ContainerController_SetEnv: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	envs := make([]*containerEnv, 0, len(ctx.Payload))
+	for i := range ctx.Payload {
+		if ctx.Payload[i] != nil {
+			envs = append(envs, &containerEnv{
+				Name:   ctx.Payload[i].Name,
+				Value:  ctx.Payload[i].Value,
+				Secret: ctx.Payload[i].Secret,
+			})
+		}
+	}
+
+	tx, err := c.DB.BeginTxx(ctx, nil)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := upsertContainerEnv(ctx, tx, id, envs); err != nil {
+		tx.Rollback()
+
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := c.applyEnv(ctx, id, cid, ctx.Deferred); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if ctx.Deferred || !cid.Valid {
+		return ctx.NoContent()
+	}
+
+	return ctx.Accepted()
+
+	// ContainerController_SetEnv: end_implement
+}
+
+// RemoveEnv runs the removeEnv action.
+func (c *ContainerController) RemoveEnv(ctx *app.RemoveEnvContainerContext) error {
+	// ContainerController_RemoveEnv: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	query, args, err := sqlx.In("DELETE FROM containerEnv WHERE containerID=? AND name IN (?)", id, ctx.Name)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res, err := c.DB.ExecContext(ctx, c.DB.Rebind(query), args...)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	} else if n == 0 {
+		return ctx.NotFound()
+	}
+
+	if err := c.applyEnv(ctx, id, cid, ctx.Deferred); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if ctx.Deferred || !cid.Valid {
+		return ctx.NoContent()
+	}
+
+	return ctx.Accepted()
+
+	// ContainerController_RemoveEnv: end_implement
+}
+
+// ListSecrets runs the listSecrets action.
+func (c *ContainerController) ListSecrets(ctx *app.ListSecretsContainerContext) error {
+	// ContainerController_ListSecrets: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	secrets, err := c.listContainerSecrets(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaContainerSecretCollection, len(secrets))
+	for i := range secrets {
+		res[i] = secrets[i].media()
+	}
+
+	return ctx.OK(res)
+
+	// ContainerController_ListSecrets: end_implement
+}
+
+// AddSecret runs the addSecret action.
+func (c *ContainerController) AddSecret(ctx *app.AddSecretContainerContext) error {
+	// ContainerController_AddSecret: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if ctx.Env != nil && ctx.File != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.New("Only one of env and file can be specified")))
+	}
+
+	var env, file string
+	if ctx.Env != nil {
+		env = *ctx.Env
+	}
+	if ctx.File != nil {
+		file = *ctx.File
+	}
+
+	secret, err := newContainerSecret(ctx.Name, env, file)
+
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	if ok, err := c.Secrets.Exists(ctx, uid, secret.Name); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	} else if !ok {
+		return ctx.BadRequest(goa.ErrBadRequest(fmt.Errorf("The secret %s is not found", secret.Name)))
+	}
+
+	_, err = c.DB.ExecContext(ctx, "INSERT INTO containerSecrets (containerID, name, env, file) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE env=VALUES(env), file=VALUES(file)", id, secret.Name, secret.Env, secret.File)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if err := c.applyEnv(ctx, id, cid, ctx.Deferred); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if ctx.Deferred || !cid.Valid {
+		return ctx.NoContent()
+	}
+
+	return ctx.Accepted()
+
+	// ContainerController_AddSecret: end_implement
+}
+
+// RemoveSecret runs the removeSecret action.
+func (c *ContainerController) RemoveSecret(ctx *app.RemoveSecretContainerContext) error {
+	// ContainerController_RemoveSecret: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	res, err := c.DB.ExecContext(ctx, "DELETE FROM containerSecrets WHERE containerID=? AND name=?", id, ctx.Name)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	} else if n == 0 {
+		return ctx.NotFound()
+	}
+
+	if err := c.applyEnv(ctx, id, cid, ctx.Deferred); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if ctx.Deferred || !cid.Valid {
+		return ctx.NoContent()
+	}
+
+	return ctx.Accepted()
+
+	// ContainerController_RemoveSecret: end_implement
+}
+
+// Pause runs the pause action.
+func (c *ContainerController) Pause(ctx *app.PauseContainerContext) error {
+	// ContainerController_Pause: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows || (err == nil && !cid.Valid) {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	j, err := c.DockerClient.ContainerInspect(ctx, cid.String)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
+	}
+
+	if !j.State.Running {
+		return ctx.NotRunning()
+	}
+
+	if !j.State.Paused {
+		if err := c.DockerClient.ContainerPause(context.Background(), cid.String); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
+		}
+	}
+
+	err = c.forEachReplica(ctx, id, func(cid string) error {
+		j, err := c.DockerClient.ContainerInspect(ctx, cid)
+
+		if err != nil {
+			return err
+		}
+
+		if j.State.Running && !j.State.Paused {
+			return c.DockerClient.ContainerPause(context.Background(), cid)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
+	}
+
+	if err := c.updateContainerStatus(context.Background(), cid.String); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.NoContent()
+
+	// ContainerController_Pause: end_implement
+}
+
+// Unpause runs the unpause action.
+func (c *ContainerController) Unpause(ctx *app.UnpauseContainerContext) error {
+	// ContainerController_Unpause: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows || (err == nil && !cid.Valid) {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	j, err := c.DockerClient.ContainerInspect(ctx, cid.String)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
+	}
+
+	if j.State.Paused {
+		if err := c.DockerClient.ContainerUnpause(context.Background(), cid.String); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
+		}
+	}
+
+	err = c.forEachReplica(ctx, id, func(cid string) error {
+		j, err := c.DockerClient.ContainerInspect(ctx, cid)
+
+		if err != nil {
+			return err
+		}
+
+		if j.State.Paused {
+			return c.DockerClient.ContainerUnpause(context.Background(), cid)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API error")))
+	}
+
+	if err := c.updateContainerStatus(context.Background(), cid.String); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.NoContent()
+
+	// ContainerController_Unpause: end_implement
+}
+
+// Inspect runs the inspect action.
+func (c *ContainerController) Inspect(ctx *app.InspectContainerContext) error {
+	// ContainerController_Inspect: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	rows, err := c.DB.Query("SELECT id, cid, name, status, port, protocol, kind, restartPolicy, restartCount, exitCode, replicas, expiresAt FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	rows.Next()
+
+	var id, restartCount, replicas int
+	var cid sql.NullString
+	var name, status, protocol, kind, restartPolicy string
+	var port, exitCode sql.NullInt64
+	var expiresAt *time.Time
+	if err := rows.Scan(&id, &cid, &name, &status, &port, &protocol, &kind, &restartPolicy, &restartCount, &exitCode, &replicas, &expiresAt); err != nil {
+		rows.Close()
+		return ctx.NotFound()
+	}
+	rows.Close()
+
+	var portPtr *int
+	if port.Valid {
+		p := int(port.Int64)
+		portPtr = &p
+	}
+
+	if status == "Error" || status == "Creating" {
+		insp := &app.GoaContainerInspect{
+			ID:       id,
+			Name:     name,
+			Port:     portPtr,
+			Protocol: &protocol,
+			Kind:     &kind,
+			Status:   status,
+
+			ExpiresAt: expiresAt,
+		}
+
+		return ctx.OK(insp)
+	}
+
+	if !cid.Valid {
+		return ctx.NotFound()
+	}
+
+	j, err := c.DockerClient.ContainerInspect(ctx, cid.String)
+
+	if err != nil {
+		return errors.Wrap(err, "Container Inspect Error")
+	}
+
+	t, _ := time.Parse(time.RFC3339Nano, j.Created)
+
+	vols := make([]string, 0, len(j.Config.Volumes))
+	for k := range j.Config.Volumes {
+		vols = append(vols, k)
+	}
+
+	insp := &app.GoaContainerInspect{
+		Args:     j.Args,
+		Created:  t,
+		ID:       id,
+		Image:    j.Config.Image,
+		ImageID:  j.Image,
+		Name:     name,
+		Path:     j.Path,
+		Port:     portPtr,
+		Protocol: &protocol,
+		Kind:     &kind,
+		Volumes:  vols,
+
+		RestartPolicy: &restartPolicy,
+		RestartCount:  &restartCount,
+		Replicas:      &replicas,
+		ExpiresAt:     expiresAt,
+		Limits:        hostConfigLimits(j.HostConfig).media(),
+	}
+
+	running, err := c.countRunningReplicas(ctx, id, j.State.Running && !j.State.Paused)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	insp.RunningReplicas = &running
+
+	schedules, err := c.listContainerSchedules(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	for i := range schedules {
+		insp.Schedules = append(insp.Schedules, schedules[i].media())
+	}
+
+	if exitCode.Valid {
+		e := int(exitCode.Int64)
+		insp.ExitCode = &e
+	}
+
+	rawState := j.State
+
+	switch rawState.Status {
+	case "created":
+		insp.Status = "Created"
+	case "running":
+		insp.Status = healthStatus(rawState.Health)
+	case "paused":
+		insp.Status = "Paused"
+	default:
+		insp.Status = "Stopped"
+
+		if status == "CrashLooping" || status == "Sleeping" {
+			insp.Status = status
+		}
+	}
+
+	s, _ := time.Parse(time.RFC3339Nano, rawState.StartedAt)
+	f, _ := time.Parse(time.RFC3339Nano, rawState.FinishedAt)
+
+	insp.RawState = &app.GoaContainerInspectRawState{
+		Dead:       rawState.Dead,
+		ExitCode:   rawState.ExitCode,
+		FinishedAt: f,
+		OomKilled:  rawState.OOMKilled,
+		Paused:     rawState.Paused,
+		Pid:        rawState.Pid,
+		Restarting: rawState.Restarting,
+		Running:    rawState.Running,
+		StartedAt:  s,
+		Status:     rawState.Status,
+		Health:     healthMedia(rawState.Health),
+	}
+
+	return ctx.OK(insp)
+	// ContainerController_Inspect: end_implement
+}
+
+// Inspect runs the inspect action.
+func (c *ContainerController) List(ctx *app.ListContainerContext) error {
+	// ContainerController_List: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	filter := filters.NewArgs()
 
 	filter.Add("label", dockerLabelModokiUID+"="+uid)
 
-	list, err := c.DockerClient.ContainerList(ctx, types.ContainerListOptions{
-		All:     true,
-		Filters: filter,
-	})
+	list, err := c.DockerClient.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: filter,
+	})
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API Error")))
+	}
+	res := make(app.GoaContainerListEachCollection, 0, len(list)+10)
+
+	rows, err := c.DB.Query(`SELECT id, name, message, status, port, protocol, kind, replicas, expiresAt FROM containers WHERE uid=?`, uid)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	defer rows.Close()
+
+	type portConfig struct {
+		port      *int
+		protocol  string
+		kind      string
+		status    string
+		replicas  int
+		expiresAt *time.Time
+	}
+	ports := make(map[int]portConfig)
+
+	for rows.Next() {
+		var id, replicas int
+		var name, status, protocol, kind string
+		var msg sql.NullString
+		var port sql.NullInt64
+		var expiresAt *time.Time
+
+		if err := rows.Scan(&id, &name, &msg, &status, &port, &protocol, &kind, &replicas, &expiresAt); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+		}
+
+		var portPtr *int
+		if port.Valid {
+			p := int(port.Int64)
+			portPtr = &p
+		}
+		ports[id] = portConfig{port: portPtr, protocol: protocol, kind: kind, status: status, replicas: replicas, expiresAt: expiresAt}
+
+		if status != "Error" && status != "Creating" {
+			continue
+		}
+
+		res = append(res, &app.GoaContainerListEach{
+			ID:       id,
+			Name:     name,
+			Command:  msg.String,
+			Port:     portPtr,
+			Protocol: &protocol,
+			Kind:     &kind,
+			Replicas: &replicas,
+			Status:   status,
+
+			ExpiresAt: expiresAt,
+		})
+	}
+	rows.Close()
+
+	// The replicas are listed as one container
+	running := make(map[int]int)
+	for i := range list {
+		if strings.ToLower(list[i].State) == "running" {
+			id, _ := strconv.Atoi(list[i].Labels[dockerLabelModokiID])
+			running[id]++
+		}
+	}
+
+	for i := range list {
+		j := list[i]
+
+		if n, _ := strconv.Atoi(j.Labels[dockerLabelModokiReplica]); n > 0 {
+			continue
+		}
+
+		// One-off containers are not listed
+		if _, ok := j.Labels[dockerLabelModokiID]; !ok {
+			continue
+		}
+
+		vols := make([]string, 0, len(j.Mounts))
+		for k := range j.Mounts {
+			vols = append(vols, j.Mounts[k].Destination)
+		}
+
+		t := time.Unix(j.Created, 0)
+		id, _ := strconv.Atoi(j.Labels[dockerLabelModokiID])
+		name := j.Labels[dockerLabelModokiName]
+
+		var state string
+
+		switch strings.ToLower(j.State) {
+		case "running":
+			state = "Running"
+
+			if strings.Contains(j.Status, "(healthy)") {
+				state = "Healthy"
+			} else if strings.Contains(j.Status, "(unhealthy)") {
+				state = "Unhealthy"
+			}
+		case "paused":
+			state = "Paused"
+		case "created":
+			state = "Created"
+		default:
+			state = "Stopped"
+		}
+
+		pc := ports[id]
+
+		if state == "Stopped" && (pc.status == "CrashLooping" || pc.status == "Sleeping") {
+			state = pc.status
+		}
+
+		runningReplicas := running[id]
+
+		each := &app.GoaContainerListEach{
+			Command:  j.Command,
+			Created:  t,
+			ID:       id,
+			Image:    j.Image,
+			ImageID:  j.ImageID,
+			Name:     name,
+			Port:     pc.port,
+			Protocol: &pc.protocol,
+			Kind:     &pc.kind,
+			Status:   state,
+			Volumes:  vols,
+
+			Replicas:        &pc.replicas,
+			RunningReplicas: &runningReplicas,
+			ExpiresAt:       pc.expiresAt,
+		}
+
+		res = append(res, each)
+	}
+
+	return ctx.OK(res)
+	// ContainerController_List: end_implement
+}
+
+// Upload runs the upload action.
+func (c *ContainerController) Upload(ctx *app.UploadContainerContext) error {
+	// ContainerController_Upload: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	rows, err := c.DB.Query("SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	rows.Next()
+
+	var id int
+	var cid sql.NullString
+	if err := rows.Scan(&id, &cid); err != nil {
+		rows.Close()
+		return ctx.NotFound(goa.ErrInternal(errors.New("No container found")))
+	}
+	rows.Close()
+
+	if !cid.Valid {
+		return ctx.NotFound(goa.ErrInternal(errors.New("No container found")))
+	}
+
+	reader, err := ctx.Payload.Data.Open()
+
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.Wrap(err, "Opening the form error")))
+	}
+	defer reader.Close()
+
+	if err := c.DockerClient.CopyToContainer(ctx, cid.String, ctx.Payload.Path, reader, types.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: ctx.Payload.AllowOverwrite,
+		CopyUIDGID:                ctx.Payload.CopyUIDGID,
+	}); err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return ctx.NotFound(goa.ErrNotFound(errors.New("The path does not exist")))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Failed to copy a file via Docker API")))
+	}
+
+	return ctx.NoContent()
+	// ContainerController_Upload: end_implement
+}
+
+// Logs runs the logs action.
+func (c *ContainerController) Logs(ctx *app.LogsContainerContext) error {
+	// ContainerController_Logs: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	rows, err := c.DB.Query("SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	rows.Next()
+
+	var id int
+	var cid sql.NullString
+	if err := rows.Scan(&id, &cid); err != nil {
+		rows.Close()
+		return ctx.NotFound(goa.ErrNotFound(errors.New("No container found")))
+	}
+	rows.Close()
+
+	if !cid.Valid {
+		return ctx.NotFound(goa.ErrNotFound(errors.New("No container found")))
+	}
+
+	opts := types.ContainerLogsOptions{
+		ShowStderr: ctx.Stderr,
+		ShowStdout: ctx.Stdout,
+		Timestamps: ctx.Timestamps,
+		Follow:     ctx.Follow,
+		Tail:       ctx.Tail,
+	}
+
+	if ctx.Since != nil {
+		opts.Since = ctx.Since.Format(time.RFC3339)
+	}
+	if ctx.Until != nil {
+		opts.Until = ctx.Until.Format(time.RFC3339)
+	}
+
+	replicas, err := c.listReplicas(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if len(replicas) != 0 {
+		replicas = append([]*containerReplica{{Replica: 0, CID: cid.String}}, replicas...)
+
+		handler := websocket.Handler(func(conn *websocket.Conn) {
+			defer trackWebsocketSession(websocketSessionLogs)()
+
+			if err := c.copyReplicaLogs(ctx, conn, replicas, opts); err != nil {
+				log.Println("Streaming logs error:", err)
+			}
+		})
+
+		handler.ServeHTTP(ctx.ResponseWriter, ctx.Request)
+		return nil
+	}
+
+	rc, err := c.DockerClient.ContainerLogs(ctx, cid.String, opts)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	defer rc.Close()
+
+	handler := websocket.Handler(func(conn *websocket.Conn) {
+		defer trackWebsocketSession(websocketSessionLogs)()
+
+		io.Copy(conn, rc)
+	})
+
+	handler.ServeHTTP(ctx.ResponseWriter, ctx.Request)
+	return nil
+	// ContainerController_Logs: end_implement
+}
+
+// Stats runs the stats action.
+func (c *ContainerController) Stats(ctx *app.StatsContainerContext) error {
+	// ContainerController_Stats: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows || (err == nil && !cid.Valid) {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	replicas, err := c.replicaContainers(ctx, id, cid.String)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaContainerStatsCollection, 0, len(replicas))
+	for i := range replicas {
+		j, err := c.containerStats(ctx, replicas[i].CID)
+
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+
+		res = append(res, statsMedia(replicas[i].Replica, j))
+	}
+
+	return ctx.OK(res)
+
+	// ContainerController_Stats: end_implement
+}
+
+// StatsStream runs the statsStream action.
+func (c *ContainerController) StatsStream(ctx *app.StatsStreamContainerContext) error {
+	// ContainerController_StatsStream: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
+
+	if err == sql.ErrNoRows || (err == nil && !cid.Valid) {
+		return ctx.NotFound(goa.ErrNotFound(errors.New("No container found")))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	replicas, err := c.replicaContainers(ctx, id, cid.String)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	handler := websocket.Handler(func(conn *websocket.Conn) {
+		defer trackWebsocketSession(websocketSessionStats)()
+
+		err := c.streamStats(ctx, replicas, func(stats *app.GoaContainerStats) error {
+			return websocket.JSON.Send(conn, stats)
+		})
+
+		if err != nil {
+			log.Println("Streaming stats error:", err)
+		}
+	})
+
+	handler.ServeHTTP(ctx.ResponseWriter, ctx.Request)
+	return nil
+
+	// ContainerController_StatsStream: end_implement
+}
+
+// Metrics runs the metrics action.
+func (c *ContainerController) Metrics(ctx *app.MetricsContainerContext) error {
+	// ContainerController_Metrics: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	to := time.Now()
+	if ctx.To != nil {
+		to = *ctx.To
+	}
+
+	from := to.Add(-time.Hour)
+	if ctx.From != nil {
+		from = *ctx.From
+	}
+
+	step := time.Duration(ctx.Step) * time.Second
+
+	if !from.Before(to) {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.New("from must be before to")))
+	}
+
+	if to.Sub(from)/step > metricsMaxPoints {
+		return ctx.BadRequest(goa.ErrBadRequest(fmt.Errorf("Too many points. Increase step to return at most %d points", metricsMaxPoints)))
+	}
+
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	samples, err := c.containerMetrics(ctx, id, from, to, step)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	points := make(app.GoaContainerMetricsPointCollection, len(samples))
+	for i := range samples {
+		points[i] = samples[i].media()
+	}
+
+	return ctx.OK(&app.GoaContainerMetrics{
+		From:   from,
+		To:     to,
+		Step:   ctx.Step,
+		Points: points,
+	})
+
+	// ContainerController_Metrics: end_implement
+}
+
+// SetConfig runs the setConfig action.
+func (c *ContainerController) SetConfig(ctx *app.SetConfigContainerContext) error {
+	// ContainerController_SetConfig: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var setQuery []string
+	var placeholders []interface{}
+
+	if ctx.Payload.DefaultShell != nil {
+		setQuery = append(setQuery, "defaultShell=?")
+		placeholders = append(placeholders, *ctx.Payload.DefaultShell)
+	}
+	if ctx.Payload.IdleTimeout != nil {
+		setQuery = append(setQuery, "idleTimeout=?")
+		placeholders = append(placeholders, *ctx.Payload.IdleTimeout)
+	}
+
+	if len(setQuery) == 0 {
+		return ctx.NoContent()
+	}
+
+	placeholders = append(placeholders, uid, ctx.ID, ctx.ID)
+
+	tx, err := c.DB.Begin()
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var kind string
+
+	err = tx.QueryRow(
+		"SELECT kind FROM containers WHERE uid=? AND (id=? OR name=?)",
+		uid, ctx.ID, ctx.ID,
+	).Scan(&kind)
+
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+
+		return ctx.NotFound()
+	} else if err != nil {
+		tx.Rollback()
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if ctx.Payload.IdleTimeout != nil && *ctx.Payload.IdleTimeout != 0 && kind != containerKindWeb {
+		tx.Rollback()
+
+		return ctx.BadRequest(goa.ErrBadRequest(errors.New("Only web containers can sleep")))
+	}
+
+	_, err = tx.Exec("UPDATE containers SET "+strings.Join(setQuery, ", ")+" WHERE uid=? AND (id=? OR name=?)", placeholders...)
+
+	if err != nil {
+		tx.Rollback()
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	tx.Commit()
+
+	return ctx.NoContent()
+
+	// ContainerController_SetConfig: end_implement
+}
+
+// GetConfig runs the getConfig action.
+func (c *ContainerController) GetConfig(ctx *app.GetConfigContainerContext) error {
+	// ContainerController_GetConfig: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	type config struct {
+		DefaultShell sql.NullString `db:"defaultShell"`
+		IdleTimeout  int            `db:"idleTimeout"`
+	}
+
+	var configs []config
+	err = c.DB.Select(&configs, "SELECT defaultShell, idleTimeout FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if len(configs) == 0 {
+		return ctx.NotFound()
+	}
+
+	res := &app.GoaContainerConfig{
+		IdleTimeout: &configs[0].IdleTimeout,
+	}
+
+	if configs[0].DefaultShell.Valid {
+		res.DefaultShell = &configs[0].DefaultShell.String
+	}
+
+	return ctx.OK(res)
+	// ContainerController_GetConfig: end_implement
+}
+
+// ListDomains runs the listDomains action.
+func (c *ContainerController) ListDomains(ctx *app.ListDomainsContainerContext) error {
+	// ContainerController_ListDomains: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	domains, err := c.listContainerDomains(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaContainerDomainCollection, len(domains))
+	for i := range domains {
+		res[i] = domains[i].media()
+	}
+
+	return ctx.OK(res)
+
+	// ContainerController_ListDomains: end_implement
+}
+
+// AddDomain runs the addDomain action.
+func (c *ContainerController) AddDomain(ctx *app.AddDomainContainerContext) error {
+	// ContainerController_AddDomain: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	domain := strings.ToLower(ctx.Domain)
+
+	if domain == *publicAddr || strings.HasSuffix(domain, "."+*publicAddr) {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.New("Subdomains of " + *publicAddr + " cannot be used as a custom domain")))
+	}
+
+	var id int
+	var kind string
+	err = c.DB.QueryRowContext(ctx, "SELECT id, kind FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &kind)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if kind != containerKindWeb {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.New("Custom domains are available only for web containers")))
+	}
+
+	var verifiedBy int
+	err = c.DB.QueryRowContext(ctx, "SELECT containerID FROM containerDomains WHERE verifiedDomain=?", domain).Scan(&verifiedBy)
+
+	if err == nil && verifiedBy != id {
+		return ctx.Conflict(goa.ErrInvalidRequest(errors.New("The domain is already verified by another container")))
+	} else if err != nil && err != sql.ErrNoRows {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	var claimed containerDomain
+	err = c.DB.QueryRowContext(ctx, "SELECT domain, token, verified FROM containerDomains WHERE containerID=? AND domain=?", id, domain).Scan(&claimed.Domain, &claimed.Token, &claimed.Verified)
+
+	if err == nil {
+		return ctx.OK(claimed.media())
+	} else if err != sql.ErrNoRows {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	token, err := generateDomainToken()
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if _, err := c.DB.ExecContext(ctx, "INSERT INTO containerDomains (containerID, domain, token) VALUES (?, ?, ?)", id, domain, token); err != nil {
+		if strings.Contains(err.Error(), "Duplicate") {
+			return ctx.Conflict(goa.ErrInvalidRequest(errors.New("The domain is already claimed by the container")))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	return ctx.OK((&containerDomain{Domain: domain, Token: token}).media())
+
+	// ContainerController_AddDomain: end_implement
+}
+
+// VerifyDomain runs the verifyDomain action.
+func (c *ContainerController) VerifyDomain(ctx *app.VerifyDomainContainerContext) error {
+	// ContainerController_VerifyDomain: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, name, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	var domain containerDomain
+	err = c.DB.QueryRowContext(ctx, "SELECT domain, token, verified FROM containerDomains WHERE containerID=? AND domain=?", id, strings.ToLower(ctx.Domain)).Scan(&domain.Domain, &domain.Token, &domain.Verified)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if !domain.Verified {
+		if err := verifyDomain(ctx, domain.Domain, domain.Token); err != nil {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+
+		// Only one of the containers claiming the domain can verify it
+		if _, err := c.DB.ExecContext(ctx, "UPDATE containerDomains SET verified=TRUE, verifiedDomain=domain WHERE containerID=? AND domain=?", id, domain.Domain); err != nil {
+			if strings.Contains(err.Error(), "Duplicate") {
+				return ctx.Conflict(goa.ErrInvalidRequest(errors.New("The domain is already verified by another container")))
+			}
+
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+		}
+		domain.Verified = true
+	}
+
+	if err := c.updateFrontendRule(ctx, id, name); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(domain.media())
+
+	// ContainerController_VerifyDomain: end_implement
+}
+
+// RemoveDomain runs the removeDomain action.
+func (c *ContainerController) RemoveDomain(ctx *app.RemoveDomainContainerContext) error {
+	// ContainerController_RemoveDomain: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, name, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	res, err := c.DB.ExecContext(ctx, "DELETE FROM containerDomains WHERE containerID=? AND domain=?", id, strings.ToLower(ctx.Domain))
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	} else if n == 0 {
+		return ctx.NotFound()
+	}
+
+	// The routes belong to the container which verified the domain
+	var verified int
+	if err := c.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM containerDomains WHERE verifiedDomain=?", strings.ToLower(ctx.Domain)).Scan(&verified); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if verified == 0 {
+		if err := c.removeRoutesByHost(ctx, strings.ToLower(ctx.Domain)); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+
+	if err := c.updateFrontendRule(ctx, id, name); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.NoContent()
+
+	// ContainerController_RemoveDomain: end_implement
+}
+
+// ListRoutes runs the listRoutes action.
+func (c *ContainerController) ListRoutes(ctx *app.ListRoutesContainerContext) error {
+	// ContainerController_ListRoutes: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	routes, err := c.listContainerRoutes(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaContainerRouteCollection, len(routes))
+	for i := range routes {
+		res[i] = routes[i].media()
+	}
+
+	return ctx.OK(res)
+
+	// ContainerController_ListRoutes: end_implement
+}
+
+// AddRoute runs the addRoute action.
+func (c *ContainerController) AddRoute(ctx *app.AddRouteContainerContext) error {
+	// ContainerController_AddRoute: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var id int
+	var sslRedirect bool
+	var kind string
+	err = c.DB.QueryRowContext(ctx, "SELECT id, sslRedirect, kind FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &sslRedirect, &kind)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if kind != containerKindWeb {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.New("Routes are available only for web containers")))
+	}
+
+	route := &containerRoute{
+		Host:        strings.ToLower(ctx.Host),
+		PathPrefix:  ctx.PathPrefix,
+		StripPrefix: ctx.StripPrefix,
+		Priority:    ctx.Priority,
+	}
+
+	if err := c.checkRouteHost(ctx, uid, route.Host); err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	res, err := c.DB.ExecContext(ctx, "INSERT INTO containerRoutes (containerID, host, pathPrefix, stripPrefix, priority) VALUES (?, ?, ?, ?, ?)", id, route.Host, route.PathPrefix, route.StripPrefix, route.Priority)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "Duplicate") {
+			return ctx.Conflict(goa.ErrInvalidRequest(errors.New("The path prefix on the host is already routed")))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if id64, err := res.LastInsertId(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	} else {
+		route.ID = int(id64)
+	}
+
+	if err := c.newRouteFrontend(fmt.Sprintf(routeFrontendFormat, id, route.ID), fmt.Sprintf(backendFormat, id), route, sslRedirect); err != nil {
+		c.DB.ExecContext(ctx, "DELETE FROM containerRoutes WHERE id=?", route.ID)
+
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Consul Error")))
+	}
+
+	return ctx.OK(route.media())
+
+	// ContainerController_AddRoute: end_implement
+}
+
+// RemoveRoute runs the removeRoute action.
+func (c *ContainerController) RemoveRoute(ctx *app.RemoveRouteContainerContext) error {
+	// ContainerController_RemoveRoute: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	res, err := c.DB.ExecContext(ctx, "DELETE FROM containerRoutes WHERE containerID=? AND id=?", id, ctx.Route)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	} else if n == 0 {
+		return ctx.NotFound()
+	}
+
+	if err := c.Consul.DeleteFrontend(fmt.Sprintf(routeFrontendFormat, id, ctx.Route)); err != nil {
+		if err != store.ErrKeyNotFound {
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Consul Error")))
+		}
+	}
+
+	return ctx.NoContent()
+
+	// ContainerController_RemoveRoute: end_implement
+}
+
+// ListSchedules runs the listSchedules action.
+func (c *ContainerController) ListSchedules(ctx *app.ListSchedulesContainerContext) error {
+	// ContainerController_ListSchedules: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	schedules, err := c.listContainerSchedules(ctx, id)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaContainerScheduleCollection, len(schedules))
+	for i := range schedules {
+		res[i] = schedules[i].media()
+	}
+
+	return ctx.OK(res)
+
+	// ContainerController_ListSchedules: end_implement
+}
+
+// AddSchedule runs the addSchedule action.
+func (c *ContainerController) AddSchedule(ctx *app.AddScheduleContainerContext) error {
+	// ContainerController_AddSchedule: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var schedules []*containerSchedule
+	if ctx.Window != nil {
+		if ctx.Action != nil || ctx.Schedule != nil {
+			return ctx.BadRequest(goa.ErrBadRequest(errors.New("Only one of window and schedule can be specified")))
+		}
+
+		start, stop, err := parseScheduleWindow(*ctx.Window)
+
+		if err != nil {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+
+		schedules = []*containerSchedule{
+			{Action: scheduleActionStart, Schedule: start},
+			{Action: scheduleActionStop, Schedule: stop},
+		}
+	} else {
+		if ctx.Action == nil || ctx.Schedule == nil {
+			return ctx.BadRequest(goa.ErrBadRequest(errors.New("action and schedule, or window must be specified")))
+		}
 
-	if err != nil {
-		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API Error")))
+		schedules = []*containerSchedule{
+			{Action: *ctx.Action, Schedule: *ctx.Schedule},
+		}
 	}
-	res := make(app.GoaContainerListEachCollection, 0, len(list)+10)
 
-	rows, err := c.DB.Query(`SELECT id, name, message, status FROM containers WHERE status="Error" OR status="Creating"`)
+	now := time.Now()
+	for i := range schedules {
+		next, err := nextScheduleRun(schedules[i].Schedule, ctx.Timezone, now)
 
-	if err != nil {
-		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+		if err != nil {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+
+		schedules[i].Timezone = ctx.Timezone
+		schedules[i].NextRunAt = next
 	}
 
-	defer rows.Close()
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
 
-	for rows.Next() {
-		var id int
-		var name, msg, status string
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
 
-		if err := rows.Scan(&id, &name, &msg, &status); err != nil {
-			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
-		}
+	tx, err := c.DB.Begin()
 
-		res = append(res, &app.GoaContainerListEach{
-			ID:      id,
-			Name:    name,
-			Command: msg,
-			Status:  status,
-		})
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
-	rows.Close()
 
-	for i := range list {
-		j := list[i]
+	for i := range schedules {
+		res, err := tx.ExecContext(ctx, "INSERT INTO containerSchedules (containerID, action, schedule, timezone, nextRunAt) VALUES (?, ?, ?, ?, ?)", id, schedules[i].Action, schedules[i].Schedule, schedules[i].Timezone, schedules[i].NextRunAt)
 
-		vols := make([]string, 0, len(j.Mounts))
-		for k := range j.Mounts {
-			vols = append(vols, j.Mounts[k].Destination)
+		if err != nil {
+			tx.Rollback()
+
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 		}
 
-		t := time.Unix(j.Created, 0)
-		id, _ := strconv.Atoi(j.Labels[dockerLabelModokiID])
-		name := j.Labels[dockerLabelModokiName]
+		scheduleID, err := res.LastInsertId()
 
-		var state string
+		if err != nil {
+			tx.Rollback()
 
-		switch strings.ToLower(j.State) {
-		case "running":
-			state = "Running"
-		case "created":
-			state = "Created"
-		default:
-			state = "Stopped"
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 		}
 
-		each := &app.GoaContainerListEach{
-			Command: j.Command,
-			Created: t,
-			ID:      id,
-			Image:   j.Image,
-			ImageID: j.ImageID,
-			Name:    name,
-			Status:  state,
-			Volumes: vols,
-		}
+		schedules[i].ID = int(scheduleID)
+	}
 
-		res = append(res, each)
+	if err := tx.Commit(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaContainerScheduleCollection, len(schedules))
+	for i := range schedules {
+		res[i] = schedules[i].media()
 	}
 
 	return ctx.OK(res)
-	// ContainerController_List: end_implement
+
+	// ContainerController_AddSchedule: end_implement
 }
 
-// Upload runs the upload action.
-func (c *ContainerController) Upload(ctx *app.UploadContainerContext) error {
-	// ContainerController_Upload: start_implement
+// RemoveSchedule runs the removeSchedule action.
+func (c *ContainerController) RemoveSchedule(ctx *app.RemoveScheduleContainerContext) error {
+	// ContainerController_RemoveSchedule: start_implement
 
 	uid, err := GetUIDFromJWT(ctx)
 
@@ -692,51 +2442,83 @@ func (c *ContainerController) Upload(ctx *app.UploadContainerContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	rows, err := c.DB.Query("SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	res, err := c.DB.ExecContext(ctx, "DELETE FROM containerSchedules WHERE containerID=? AND id=?", id, ctx.Schedule)
 
 	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Deletion From Database Error")))
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	} else if n == 0 {
+		return ctx.NotFound()
 	}
 
-	rows.Next()
+	return ctx.NoContent()
 
-	var id int
-	var cid sql.NullString
-	if err := rows.Scan(&id, &cid); err != nil {
-		rows.Close()
-		return ctx.NotFound(goa.ErrInternal(errors.New("No container found")))
+	// ContainerController_RemoveSchedule: end_implement
+}
+
+// ScheduleLogs runs the scheduleLogs action.
+func (c *ContainerController) ScheduleLogs(ctx *app.ScheduleLogsContainerContext) error {
+	// ContainerController_ScheduleLogs: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
-	rows.Close()
 
-	if !cid.Valid {
-		return ctx.NotFound(goa.ErrInternal(errors.New("No container found")))
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
 
-	reader, err := ctx.Payload.Data.Open()
+	type scheduleLog struct {
+		ScheduleID int       `db:"scheduleID"`
+		Action     string    `db:"action"`
+		Status     string    `db:"status"`
+		Message    *string   `db:"message"`
+		CreatedAt  time.Time `db:"createdAt"`
+	}
+
+	var logs []*scheduleLog
+	err = c.DB.SelectContext(ctx, &logs, "SELECT scheduleID, action, status, message, createdAt FROM containerScheduleLogs WHERE containerID=? ORDER BY id DESC LIMIT ?", id, ctx.Limit)
 
 	if err != nil {
-		return ctx.BadRequest(goa.ErrBadRequest(errors.Wrap(err, "Opening the form error")))
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
-	defer reader.Close()
 
-	if err := c.DockerClient.CopyToContainer(ctx, cid.String, ctx.Payload.Path, reader, types.CopyToContainerOptions{
-		AllowOverwriteDirWithFile: ctx.Payload.AllowOverwrite,
-		CopyUIDGID:                ctx.Payload.CopyUIDGID,
-	}); err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "not found") {
-			return ctx.NotFound(goa.ErrNotFound(errors.New("The path does not exist")))
+	res := make(app.GoaContainerScheduleLogCollection, len(logs))
+	for i := range logs {
+		res[i] = &app.GoaContainerScheduleLog{
+			Schedule:  logs[i].ScheduleID,
+			Action:    logs[i].Action,
+			Status:    logs[i].Status,
+			Message:   logs[i].Message,
+			CreatedAt: logs[i].CreatedAt,
 		}
-
-		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Failed to copy a file via Docker API")))
 	}
 
-	return ctx.NoContent()
-	// ContainerController_Upload: end_implement
+	return ctx.OK(res)
+
+	// ContainerController_ScheduleLogs: end_implement
 }
 
-// Logs runs the logs action.
-func (c *ContainerController) Logs(ctx *app.LogsContainerContext) error {
-	// ContainerController_Logs: start_implement
+// Extend runs the extend action.
+func (c *ContainerController) Extend(ctx *app.ExtendContainerContext) error {
+	// ContainerController_Extend: start_implement
 
 	uid, err := GetUIDFromJWT(ctx)
 
@@ -744,60 +2526,75 @@ func (c *ContainerController) Logs(ctx *app.LogsContainerContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	rows, err := c.DB.Query("SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+	expiresAt, err := expirationTime(ctx.TTL, ctx.ExpiresAt, time.Now())
 
 	if err != nil {
-		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+		return ctx.BadRequest(goa.ErrBadRequest(err))
 	}
 
-	rows.Next()
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
 
-	var id int
-	var cid sql.NullString
-	if err := rows.Scan(&id, &cid); err != nil {
-		rows.Close()
-		return ctx.NotFound(goa.ErrNotFound(errors.New("No container found")))
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
-	rows.Close()
 
-	if !cid.Valid {
-		return ctx.NotFound(goa.ErrNotFound(errors.New("No container found")))
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET expiresAt=?, expiryWarned=FALSE WHERE id=?", expiresAt, id); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
 
-	opts := types.ContainerLogsOptions{
-		ShowStderr: ctx.Stderr,
-		ShowStdout: ctx.Stdout,
-		Timestamps: ctx.Timestamps,
-		Follow:     ctx.Follow,
-		Tail:       ctx.Tail,
+	return ctx.NoContent()
+
+	// ContainerController_Extend: end_implement
+}
+
+// Events runs the events action.
+func (c *ContainerController) Events(ctx *app.EventsContainerContext) error {
+	// ContainerController_Events: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	if ctx.Since != nil {
-		opts.Since = ctx.Since.Format(time.RFC3339)
+	id, _, err := c.lookupContainer(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
-	if ctx.Until != nil {
-		opts.Until = ctx.Until.Format(time.RFC3339)
+
+	type containerEvent struct {
+		Type      string    `db:"type"`
+		Message   string    `db:"message"`
+		CreatedAt time.Time `db:"createdAt"`
 	}
 
-	rc, err := c.DockerClient.ContainerLogs(ctx, cid.String, opts)
+	var events []*containerEvent
+	if err := c.DB.SelectContext(ctx, &events, "SELECT type, message, createdAt FROM containerEvents WHERE containerID=? ORDER BY id DESC LIMIT ?", id, ctx.Limit); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
 
-	if err != nil {
-		return ctx.InternalServerError(goa.ErrInternal(err))
+	res := make(app.GoaContainerEventCollection, len(events))
+	for i := range events {
+		res[i] = &app.GoaContainerEvent{
+			Type:      events[i].Type,
+			Message:   events[i].Message,
+			CreatedAt: events[i].CreatedAt,
+		}
 	}
-	defer rc.Close()
 
-	handler := websocket.Handler(func(conn *websocket.Conn) {
-		io.Copy(conn, rc)
-	})
+	return ctx.OK(res)
 
-	handler.ServeHTTP(ctx.ResponseWriter, ctx.Request)
-	return nil
-	// ContainerController_Logs: end_implement
+	// ContainerController_Events: end_implement
 }
 
-// SetConfig runs the setConfig action.
-func (c *ContainerController) SetConfig(ctx *app.SetConfigContainerContext) error {
-	// ContainerController_SetConfig: start_implement
+// SetLimits runs the setLimits action.
+func (c *ContainerController) SetLimits(ctx *app.SetLimitsContainerContext) error {
+	// ContainerController_SetLimits: start_implement
 
 	uid, err := GetUIDFromJWT(ctx)
 
@@ -805,57 +2602,82 @@ func (c *ContainerController) SetConfig(ctx *app.SetConfigContainerContext) erro
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	var setQuery []string
-	var placeholders []interface{}
+	var id int
+	var cid sql.NullString
+	err = c.DB.QueryRowContext(ctx, "SELECT id, cid FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID).Scan(&id, &cid)
 
-	if ctx.Payload.DefaultShell != nil {
-		setQuery = append(setQuery, "defaultShell=?")
-		placeholders = append(placeholders, *ctx.Payload.DefaultShell)
+	if err == sql.ErrNoRows || (err == nil && !cid.Valid) {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
 	}
 
-	placeholders = append(placeholders, uid, ctx.ID, ctx.ID)
+	j, err := c.DockerClient.ContainerInspect(ctx, cid.String)
 
-	tx, err := c.DB.Begin()
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Docker API Error")))
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
 
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	var cnt int
-
-	err = tx.QueryRow(
-		"SELECT COUNT(id) FROM containers WHERE uid=? AND (id=? OR name=?)",
-		uid, ctx.ID, ctx.ID,
-	).Scan(&cnt)
+	current, replicas, volumes, err := lockContainerLimits(ctx, tx, id)
 
 	if err != nil {
 		tx.Rollback()
+
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	if cnt == 0 {
+	// The pids limit is not recorded in SQL
+	current.Pids = hostConfigLimits(j.HostConfig).Pids
+
+	limits, err := requestedLimits(current, c.resourceCeilings(), ctx.CPU, ctx.Memory, nil, ctx.Pids)
+
+	if err != nil {
 		tx.Rollback()
 
-		return ctx.NotFound()
+		return ctx.BadRequest(goa.ErrBadRequest(err))
 	}
 
-	_, err = tx.Exec("UPDATE containers SET "+strings.Join(setQuery, " ")+" WHERE uid=? AND (id=? OR name=?)", placeholders...)
+	if err := reserveQuota(ctx, tx, c.Consul, uid, id, containerUsage(limits, replicas, volumes)); err != nil {
+		tx.Rollback()
 
-	if err != nil {
+		if _, ok := err.(*quotaExceededError); ok {
+			return ctx.Forbidden(goa.ErrInvalidRequest(err))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE containers SET cpuLimit=?, memoryLimit=?, storageLimit=? WHERE id=?", limits.CPU, limits.Memory, limits.Storage, id); err != nil {
 		tx.Rollback()
+
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	// The new limits are recorded only if docker accepts them
+	if err := c.updateLimits(ctx, id, cid.String, limits); err != nil {
+		tx.Rollback()
+
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
 
-	return ctx.NoContent()
+	return ctx.OK(limits.media())
 
-	// ContainerController_SetConfig: end_implement
+	// ContainerController_SetLimits: end_implement
 }
 
-// GetConfig runs the getConfig action.
-func (c *ContainerController) GetConfig(ctx *app.GetConfigContainerContext) error {
-	// ContainerController_GetConfig: start_implement
+// Run runs the run action.
+func (c *ContainerController) Run(ctx *app.RunContainerContext) error {
+	// ContainerController_Run: start_implement
 
 	uid, err := GetUIDFromJWT(ctx)
 
@@ -863,23 +2685,83 @@ func (c *ContainerController) GetConfig(ctx *app.GetConfigContainerContext) erro
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	var configs []sql.NullString
-	err = c.DB.Select(&configs, "SELECT defaultShell FROM containers WHERE uid=? AND (id=? OR name=?)", uid, ctx.ID, ctx.ID)
+	limits := c.resourceCeilings()
+	release, err := c.reserveOneOff(ctx, uid, limits)
+
+	if err != nil {
+		if _, ok := err.(*quotaExceededError); ok {
+			return ctx.Forbidden(goa.ErrInvalidRequest(err))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	defer release()
+
+	config := oneOffConfig(uid, ctx.Image, ctx.Command, ctx.Entrypoint, ctx.Env, ctx.WorkingDir)
+	out := &tailBuffer{n: runOutputLimit}
+
+	exitCode, timedOut, err := c.runOneOff(ctx, config, limits, time.Duration(ctx.Timeout)*time.Second, out)
 
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	if len(configs) == 0 {
-		return ctx.NotFound()
+	return ctx.OK(&app.GoaContainerRunResult{
+		ExitCode: exitCode,
+		Output:   out.String(),
+		TimedOut: timedOut,
+	})
+
+	// ContainerController_Run: end_implement
+}
+
+// RunStream runs the runStream action.
+func (c *ContainerController) RunStream(ctx *app.RunStreamContainerContext) error {
+	// ContainerController_RunStream: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	if !configs[0].Valid {
-		return ctx.OK(&app.GoaContainerConfig{nil})
+	limits := c.resourceCeilings()
+	release, err := c.reserveOneOff(ctx, uid, limits)
+
+	if err != nil {
+		if _, ok := err.(*quotaExceededError); ok {
+			return ctx.Forbidden(goa.ErrInvalidRequest(err))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	defer release()
 
-	return ctx.OK(&app.GoaContainerConfig{&configs[0].String})
-	// ContainerController_GetConfig: end_implement
+	config := oneOffConfig(uid, ctx.Image, ctx.Command, ctx.Entrypoint, ctx.Env, ctx.WorkingDir)
+
+	handler := websocket.Handler(func(conn *websocket.Conn) {
+		defer trackWebsocketSession(websocketSessionRun)()
+
+		exitCode, timedOut, err := c.runOneOff(ctx, config, limits, time.Duration(ctx.Timeout)*time.Second, &binaryMessageWriter{conn: conn})
+
+		if err != nil {
+			log.Println("Running a one-off container error:", err)
+
+			websocket.JSON.Send(conn, goa.ErrInternal(err))
+
+			return
+		}
+
+		websocket.JSON.Send(conn, &app.GoaContainerRunResult{
+			ExitCode: exitCode,
+			TimedOut: timedOut,
+		})
+	})
+
+	handler.ServeHTTP(ctx.ResponseWriter, ctx.Request)
+	return nil
+
+	// ContainerController_RunStream: end_implement
 }
 
 // Exec runs the exec action.
@@ -960,6 +2842,8 @@ func (c *ContainerController) ExecWSHandler(ctx *app.ExecContainerContext, cid s
 	return func(ws *websocket.Conn) {
 		// ContainerController_Exec: start_implement
 
+		defer trackWebsocketSession(websocketSessionExec)()
+
 		execConfig := types.ExecConfig{
 			AttachStdin:  true,
 			AttachStdout: true,
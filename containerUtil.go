@@ -2,15 +2,22 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/modoki-paas/modoki/consul_traefik"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
+	"github.com/docker/libkv/store"
 	"github.com/jmoiron/sqlx"
 	"github.com/k0kubun/pp"
 	"github.com/pkg/errors"
@@ -21,6 +28,9 @@ type ContainerControllerUtil struct {
 	DB           *sqlx.DB
 	DockerClient *client.Client
 	Consul       *consulTraefik.Client
+	Secrets      *SecretStore
+
+	locks containerLocks
 }
 
 func (c *ContainerControllerUtil) updateStatus(ctx context.Context, status, msg string, id int) error {
@@ -29,6 +39,12 @@ func (c *ContainerControllerUtil) updateStatus(ctx context.Context, status, msg
 	return err
 }
 
+func (c *ContainerControllerUtil) updateMessage(ctx context.Context, msg string, id int) error {
+	_, err := c.DB.ExecContext(ctx, "UPDATE containers SET message=? WHERE id=?", msg, id)
+
+	return err
+}
+
 func (c *ContainerController) must(err error) {
 	if err != nil {
 		log.Println("UpdateStatus error:", err)
@@ -53,49 +69,517 @@ func (c *ContainerControllerUtil) updateContainerStatus(ctx context.Context, cid
 		}
 	}
 
+	if n, _ := strconv.Atoi(j.Config.Labels[dockerLabelModokiReplica]); n > 0 {
+		return c.updateReplicaStatus(ctx, id, n, j)
+	}
+
+	// Ignore containers replaced by redeploying
+	var currentCID sql.NullString
+	var sleeping bool
+	if err := c.DB.QueryRowContext(ctx, "SELECT cid, sleeping FROM containers WHERE id=?", id).Scan(&currentCID, &sleeping); err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	if currentCID.String != j.ID {
+		return nil
+	}
+
 	if j.State.Error != "" {
 		if err := c.updateStatus(ctx, "Error", j.State.Error, id); err != nil {
 			return errors.Wrap(err, "DB Update error")
 		}
 	}
 
-	n := "bridge"
+	if err := c.registerServer(ctx, id, serverName, j); err != nil {
+		return err
+	}
 
-	if networkName != nil { // command arguments
-		n = *networkName
+	status := ""
+	if j.State.Paused {
+		status = "Paused"
+	} else if j.State.Running {
+		status = healthStatus(j.State.Health)
+	} else if sleeping {
+		status = "Sleeping"
+
+		if err := c.registerWake(ctx, id); err != nil {
+			return err
+		}
+	} else {
+		status = "Stopped"
+	}
+
+	if err := c.updateStatus(ctx, status, "", id); err != nil {
+		return errors.Wrap(err, "DB Update error")
 	}
 
-	addr := j.NetworkSettings.Networks[n].IPAddress
+	return nil
+}
+
+// errContainerNotFound is returned if the docker container of the container does not exist
+var errContainerNotFound = errors.New("The container is not found")
+
+// startContainer starts the container and its replicas as the user does
+func (c *ContainerControllerUtil) startContainer(ctx context.Context, id int, cid string) error {
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET restartCount=0, crashCount=0, manualStop=FALSE, sleeping=FALSE WHERE id=?", id); err != nil {
+		return errors.Wrap(err, "Database Error")
+	}
+
+	resp, err := c.DockerClient.HTTPClient().Post("http://"+*dockerAPIVersion+"/containers/"+cid+"/start", "", nil)
+
+	if err != nil {
+		return errors.Wrap(err, "Docker API error")
+	}
+	defer func() {
+		if resp.Body != nil {
+			resp.Body.Close()
+		}
+	}()
+	switch resp.StatusCode {
+	case http.StatusOK, 304: // 304: Already started
+		// Do nothing
+	case http.StatusNotFound:
+		return errContainerNotFound
+	case http.StatusInternalServerError:
+		type message struct {
+			Message string `json:"message"`
+		}
+
+		var msg message
+		json.NewDecoder(resp.Body).Decode(&msg)
+
+		return fmt.Errorf("Container starting error: %s", msg.Message)
+	}
+
+	err = c.forEachReplica(ctx, id, func(cid string) error {
+		return c.DockerClient.ContainerStart(context.Background(), cid, types.ContainerStartOptions{})
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "Docker API error")
+	}
+
+	return c.updateContainerStatus(context.Background(), cid)
+}
+
+// stopContainer stops the container and its replicas as the user does
+func (c *ContainerControllerUtil) stopContainer(ctx context.Context, id int, cid string, timeout time.Duration) error {
+	// Containers stopped by the user are not restarted by the restart policy
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET manualStop=TRUE, sleeping=FALSE WHERE id=?", id); err != nil {
+		return errors.Wrap(err, "Database Error")
+	}
+
+	if err := c.DockerClient.ContainerStop(context.Background(), cid, &timeout); err != nil {
+		return errors.Wrap(err, "Docker API error")
+	}
+
+	err := c.forEachReplica(ctx, id, func(cid string) error {
+		return c.DockerClient.ContainerStop(context.Background(), cid, &timeout)
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "Docker API error")
+	}
+
+	return c.updateContainerStatus(context.Background(), cid)
+}
+
+// errContainerRunning is returned if the container to remove is running
+var errContainerRunning = errors.New("The container is running")
+
+// removeContainer removes the container, its replicas, volumes, records and frontends/backends.
+// Running containers are removed only with force.
+func (c *ContainerControllerUtil) removeContainer(ctx context.Context, id int, force bool) error {
+	var cid sql.NullString
+	var name, status string
+	if err := c.DB.QueryRowContext(ctx, "SELECT cid, name, status FROM containers WHERE id=?", id).Scan(&cid, &name, &status); err != nil {
+		if err == sql.ErrNoRows {
+			return errContainerNotFound
+		}
+
+		return errors.Wrap(err, "Database Error")
+	}
+
+	if status != "Error" {
+		if !cid.Valid {
+			return errContainerNotFound
+		}
+
+		j, err := c.DockerClient.ContainerInspect(context.Background(), cid.String)
+
+		if err != nil {
+			return errors.Wrap(err, "Docker API Error")
+		}
+
+		if err := c.DockerClient.ContainerRemove(
+			context.Background(),
+			cid.String,
+			types.ContainerRemoveOptions{
+				RemoveVolumes: true,
+				Force:         force,
+			},
+		); err != nil {
+			if strings.Contains(err.Error(), "You cannot remove a running container") {
+				return errContainerRunning
+			}
 
-	backendName := fmt.Sprintf(backendFormat, id)
+			return errors.Wrap(err, "Docker API Error")
+		}
+
+		err = c.forEachReplica(ctx, id, func(cid string) error {
+			return c.DockerClient.ContainerRemove(context.Background(), cid, types.ContainerRemoveOptions{Force: true})
+		})
+
+		if err != nil {
+			return errors.Wrap(err, "Docker API Error")
+		}
 
-	if addr == "" {
-		if err := c.Consul.DeleteBackend(backendName); err != nil {
-			if !strings.Contains(err.Error(), "Key not found") {
-				return errors.Wrap(err, "Traefik Unregisteration Error")
+		// Volumes taken over by redeploying are mounted by name and not removed with the container
+		for i := range j.Mounts {
+			if j.Mounts[i].Type == mount.TypeVolume {
+				c.DockerClient.VolumeRemove(context.Background(), j.Mounts[i].Name, false)
 			}
 		}
-	} else {
-		if err := c.Consul.NewBackend(backendName, serverName, "http://"+addr); err != nil {
+	}
+
+	return c.removeContainerRecords(ctx, id, name)
+}
+
+// removeContainerRecords removes the container from SQL and traefik without touching the docker containers
+func (c *ContainerControllerUtil) removeContainerRecords(ctx context.Context, id int, name string) error {
+	ports, err := c.listContainerPorts(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	routes, err := c.listContainerRoutes(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerRoutes WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	domains, err := c.listContainerDomains(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	hosts := []string{name + "." + *publicAddr}
+	for i := range domains {
+		hosts = append(hosts, domains[i].Domain)
+	}
+
+	for i := range hosts {
+		if err := c.removeRoutesByHost(ctx, hosts[i]); err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerReplicas WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerSchedules WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerScheduleLogs WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerEvents WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerMetrics WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE jobRuns FROM jobRuns JOIN jobs ON jobRuns.jobID=jobs.id WHERE jobs.containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM jobs WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerSecrets WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerEnv WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerReleases WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerDomains WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containerPorts WHERE containerID=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	if _, err := c.DB.Exec("DELETE FROM containers WHERE id=?", id); err != nil {
+		return errors.Wrap(err, "Deletion From Database Error")
+	}
+
+	frontendNames := []string{fmt.Sprintf(frontendFormat, id)}
+	backendNames := []string{fmt.Sprintf(backendFormat, id)}
+
+	for i := range ports {
+		frontendNames = append(frontendNames, fmt.Sprintf(portFrontendFormat, id, ports[i].Name))
+		backendNames = append(backendNames, fmt.Sprintf(portBackendFormat, id, ports[i].Name))
+	}
+	for i := range routes {
+		frontendNames = append(frontendNames, fmt.Sprintf(routeFrontendFormat, id, routes[i].ID))
+	}
+
+	for i := range backendNames {
+		if err := c.Consul.DeleteBackend(backendNames[i]); err != nil {
+			if err != store.ErrKeyNotFound {
+				return errors.Wrap(err, "Consul Error")
+			}
+		}
+	}
+	for i := range frontendNames {
+		if err := c.Consul.DeleteFrontend(frontendNames[i]); err != nil {
+			if err != store.ErrKeyNotFound {
+				return errors.Wrap(err, "Consul Error")
+			}
+		}
+	}
+
+	return nil
+}
+
+// registerServer adds the container to the backends as the server, or removes it from them if it cannot respond
+func (c *ContainerControllerUtil) registerServer(ctx context.Context, id int, server string, j types.ContainerJSON) error {
+	addr := containerIPAddress(j)
+
+	backends, err := c.backendURLs(ctx, id, addr)
+
+	if err != nil {
+		return err
+	}
+
+	unhealthy := j.State.Health != nil && j.State.Health.Status == types.Unhealthy
+
+	// A paused or unhealthy container cannot respond, so it is removed from the backends until it is unpaused or recovers
+	if addr == "" || j.State.Paused || unhealthy {
+		for backendName := range backends {
+			if err := c.Consul.DeleteServer(backendName, server); err != nil {
+				if !strings.Contains(err.Error(), "Key not found") {
+					return errors.Wrap(err, "Traefik Unregisteration Error")
+				}
+			}
+		}
+
+		return nil
+	}
+
+	for backendName, url := range backends {
+		if err := c.Consul.NewBackend(backendName, server, url); err != nil {
+			return errors.Wrap(err, "Traefik Registeration Error")
+		}
+
+		if err := c.Consul.SetServerWeight(backendName, server, defaultServerWeight); err != nil {
 			return errors.Wrap(err, "Traefik Registeration Error")
 		}
 	}
 
-	status := ""
-	if j.State.Running {
-		status = "Running"
-	} else {
-		status = "Stopped"
+	return nil
+}
+
+// containerNetwork returns the name of the network the containers join
+func containerNetwork() string {
+	if networkName != nil { // command arguments
+		return *networkName
 	}
 
-	if err := c.updateStatus(ctx, status, "", id); err != nil {
-		return errors.Wrap(err, "DB Update error")
+	return "bridge"
+}
+
+// containerIPAddress returns the IP address of the container in the network for containers
+func containerIPAddress(j types.ContainerJSON) string {
+	n := containerNetwork()
+
+	if j.NetworkSettings == nil || j.NetworkSettings.Networks[n] == nil {
+		return ""
+	}
+
+	return j.NetworkSettings.Networks[n].IPAddress
+}
+
+// backendURLs returns the URLs of the container at the address for each backend of the container.
+// Only web containers have backends.
+func (c *ContainerControllerUtil) backendURLs(ctx context.Context, id int, addr string) (map[string]string, error) {
+	var port sql.NullInt64
+	var protocol, kind string
+	if err := c.DB.QueryRowContext(ctx, "SELECT port, protocol, kind FROM containers WHERE id=?", id).Scan(&port, &protocol, &kind); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	if kind != containerKindWeb {
+		return map[string]string{}, nil
+	}
+
+	if !port.Valid {
+		port.Int64 = defaultContainerPort
+	}
+
+	ports, err := c.listContainerPorts(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	backends := map[string]string{
+		fmt.Sprintf(backendFormat, id): protocol + "://" + addr + ":" + strconv.FormatInt(port.Int64, 10),
+	}
+
+	for i := range ports {
+		backends[fmt.Sprintf(portBackendFormat, id, ports[i].Name)] = ports[i].Protocol + "://" + addr + ":" + strconv.Itoa(ports[i].Port)
+	}
+
+	return backends, nil
+}
+
+func containerNetworkingConfig(aliases ...string) *network.NetworkingConfig {
+	networkingConfig := &network.NetworkingConfig{}
+
+	if networkName != nil {
+		networkingConfig.EndpointsConfig = map[string]*network.EndpointSettings{
+			*networkName: &network.EndpointSettings{
+				Aliases: aliases,
+			},
+		}
+	}
+
+	return networkingConfig
+}
+
+// privateAlias returns the network alias of the user's private container.
+// The alias contains the user not to let other users' containers take over the name.
+func privateAlias(name, uid string) string {
+	label := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' {
+			return r
+		}
+
+		return '-'
+	}, strings.ToLower(uid))
+
+	return name + "." + label
+}
+
+// networkingConfig returns the networking config for the docker containers of the container.
+// Private containers are reachable from other containers by privateAlias.
+func (c *ContainerControllerUtil) networkingConfig(ctx context.Context, id int) (*network.NetworkingConfig, error) {
+	var name, uid, kind string
+	if err := c.DB.QueryRowContext(ctx, "SELECT name, uid, kind FROM containers WHERE id=?", id).Scan(&name, &uid, &kind); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	if kind != containerKindPrivate {
+		return containerNetworkingConfig(), nil
+	}
+
+	return containerNetworkingConfig(privateAlias(name, uid)), nil
+}
+
+// pullImage pulls the image and waits for the completion
+func (c *ContainerControllerUtil) pullImage(ctx context.Context, image string) (err error) {
+	started := time.Now()
+	defer func() {
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+
+		imagePullDuration.WithLabelValues(result).Observe(time.Since(started).Seconds())
+	}()
+
+	type ImagePullProgress struct {
+		Status         string `json:"status"`
+		ProgressDetail struct {
+			Current int `json:"current"`
+			Total   int `json:"total"`
+		} `json:"progressDetail,omitempty"`
+		Progress string `json:"progress,omitempty"`
+		ID       string `json:"id,omitempty"`
+	}
+
+	rc, err := c.DockerClient.ImagePull(ctx, image, types.ImagePullOptions{})
+
+	if err != nil {
+		return errors.Wrap(err, "Downloading the image error")
+	}
+	defer rc.Close()
+
+	decoder := json.NewDecoder(rc)
+
+	var status string
+	for {
+		var progress ImagePullProgress
+
+		if err := decoder.Decode(&progress); err != nil {
+			break
+		}
+
+		status = progress.Status
+	}
+
+	if !(strings.Contains(status, "Downloaded") || strings.Contains(status, "up to date")) {
+		return fmt.Errorf("Image downloading error: %v", status)
 	}
 
 	return nil
 }
 
+// imageExposedPort returns the lowest TCP port declared with EXPOSE in the image, or 0 if there is none
+func (c *ContainerControllerUtil) imageExposedPort(ctx context.Context, image string) (int, error) {
+	j, _, err := c.DockerClient.ImageInspectWithRaw(ctx, image)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "Image Inspect Error")
+	}
+
+	if j.Config == nil {
+		return 0, nil
+	}
+
+	port := 0
+	for p := range j.Config.ExposedPorts {
+		if p.Proto() != "tcp" {
+			continue
+		}
+
+		if n := p.Int(); n > 0 && (port == 0 || n < port) {
+			port = n
+		}
+	}
+
+	return port, nil
+}
+
 func (c *ContainerControllerUtil) run(ctx context.Context) {
+	if err := c.startRestartedContainers(ctx); err != nil {
+		log.Println("Starting containers with restart policies error:", err)
+	}
+
 	var fn func()
 
 	fn = func() {
@@ -115,9 +599,19 @@ func (c *ContainerControllerUtil) run(ctx context.Context) {
 
 				switch m.Status {
 				case "start":
+					// The network counters are reset and idling is measured from the start
+					c.DB.Exec("UPDATE containers SET manualStop=FALSE, rxBytes=0, activeAt=NOW() WHERE cid=?", m.Actor.ID)
 					c.updateContainerStatus(context.Background(), m.Actor.ID)
 				case "die":
 					c.updateContainerStatus(context.Background(), m.Actor.ID)
+
+					if err := c.handleDie(context.Background(), m.Actor.ID); err != nil {
+						log.Println("Handling the exit of the container error:", err)
+					}
+				case "pause", "unpause":
+					c.updateContainerStatus(context.Background(), m.Actor.ID)
+				case "health_status: healthy", "health_status: unhealthy":
+					c.updateContainerStatus(context.Background(), m.Actor.ID)
 				}
 			case e := <-err:
 				log.Println("Watching events error: ", e)
@@ -129,3 +623,44 @@ func (c *ContainerControllerUtil) run(ctx context.Context) {
 
 	fn()
 }
+
+// loop runs the periodic tasks until ctx is done
+func (c *ContainerControllerUtil) loop(ctx context.Context) {
+	if err := c.abortInterruptedJobRuns(ctx); err != nil {
+		log.Println("Aborting interrupted job runs error:", err)
+	}
+
+	if err := c.releaseInterruptedOneOffs(ctx); err != nil {
+		log.Println("Releasing interrupted one-off containers error:", err)
+	}
+
+	idleTicker := time.NewTicker(idleCheckInterval)
+	defer idleTicker.Stop()
+
+	jobTicker := time.NewTicker(jobCheckInterval)
+	defer jobTicker.Stop()
+
+	scheduleTicker := time.NewTicker(scheduleCheckInterval)
+	defer scheduleTicker.Stop()
+
+	go c.expiryLoop(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-idleTicker.C:
+			if err := c.sleepIdleContainers(ctx); err != nil {
+				log.Println("Sleeping idle containers error:", err)
+			}
+		case <-jobTicker.C:
+			if err := c.runScheduledJobs(ctx); err != nil {
+				log.Println("Running scheduled jobs error:", err)
+			}
+		case <-scheduleTicker.C:
+			if err := c.runContainerSchedules(ctx); err != nil {
+				log.Println("Running container schedules error:", err)
+			}
+		}
+	}
+}
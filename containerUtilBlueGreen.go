@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/pkg/errors"
+)
+
+// waitHealthy waits for the container to become healthy and returns its address.
+// A container with a health check is healthy when docker reports so, otherwise when it responds to HTTP requests.
+func (c *ContainerControllerUtil) waitHealthy(ctx context.Context, id int, cid string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client := &http.Client{
+		Timeout: 2 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		j, err := c.DockerClient.ContainerInspect(ctx, cid)
+
+		if err != nil {
+			return "", errors.Wrap(err, "Container Inspect Error")
+		}
+
+		if !j.State.Running {
+			return "", errors.New("The new container is not running")
+		}
+
+		if addr := containerIPAddress(j); addr != "" {
+			if j.State.Health != nil {
+				switch j.State.Health.Status {
+				case types.Healthy:
+					return addr, nil
+				case types.Unhealthy:
+					return "", errors.New("The new container is unhealthy")
+				}
+			} else {
+				backends, err := c.backendURLs(ctx, id, addr)
+
+				if err != nil {
+					return "", err
+				}
+
+				url, ok := backends[fmt.Sprintf(backendFormat, id)]
+
+				// Containers without backends do not serve HTTP
+				if !ok {
+					return addr, nil
+				}
+
+				req, _ := http.NewRequest("GET", url+"/", nil)
+
+				if resp, err := client.Do(req.WithContext(ctx)); err == nil {
+					resp.Body.Close()
+
+					return addr, nil
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", errors.New("The new container did not become healthy in time")
+		case <-ticker.C:
+		}
+	}
+}
+
+// switchBlueGreen starts the new container alongside the old one, adds it to the backends after it becomes healthy,
+// and drains and removes the old one. The new container is removed if it fails to become healthy.
+func (c *ContainerControllerUtil) switchBlueGreen(ctx context.Context, id int, oldCID, newCID string, rc *redeployConfig) error {
+	if err := c.DockerClient.ContainerStart(ctx, newCID, types.ContainerStartOptions{}); err != nil {
+		c.DockerClient.ContainerRemove(ctx, newCID, types.ContainerRemoveOptions{Force: true})
+
+		return errors.Wrap(err, "Failed to start the new container")
+	}
+
+	addr, err := c.waitHealthy(ctx, id, newCID, rc.HealthTimeout)
+
+	if err != nil {
+		c.DockerClient.ContainerRemove(ctx, newCID, types.ContainerRemoveOptions{Force: true})
+
+		return errors.Wrap(err, "Rolled back")
+	}
+
+	backends, err := c.backendURLs(ctx, id, addr)
+
+	if err != nil {
+		c.DockerClient.ContainerRemove(ctx, newCID, types.ContainerRemoveOptions{Force: true})
+
+		return err
+	}
+
+	rollback := func() {
+		for backendName := range backends {
+			c.Consul.DeleteServer(backendName, nextServerName)
+			c.Consul.SetServerWeight(backendName, serverName, defaultServerWeight)
+		}
+
+		c.DockerClient.ContainerRemove(ctx, newCID, types.ContainerRemoveOptions{Force: true})
+	}
+
+	for backendName, url := range backends {
+		if err := c.Consul.NewBackend(backendName, nextServerName, url); err != nil {
+			rollback()
+
+			return errors.Wrap(err, "Traefik Registeration Error")
+		}
+	}
+
+	// New requests go to the new container while the old one finishes in-flight requests
+	for backendName := range backends {
+		if err := c.Consul.SetServerWeight(backendName, serverName, 0); err != nil {
+			rollback()
+
+			return errors.Wrap(err, "Traefik Registeration Error")
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(rc.DrainPeriod):
+	}
+
+	// Events from the old container are ignored after the cid is swapped
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET cid=? WHERE id=?", newCID, id); err != nil {
+		rollback()
+
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	for backendName, url := range backends {
+		if err := c.Consul.NewBackend(backendName, serverName, url); err != nil {
+			return errors.Wrap(err, "Traefik Registeration Error")
+		}
+
+		if err := c.Consul.SetServerWeight(backendName, serverName, defaultServerWeight); err != nil {
+			return errors.Wrap(err, "Traefik Registeration Error")
+		}
+
+		if err := c.Consul.DeleteServer(backendName, nextServerName); err != nil {
+			return errors.Wrap(err, "Traefik Unregisteration Error")
+		}
+	}
+
+	if err := c.DockerClient.ContainerStop(ctx, oldCID, &rc.Timeout); err != nil {
+		return errors.Wrap(err, "Failed to stop the old container")
+	}
+
+	if err := c.DockerClient.ContainerRemove(ctx, oldCID, types.ContainerRemoveOptions{Force: true}); err != nil {
+		return errors.Wrap(err, "Failed to remove the old container")
+	}
+
+	return c.updateContainerStatus(ctx, newCID)
+}
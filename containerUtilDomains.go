@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+)
+
+// containerDomain is a custom domain claimed for a container
+type containerDomain struct {
+	Domain   string `db:"domain"`
+	Token    string `db:"token"`
+	Verified bool   `db:"verified"`
+}
+
+func (d *containerDomain) media() *app.GoaContainerDomain {
+	return &app.GoaContainerDomain{
+		Domain:    d.Domain,
+		Token:     d.Token,
+		Verified:  d.Verified,
+		TxtRecord: domainChallengeTXTPrefix + d.Domain,
+		HTTPURL:   "http://" + d.Domain + domainChallengeHTTPPath + d.Token,
+	}
+}
+
+func generateDomainToken() (string, error) {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// domainResolver returns the resolver specified with -resolver
+func domainResolver() *net.Resolver {
+	if *dnsResolver == "" {
+		return net.DefaultResolver
+	}
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var d net.Dialer
+
+			return d.DialContext(ctx, network, *dnsResolver)
+		},
+	}
+}
+
+func verifyDomainTXT(ctx context.Context, domain, token string) error {
+	records, err := domainResolver().LookupTXT(ctx, domainChallengeTXTPrefix+domain)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range records {
+		if strings.TrimSpace(records[i]) == token {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("TXT record %s%s does not contain the token", domainChallengeTXTPrefix, domain)
+}
+
+// nonPublicNetworks are the networks not reachable from the internet besides loopback, link-local and multicast ones
+var nonPublicNetworks = func() []*net.IPNet {
+	var nets []*net.IPNet
+
+	for _, cidr := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10", "fc00::/7"} {
+		_, n, err := net.ParseCIDR(cidr)
+
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+
+	return nets
+}()
+
+// isPublicIP returns whether ip is a global unicast address outside the private networks
+func isPublicIP(ip net.IP) bool {
+	if !ip.IsGlobalUnicast() {
+		return false
+	}
+
+	for _, n := range nonPublicNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// verifyDomainHTTP fetches the token from the domain.
+// Only public addresses are connected to and redirects are not followed not to reach the internal services.
+func verifyDomainHTTP(ctx context.Context, domain, token string) error {
+	resolver := domainResolver()
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				host, port, err := net.SplitHostPort(address)
+
+				if err != nil {
+					return nil, err
+				}
+
+				addrs, err := resolver.LookupIPAddr(ctx, host)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for i := range addrs {
+					if !isPublicIP(addrs[i].IP) {
+						continue
+					}
+
+					var d net.Dialer
+
+					return d.DialContext(ctx, network, net.JoinHostPort(addrs[i].IP.String(), port))
+				}
+
+				return nil, fmt.Errorf("%s does not resolve to a public address", host)
+			},
+		},
+	}
+
+	req, err := http.NewRequest("GET", "http://"+domain+domainChallengeHTTPPath+token, nil)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP token request returned %s", resp.Status)
+	}
+
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(string(b)) != token {
+		return errors.New("HTTP token does not match")
+	}
+
+	return nil
+}
+
+// verifyDomain checks the token with the TXT record first and then with HTTP
+func verifyDomain(ctx context.Context, domain, token string) error {
+	txtErr := verifyDomainTXT(ctx, domain, token)
+
+	if txtErr == nil {
+		return nil
+	}
+
+	httpErr := verifyDomainHTTP(ctx, domain, token)
+
+	if httpErr == nil {
+		return nil
+	}
+
+	return fmt.Errorf("Failed to verify the ownership of %s(TXT: %v, HTTP: %v)", domain, txtErr, httpErr)
+}
+
+// lookupContainer returns the id and the name of the user's container specified with id or name.
+// sql.ErrNoRows is returned if it is not found.
+func (c *ContainerControllerUtil) lookupContainer(ctx context.Context, uid, idOrName string) (int, string, error) {
+	var id int
+	var name string
+	err := c.DB.QueryRowContext(ctx, "SELECT id, name FROM containers WHERE uid=? AND (id=? OR name=?)", uid, idOrName, idOrName).Scan(&id, &name)
+
+	return id, name, err
+}
+
+func (c *ContainerControllerUtil) listContainerDomains(ctx context.Context, id int) ([]*containerDomain, error) {
+	var domains []*containerDomain
+
+	if err := c.DB.SelectContext(ctx, &domains, "SELECT domain, token, verified FROM containerDomains WHERE containerID=? ORDER BY id", id); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return domains, nil
+}
+
+// updateFrontendRule routes requests for the container's subdomain and its verified custom domains to the container
+func (c *ContainerControllerUtil) updateFrontendRule(ctx context.Context, id int, name string) error {
+	domains, err := c.listContainerDomains(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	hosts := []string{name + "." + *publicAddr}
+	for i := range domains {
+		if domains[i].Verified {
+			hosts = append(hosts, domains[i].Domain)
+		}
+	}
+
+	if err := c.Consul.NewFrontend(fmt.Sprintf(frontendFormat, id), "Host: "+strings.Join(hosts, ",")); err != nil {
+		return errors.Wrap(err, "Traefik Registeration Error")
+	}
+
+	return nil
+}
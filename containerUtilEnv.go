@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+)
+
+const maskedEnvValue = "********"
+
+// containerEnv is an environment variable of a container
+type containerEnv struct {
+	Name   string `db:"name"`
+	Value  string `db:"value"`
+	Secret bool   `db:"secret"`
+}
+
+func (e *containerEnv) media() *app.GoaContainerEnv {
+	res := &app.GoaContainerEnv{
+		Name:   e.Name,
+		Value:  e.Value,
+		Secret: e.Secret,
+	}
+
+	if e.Secret {
+		res.Value = maskedEnvValue
+	}
+
+	return res
+}
+
+// parseEnv splits NAME=value
+func parseEnv(s string) (string, string) {
+	idx := strings.Index(s, "=")
+
+	if idx == -1 {
+		return s, ""
+	}
+
+	return s[:idx], s[idx+1:]
+}
+
+// maskEnv masks values of the secret environment variables in NAME=value format
+func maskEnv(env []string, secrets map[string]struct{}) []string {
+	res := make([]string, len(env))
+
+	for i := range env {
+		name, _ := parseEnv(env[i])
+
+		if _, ok := secrets[name]; ok {
+			res[i] = name + "=" + maskedEnvValue
+		} else {
+			res[i] = env[i]
+		}
+	}
+
+	return res
+}
+
+func (c *ContainerControllerUtil) listContainerEnv(ctx context.Context, id int) ([]*containerEnv, error) {
+	var envs []*containerEnv
+
+	if err := c.DB.SelectContext(ctx, &envs, "SELECT name, value, secret FROM containerEnv WHERE containerID=? ORDER BY id", id); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return envs, nil
+}
+
+// secretEnvNames returns the names of the secret environment variables of the container
+func (c *ContainerControllerUtil) secretEnvNames(ctx context.Context, id int) (map[string]struct{}, error) {
+	envs, err := c.listContainerEnv(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]struct{})
+	for i := range envs {
+		if envs[i].Secret {
+			secrets[envs[i].Name] = struct{}{}
+		}
+	}
+
+	return secrets, nil
+}
+
+// containerEnvList returns the environment variables of the container stored in SQL in NAME=value format
+func (c *ContainerControllerUtil) containerEnvList(ctx context.Context, id int) ([]string, error) {
+	envs, err := c.listContainerEnv(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(envs))
+	for i := range envs {
+		res[i] = envs[i].Name + "=" + envs[i].Value
+	}
+
+	return res, nil
+}
+
+// upsertContainerEnv adds or updates the environment variables of the container
+func upsertContainerEnv(ctx context.Context, tx *sqlx.Tx, id int, envs []*containerEnv) error {
+	for i := range envs {
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO containerEnv (containerID, name, value, secret) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE value=VALUES(value), secret=VALUES(secret)",
+			id, envs[i].Name, envs[i].Value, envs[i].Secret,
+		)
+
+		if err != nil {
+			return errors.Wrap(err, "DB Insert error")
+		}
+	}
+
+	return nil
+}
+
+// replaceContainerEnv replaces the environment variables stored in SQL with the ones the container was deployed with.
+// Variables keep being secret if they were.
+func (c *ContainerControllerUtil) replaceContainerEnv(ctx context.Context, id int, env []string) error {
+	secrets, err := c.secretEnvNames(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	envs := make([]*containerEnv, len(env))
+	for i := range env {
+		name, value := parseEnv(env[i])
+		_, secret := secrets[name]
+
+		envs[i] = &containerEnv{
+			Name:   name,
+			Value:  value,
+			Secret: secret,
+		}
+	}
+
+	tx, err := c.DB.BeginTxx(ctx, nil)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Begin error")
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM containerEnv WHERE containerID=?", id); err != nil {
+		tx.Rollback()
+
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	if err := upsertContainerEnv(ctx, tx, id, envs); err != nil {
+		tx.Rollback()
+
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE containers SET envPending=FALSE WHERE id=?", id); err != nil {
+		tx.Rollback()
+
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	return tx.Commit()
+}
+
+// applyEnv recreates the container with the environment variables stored in SQL in the background,
+// or marks them to be applied at the next restart if deferred
+func (c *ContainerControllerUtil) applyEnv(ctx context.Context, id int, cid sql.NullString, deferred bool) error {
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET envPending=TRUE WHERE id=?", id); err != nil {
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	if deferred || !cid.Valid {
+		return nil
+	}
+
+	go func() {
+		rc := &redeployConfig{
+			Timeout:  defaultStopTimeout,
+			Strategy: "recreate",
+
+			// Changing the environment variables does not upgrade the image
+			KeepImage: true,
+		}
+
+		if err := c.redeploy(context.Background(), id, rc); err != nil {
+			log.Println("Applying environment variables error:", err)
+
+			c.updateMessage(context.Background(), fmt.Sprintf("Applying environment variables error: %v", err), id)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/pkg/errors"
+)
+
+const containerEventExpiryWarning = "ExpiryWarning"
+
+// expirationTime returns the time a container specified with ttl or expiresAt is removed at, or nil if neither is specified
+func expirationTime(ttl *int, expiresAt *time.Time, now time.Time) (*time.Time, error) {
+	if ttl != nil && expiresAt != nil {
+		return nil, errors.New("Only one of ttl and expiresAt can be specified")
+	}
+
+	if ttl != nil {
+		t := now.Add(time.Duration(*ttl) * time.Second)
+
+		return &t, nil
+	}
+
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, errors.New("expiresAt must be in the future")
+	}
+
+	return expiresAt, nil
+}
+
+// addContainerEvent records an event of the container shown to the user
+func (c *ContainerControllerUtil) addContainerEvent(ctx context.Context, id int, eventType, message string) error {
+	_, err := c.DB.ExecContext(ctx, "INSERT INTO containerEvents (containerID, type, message, createdAt) VALUES (?, ?, ?, ?)", id, eventType, message, time.Now())
+
+	if err != nil {
+		return errors.Wrap(err, "DB Insert error")
+	}
+
+	return nil
+}
+
+// expiryLoop removes the expired containers until ctx is done.
+// It runs apart from loop not to delay the other periodic tasks while containers are stopped.
+func (c *ContainerControllerUtil) expiryLoop(ctx context.Context) {
+	ticker := time.NewTicker(expiryCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.expireContainers(ctx); err != nil {
+				log.Println("Removing expired containers error:", err)
+			}
+		}
+	}
+}
+
+// expireContainers warns the containers expiring soon and removes the expired ones
+func (c *ContainerControllerUtil) expireContainers(ctx context.Context) error {
+	type expiringContainer struct {
+		ID        int            `db:"id"`
+		CID       sql.NullString `db:"cid"`
+		Name      string         `db:"name"`
+		ExpiresAt time.Time      `db:"expiresAt"`
+	}
+
+	now := time.Now()
+
+	var expiring []expiringContainer
+	err := c.DB.SelectContext(ctx, &expiring, "SELECT id, cid, name, expiresAt FROM containers WHERE expiresAt IS NOT NULL AND expiryWarned=FALSE AND expiresAt<=?", now.Add(expiryWarningBefore))
+
+	if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	for i := range expiring {
+		if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET expiryWarned=TRUE WHERE id=?", expiring[i].ID); err != nil {
+			return errors.Wrap(err, "DB Update error")
+		}
+
+		msg := fmt.Sprintf("The container will be removed at %s", expiring[i].ExpiresAt.Format(time.RFC3339))
+
+		if err := c.addContainerEvent(ctx, expiring[i].ID, containerEventExpiryWarning, msg); err != nil {
+			return err
+		}
+
+		log.Printf("Container %s(%d) expires at %s", expiring[i].Name, expiring[i].ID, expiring[i].ExpiresAt.Format(time.RFC3339))
+	}
+
+	var expired []expiringContainer
+	err = c.DB.SelectContext(ctx, &expired, "SELECT id, cid, name, expiresAt FROM containers WHERE expiresAt IS NOT NULL AND expiresAt<=?", now)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, expiryConcurrency)
+
+	for i := range expired {
+		wg.Add(1)
+		go func(e expiringContainer) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := c.removeExpiredContainer(ctx, e.ID, e.CID, e.Name); err != nil {
+				log.Printf("Removing the expired container %d error: %v", e.ID, err)
+
+				return
+			}
+
+			log.Printf("Container %s(%d) expired and was removed", e.Name, e.ID)
+		}(expired[i])
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// removeExpiredContainer stops the container gracefully and removes it.
+// Only the records are removed if the docker container does not exist any more.
+func (c *ContainerControllerUtil) removeExpiredContainer(ctx context.Context, id int, cid sql.NullString, name string) error {
+	if cid.Valid {
+		if err := c.stopContainer(ctx, id, cid.String, defaultStopTimeout); err != nil {
+			log.Printf("Stopping the expired container %d error: %v", id, err)
+		}
+	}
+
+	err := c.removeContainer(ctx, id, true)
+
+	if err != errContainerNotFound && !client.IsErrNotFound(errors.Cause(err)) {
+		return err
+	}
+
+	// Replicas may be left even if the main one is gone
+	c.forEachReplica(ctx, id, func(cid string) error {
+		c.DockerClient.ContainerRemove(ctx, cid, types.ContainerRemoveOptions{Force: true})
+
+		return nil
+	})
+
+	return c.removeContainerRecords(ctx, id, name)
+}
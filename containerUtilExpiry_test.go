@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpirationTime(t *testing.T) {
+	now := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	ttl := 3600
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Second)
+
+	tests := []struct {
+		name      string
+		ttl       *int
+		expiresAt *time.Time
+		want      *time.Time
+		wantErr   bool
+	}{
+		{name: "none"},
+		{name: "ttl", ttl: &ttl, want: &future},
+		{name: "expiresAt", expiresAt: &future, want: &future},
+		{name: "both", ttl: &ttl, expiresAt: &future, wantErr: true},
+		{name: "past", expiresAt: &past, wantErr: true},
+		{name: "now", expiresAt: &now, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		got, err := expirationTime(tc.ttl, tc.expiresAt, now)
+
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("%s: succeeded, want an error", tc.name)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: error: %v", tc.name, err)
+
+			continue
+		}
+
+		switch {
+		case got == nil && tc.want == nil:
+		case got == nil || tc.want == nil || !got.Equal(*tc.want):
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/modoki-paas/modoki/app"
+)
+
+// healthCheck is the health check of a container configured by the user
+type healthCheck struct {
+	Path        string
+	Command     []string
+	Interval    time.Duration
+	Timeout     time.Duration
+	Retries     int
+	StartPeriod time.Duration
+}
+
+// config returns the health check passed to docker, requesting the path on the port if Path is set
+func (h *healthCheck) config(protocol string, port int) *container.HealthConfig {
+	config := &container.HealthConfig{
+		Interval:    h.Interval,
+		Timeout:     h.Timeout,
+		Retries:     h.Retries,
+		StartPeriod: h.StartPeriod,
+	}
+
+	if h.Path != "" {
+		url := fmt.Sprintf("%s://localhost:%d%s", protocol, port, h.Path)
+
+		config.Test = []string{
+			"CMD-SHELL",
+			fmt.Sprintf("curl -fsSk '%s' > /dev/null || wget -q --no-check-certificate -O /dev/null '%s' || exit 1", url, url),
+		}
+	} else {
+		config.Test = append([]string{"CMD"}, h.Command...)
+	}
+
+	return config
+}
+
+// healthMedia returns the result of the health check, or nil if the container has no health check
+func healthMedia(h *types.Health) *app.GoaContainerInspectHealth {
+	if h == nil || h.Status == types.NoHealthcheck {
+		return nil
+	}
+
+	res := &app.GoaContainerInspectHealth{
+		Status:        h.Status,
+		FailingStreak: h.FailingStreak,
+	}
+
+	if len(h.Log) != 0 {
+		res.LastOutput = &h.Log[len(h.Log)-1].Output
+	}
+
+	return res
+}
+
+// healthStatus returns the status of the running container depending on its health
+func healthStatus(h *types.Health) string {
+	if h != nil {
+		switch h.Status {
+		case types.Healthy:
+			return "Healthy"
+		case types.Unhealthy:
+			return "Unhealthy"
+		}
+	}
+
+	return "Running"
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+)
+
+const jobColumns = "id, uid, name, schedule, containerID, image, command, env, nextRunAt"
+
+// job is a command run on a cron schedule in a container or in a one-off container
+type job struct {
+	ID          int            `db:"id"`
+	UID         string         `db:"uid"`
+	Name        string         `db:"name"`
+	Schedule    string         `db:"schedule"`
+	ContainerID sql.NullInt64  `db:"containerID"`
+	Image       sql.NullString `db:"image"`
+	Command     stringList     `db:"command"`
+	Env         stringList     `db:"env"`
+	NextRunAt   time.Time      `db:"nextRunAt"`
+}
+
+func (j *job) media() *app.GoaJob {
+	m := &app.GoaJob{
+		ID:        j.ID,
+		Name:      j.Name,
+		Schedule:  j.Schedule,
+		Command:   []string(j.Command),
+		Env:       []string(j.Env),
+		NextRunAt: j.NextRunAt,
+	}
+
+	if m.Command == nil {
+		m.Command = []string{}
+	}
+	if m.Env == nil {
+		m.Env = []string{}
+	}
+
+	if j.ContainerID.Valid {
+		id := int(j.ContainerID.Int64)
+		m.Container = &id
+	}
+	if j.Image.Valid {
+		m.Image = &j.Image.String
+	}
+
+	return m
+}
+
+// jobRun is a record of a run of a job
+type jobRun struct {
+	ID         int        `db:"id"`
+	Trigger    string     `db:"trigger"`
+	Status     string     `db:"status"`
+	StartedAt  time.Time  `db:"startedAt"`
+	FinishedAt *time.Time `db:"finishedAt"`
+	ExitCode   *int       `db:"exitCode"`
+	Output     string     `db:"output"`
+	Message    *string    `db:"message"`
+}
+
+func (r *jobRun) media() *app.GoaJobRun {
+	return &app.GoaJobRun{
+		ID:         r.ID,
+		Trigger:    r.Trigger,
+		Status:     r.Status,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+		ExitCode:   r.ExitCode,
+		Output:     r.Output,
+		Message:    r.Message,
+	}
+}
+
+// lookupJob returns the user's job specified with id or name.
+// sql.ErrNoRows is returned if it is not found.
+func (c *ContainerControllerUtil) lookupJob(ctx context.Context, uid, idOrName string) (*job, error) {
+	var j job
+	err := c.DB.GetContext(ctx, &j, "SELECT "+jobColumns+" FROM jobs WHERE uid=? AND (id=? OR name=?)", uid, idOrName, idOrName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+// execJob executes the command of the job in its container, waking it if it is sleeping.
+// The output stops being read after the timeout though the command is left running in the container.
+func (c *ContainerControllerUtil) execJob(ctx context.Context, j *job, timeout time.Duration, out io.Writer) (int, error) {
+	id := int(j.ContainerID.Int64)
+
+	if _, err := c.wake(ctx, id); err != nil {
+		return 0, err
+	}
+
+	var cid sql.NullString
+	if err := c.DB.QueryRowContext(ctx, "SELECT cid FROM containers WHERE id=?", id).Scan(&cid); err != nil {
+		return 0, errors.Wrap(err, "DB Select error")
+	}
+
+	execID, hijack, err := c.initExec(ctx, cid.String, types.ExecConfig{
+		Cmd:          []string(j.Command),
+		Env:          []string(j.Env),
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+
+	if err != nil {
+		return 0, errors.Wrap(err, "Docker API Error")
+	}
+	defer hijack.Close()
+
+	var timedOut int32
+	timer := time.AfterFunc(timeout, func() {
+		atomic.StoreInt32(&timedOut, 1)
+
+		hijack.Close()
+	})
+	defer timer.Stop()
+
+	if _, err := stdcopy.StdCopy(out, out, hijack.Reader); err != nil {
+		if atomic.LoadInt32(&timedOut) == 1 {
+			return 0, errors.Errorf("Timed out after %v", timeout)
+		}
+
+		return 0, errors.Wrap(err, "Reading the output error")
+	}
+
+	inspect, err := c.DockerClient.ContainerExecInspect(ctx, execID)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "Docker API Error")
+	}
+
+	return inspect.ExitCode, nil
+}
+
+// runJob runs the job and records the result in jobRuns
+func (c *ContainerControllerUtil) runJob(ctx context.Context, j *job, trigger string) error {
+	res, err := c.DB.ExecContext(ctx, "INSERT INTO jobRuns (jobID, `trigger`, status, startedAt, output) VALUES (?, ?, 'Running', ?, '')", j.ID, trigger, time.Now())
+
+	if err != nil {
+		return errors.Wrap(err, "DB Insert error")
+	}
+
+	runID, err := res.LastInsertId()
+
+	if err != nil {
+		return errors.Wrap(err, "DB Insert error")
+	}
+
+	out := &tailBuffer{n: runOutputLimit}
+
+	var exitCode int
+	if j.ContainerID.Valid {
+		exitCode, err = c.execJob(ctx, j, jobTimeout, out)
+	} else {
+		exitCode, err = c.runJobContainer(ctx, j, out)
+	}
+
+	status := "Succeeded"
+	code := &exitCode
+	var message *string
+	if err != nil {
+		status = "Error"
+		code = nil
+		msg := err.Error()
+		message = &msg
+	} else if exitCode != 0 {
+		status = "Failed"
+	}
+
+	_, err = c.DB.ExecContext(ctx, "UPDATE jobRuns SET status=?, finishedAt=?, exitCode=?, output=?, message=? WHERE id=?", status, time.Now(), code, out.String(), message, runID)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	return c.pruneJobRuns(ctx, j.ID)
+}
+
+// runJobContainer runs the job in a one-off container counted in the quota of the owner
+func (c *ContainerControllerUtil) runJobContainer(ctx context.Context, j *job, out io.Writer) (int, error) {
+	limits := c.resourceCeilings()
+	release, err := c.reserveOneOff(ctx, j.UID, limits)
+
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	config := oneOffConfig(j.UID, j.Image.String, j.Command, nil, j.Env, nil)
+	config.Labels[dockerLabelModokiJob] = strconv.Itoa(j.ID)
+
+	exitCode, timedOut, err := c.runOneOff(ctx, config, limits, jobTimeout, out)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if timedOut {
+		return 0, errors.Errorf("Timed out after %v", jobTimeout)
+	}
+
+	return exitCode, nil
+}
+
+// pruneJobRuns deletes the finished runs of the job except the last jobRunsKept ones
+func (c *ContainerControllerUtil) pruneJobRuns(ctx context.Context, jobID int) error {
+	_, err := c.DB.ExecContext(
+		ctx,
+		"DELETE FROM jobRuns WHERE jobID=? AND status<>'Running' AND id<=(SELECT id FROM (SELECT id FROM jobRuns WHERE jobID=? ORDER BY id DESC LIMIT 1 OFFSET ?) AS t)",
+		jobID, jobID, jobRunsKept,
+	)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	return nil
+}
+
+// runScheduledJobs starts the jobs whose time has come and schedules their next runs
+func (c *ContainerControllerUtil) runScheduledJobs(ctx context.Context) error {
+	now := time.Now()
+
+	var jobs []*job
+	if err := c.DB.SelectContext(ctx, &jobs, "SELECT "+jobColumns+" FROM jobs WHERE nextRunAt<=?", now); err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	for i := range jobs {
+		j := jobs[i]
+
+		schedule, err := cron.ParseStandard(j.Schedule)
+
+		if err != nil {
+			log.Printf("Invalid schedule of the job %d: %v", j.ID, err)
+
+			continue
+		}
+
+		res, err := c.DB.ExecContext(ctx, "UPDATE jobs SET nextRunAt=? WHERE id=? AND nextRunAt=?", schedule.Next(now), j.ID, j.NextRunAt)
+
+		if err != nil {
+			return errors.Wrap(err, "DB Update error")
+		}
+
+		// The job was removed or rescheduled in the meantime
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			continue
+		}
+
+		var running int
+		if err := c.DB.GetContext(ctx, &running, "SELECT COUNT(*) FROM jobRuns WHERE jobID=? AND status='Running'", j.ID); err != nil {
+			return errors.Wrap(err, "DB Select error")
+		}
+
+		// Runs of the same job do not overlap
+		if running != 0 {
+			log.Printf("Skipping the job %d because the previous run is still running", j.ID)
+
+			continue
+		}
+
+		go func() {
+			if err := c.runJob(context.Background(), j, jobTriggerSchedule); err != nil {
+				log.Printf("Running the job %d error: %v", j.ID, err)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// abortInterruptedJobRuns marks the runs left running by the previous process as errors
+func (c *ContainerControllerUtil) abortInterruptedJobRuns(ctx context.Context) error {
+	_, err := c.DB.ExecContext(ctx, "UPDATE jobRuns SET status='Error', finishedAt=?, message='Interrupted by the restart of modoki' WHERE status='Running'", time.Now())
+
+	if err != nil {
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	return nil
+}
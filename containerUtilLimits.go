@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/bytefmt"
+	"github.com/docker/docker/api/types/container"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+)
+
+// cpuPeriod is the CFS period the cpu limits are the quotas in
+const cpuPeriod = 100000
+
+// resourceLimits are the resource limits of a container. 0 means unlimited.
+type resourceLimits struct {
+	CPU     int64 // percentage of a CPU
+	Memory  int64 // bytes
+	Storage int64 // bytes
+	Pids    int64
+}
+
+// resourceCeilings returns the maximum resource limits configured by the administrator
+func (c *ContainerControllerUtil) resourceCeilings() resourceLimits {
+	var ceilings resourceLimits
+
+	pair, err := c.Consul.Client.Get("modoki/cpu/max_usage")
+
+	if err == nil {
+		if c, err := strconv.Atoi(string(pair.Value)); err == nil && c > 0 && c < 100 {
+			ceilings.CPU = int64(c)
+		}
+	}
+
+	pair, err = c.Consul.Client.Get("modoki/memory/max_usage")
+
+	if err == nil {
+		if u, err := bytefmt.ToBytes(string(pair.Value)); err == nil && u > 0 {
+			ceilings.Memory = int64(u)
+		}
+	}
+
+	pair, err = c.Consul.Client.Get("modoki/storage/max_usage")
+
+	if err == nil {
+		if v, err := bytefmt.ToBytes(string(pair.Value)); err == nil && v > 0 {
+			ceilings.Storage = int64(v)
+		}
+	}
+
+	pair, err = c.Consul.Client.Get("modoki/pids/max_usage")
+
+	if err == nil {
+		if p, err := strconv.Atoi(string(pair.Value)); err == nil && p > 0 {
+			ceilings.Pids = int64(p)
+		}
+	}
+
+	return ceilings
+}
+
+// limitWithin returns the requested limit validated against the ceiling, or the current one if it is not requested.
+// Requesting 0 removes the limit, which leaves the ceiling.
+func limitWithin(name string, requested *int64, current, ceiling int64, format func(int64) string) (int64, error) {
+	if requested == nil {
+		return current, nil
+	}
+
+	if *requested == 0 {
+		return ceiling, nil
+	}
+
+	if ceiling != 0 && *requested > ceiling {
+		return 0, fmt.Errorf("%s must be at most %s", name, format(ceiling))
+	}
+
+	return *requested, nil
+}
+
+func formatBytes(v int64) string {
+	return bytefmt.ByteSize(uint64(v))
+}
+
+func formatInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+// parseBytes parses a size such as 512M. nil is returned if size is nil.
+func parseBytes(name string, size *string) (*int64, error) {
+	if size == nil {
+		return nil, nil
+	}
+
+	var v int64
+	if *size != "0" {
+		b, err := bytefmt.ToBytes(*size)
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid %s", name)
+		}
+
+		v = int64(b)
+	}
+
+	return &v, nil
+}
+
+// requestedLimits returns the current limits overridden by the requested ones within the ceilings.
+// Limits not requested are nil and 0 removes the limit.
+func requestedLimits(current, ceilings resourceLimits, cpu *int, memory, storage *string, pids *int) (resourceLimits, error) {
+	int64Ptr := func(v *int) *int64 {
+		if v == nil {
+			return nil
+		}
+
+		i := int64(*v)
+
+		return &i
+	}
+
+	var requested struct {
+		CPU, Memory, Storage, Pids *int64
+	}
+	requested.CPU = int64Ptr(cpu)
+	requested.Pids = int64Ptr(pids)
+
+	var err error
+	if requested.Memory, err = parseBytes("memory", memory); err != nil {
+		return resourceLimits{}, err
+	}
+	if requested.Storage, err = parseBytes("storage", storage); err != nil {
+		return resourceLimits{}, err
+	}
+
+	var limits resourceLimits
+	if limits.CPU, err = limitWithin("cpu", requested.CPU, current.CPU, ceilings.CPU, formatInt); err != nil {
+		return resourceLimits{}, err
+	}
+	if limits.Memory, err = limitWithin("memory", requested.Memory, current.Memory, ceilings.Memory, formatBytes); err != nil {
+		return resourceLimits{}, err
+	}
+	if limits.Storage, err = limitWithin("storage", requested.Storage, current.Storage, ceilings.Storage, formatBytes); err != nil {
+		return resourceLimits{}, err
+	}
+	if limits.Pids, err = limitWithin("pids", requested.Pids, current.Pids, ceilings.Pids, formatInt); err != nil {
+		return resourceLimits{}, err
+	}
+
+	if limits.CPU >= 100 {
+		limits.CPU = 0
+	}
+
+	return limits, nil
+}
+
+// hostConfig returns a host config with the limits
+func (l resourceLimits) hostConfig() *container.HostConfig {
+	hostConfig := &container.HostConfig{}
+
+	if l.CPU != 0 {
+		hostConfig.Resources.CPUPeriod = cpuPeriod
+		hostConfig.Resources.CPUQuota = l.CPU * cpuPeriod / 100
+	}
+	hostConfig.Resources.Memory = l.Memory
+	hostConfig.Resources.PidsLimit = l.Pids
+
+	if l.Storage != 0 {
+		hostConfig.StorageOpt = map[string]string{
+			"size": formatBytes(l.Storage),
+		}
+	}
+
+	return hostConfig
+}
+
+// updateConfig returns the config to update the limits of a running container to l.
+// The storage can not be changed after creation. Docker can not remove the memory limit of a running container,
+// so memTotal, the memory of the host, is set instead if l has no memory limit.
+func (l resourceLimits) updateConfig(memTotal int64) container.UpdateConfig {
+	// -1 removes the limits as 0 leaves them unchanged
+	config := container.UpdateConfig{
+		Resources: container.Resources{
+			CPUPeriod:  cpuPeriod,
+			CPUQuota:   -1,
+			Memory:     memTotal,
+			MemorySwap: -1,
+			PidsLimit:  -1,
+		},
+	}
+
+	if l.CPU != 0 {
+		config.CPUQuota = l.CPU * cpuPeriod / 100
+	}
+	if l.Memory != 0 {
+		config.Memory = l.Memory
+		// Same as the default of docker run
+		config.MemorySwap = 2 * l.Memory
+	}
+	if l.Pids != 0 {
+		config.PidsLimit = l.Pids
+	}
+
+	return config
+}
+
+// hostConfigLimits returns the limits applied to a docker container
+func hostConfigLimits(hostConfig *container.HostConfig) resourceLimits {
+	var limits resourceLimits
+
+	if hostConfig == nil {
+		return limits
+	}
+
+	if hostConfig.CPUQuota > 0 && hostConfig.CPUPeriod > 0 {
+		limits.CPU = hostConfig.CPUQuota * 100 / hostConfig.CPUPeriod
+	}
+	if hostConfig.Memory > 0 {
+		limits.Memory = hostConfig.Memory
+	}
+	if hostConfig.PidsLimit > 0 {
+		limits.Pids = hostConfig.PidsLimit
+	}
+	if size, ok := hostConfig.StorageOpt["size"]; ok {
+		if v, err := bytefmt.ToBytes(size); err == nil {
+			limits.Storage = int64(v)
+		}
+	}
+
+	return limits
+}
+
+func (l resourceLimits) media() *app.GoaContainerLimits {
+	m := &app.GoaContainerLimits{}
+
+	if l.CPU != 0 {
+		cpu := int(l.CPU)
+		m.CPU = &cpu
+	}
+	if l.Memory != 0 {
+		memory := formatBytes(l.Memory)
+		m.Memory = &memory
+	}
+	if l.Storage != 0 {
+		storage := formatBytes(l.Storage)
+		m.Storage = &storage
+	}
+	if l.Pids != 0 {
+		pids := int(l.Pids)
+		m.Pids = &pids
+	}
+
+	return m
+}
+
+// updateLimits applies the limits to the running docker containers of the container including the replicas
+func (c *ContainerControllerUtil) updateLimits(ctx context.Context, id int, cid string, limits resourceLimits) error {
+	info, err := c.DockerClient.Info(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "Docker API Error")
+	}
+
+	config := limits.updateConfig(info.MemTotal)
+
+	if _, err := c.DockerClient.ContainerUpdate(ctx, cid, config); err != nil {
+		return errors.Wrap(err, "Docker API Error")
+	}
+
+	return c.forEachReplica(ctx, id, func(cid string) error {
+		if _, err := c.DockerClient.ContainerUpdate(ctx, cid, config); err != nil {
+			return errors.Wrap(err, "Docker API Error")
+		}
+
+		return nil
+	})
+}
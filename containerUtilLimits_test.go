@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestRequestedLimits(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	strPtr := func(s string) *string { return &s }
+
+	current := resourceLimits{CPU: 50, Memory: 512 * 1024 * 1024, Pids: 100}
+	ceilings := resourceLimits{CPU: 80, Memory: 1024 * 1024 * 1024}
+
+	tests := []struct {
+		name    string
+		cpu     *int
+		memory  *string
+		pids    *int
+		want    resourceLimits
+		wantErr bool
+	}{
+		{"unchanged", nil, nil, nil, current, false},
+		{"changed", intPtr(20), strPtr("256M"), intPtr(10), resourceLimits{CPU: 20, Memory: 256 * 1024 * 1024, Pids: 10}, false},
+		{"removed up to the ceilings", intPtr(0), strPtr("0"), intPtr(0), resourceLimits{CPU: 80, Memory: 1024 * 1024 * 1024}, false},
+		{"cpu over the ceiling", intPtr(90), nil, nil, resourceLimits{}, true},
+		{"memory over the ceiling", nil, strPtr("2G"), nil, resourceLimits{}, true},
+		{"invalid memory", nil, strPtr("lots"), nil, resourceLimits{}, true},
+	}
+
+	for _, tc := range tests {
+		got, err := requestedLimits(current, ceilings, tc.cpu, tc.memory, nil, tc.pids)
+
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tc.name, err, tc.wantErr)
+
+			continue
+		}
+
+		if got != tc.want {
+			t.Errorf("%s: got %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+)
+
+const metricsColumns = "containerID, sampledAt, resolution, cpuPercent, memoryUsage, memoryLimit, rxBytes, txBytes, blockReadBytes, blockWriteBytes, pids"
+
+// metricsSample is the resource usage of a container over resolution seconds from sampledAt
+type metricsSample struct {
+	ContainerID     int       `db:"containerID"`
+	SampledAt       time.Time `db:"sampledAt"`
+	Resolution      int       `db:"resolution"`
+	CPUPercent      float64   `db:"cpuPercent"`
+	MemoryUsage     int64     `db:"memoryUsage"`
+	MemoryLimit     int64     `db:"memoryLimit"`
+	RxBytes         int64     `db:"rxBytes"`
+	TxBytes         int64     `db:"txBytes"`
+	BlockReadBytes  int64     `db:"blockReadBytes"`
+	BlockWriteBytes int64     `db:"blockWriteBytes"`
+	Pids            int64     `db:"pids"`
+}
+
+func (s *metricsSample) media() *app.GoaContainerMetricsPoint {
+	return &app.GoaContainerMetricsPoint{
+		Time:            s.SampledAt,
+		CPUPercent:      s.CPUPercent,
+		MemoryUsage:     int(s.MemoryUsage),
+		MemoryLimit:     int(s.MemoryLimit),
+		RxBytes:         int(s.RxBytes),
+		TxBytes:         int(s.TxBytes),
+		BlockReadBytes:  int(s.BlockReadBytes),
+		BlockWriteBytes: int(s.BlockWriteBytes),
+		Pids:            int(s.Pids),
+	}
+}
+
+// metricsCounters are the byte counters of a docker container since it started
+type metricsCounters struct {
+	rx, tx, read, write int64
+}
+
+// delta returns the bytes counted since the previous counters
+func (m metricsCounters) delta(prev metricsCounters, ok bool) metricsCounters {
+	if !ok {
+		return metricsCounters{}
+	}
+
+	d := func(cur, prev int64) int64 {
+		// The counters are reset when the container restarts
+		if cur < prev {
+			return cur
+		}
+
+		return cur - prev
+	}
+
+	return metricsCounters{
+		rx:    d(m.rx, prev.rx),
+		tx:    d(m.tx, prev.tx),
+		read:  d(m.read, prev.read),
+		write: d(m.write, prev.write),
+	}
+}
+
+// aggregateMetrics merges the samples of a container into the buckets of step.
+// The byte counters are summed and the others are averaged weighted by the resolutions.
+func aggregateMetrics(samples []*metricsSample, step time.Duration) []*metricsSample {
+	var res []*metricsSample
+	buckets := make(map[time.Time]*metricsSample)
+
+	for _, s := range samples {
+		t := s.SampledAt.Truncate(step)
+
+		b, ok := buckets[t]
+		if !ok {
+			b = &metricsSample{ContainerID: s.ContainerID, SampledAt: t}
+			buckets[t] = b
+			res = append(res, b)
+		}
+
+		// Weighted sums until divided below
+		w := float64(s.Resolution)
+		b.CPUPercent += s.CPUPercent * w
+		b.MemoryUsage += s.MemoryUsage * int64(s.Resolution)
+		b.MemoryLimit += s.MemoryLimit * int64(s.Resolution)
+		b.Pids += s.Pids * int64(s.Resolution)
+		b.Resolution += s.Resolution
+
+		b.RxBytes += s.RxBytes
+		b.TxBytes += s.TxBytes
+		b.BlockReadBytes += s.BlockReadBytes
+		b.BlockWriteBytes += s.BlockWriteBytes
+	}
+
+	for _, b := range res {
+		if b.Resolution == 0 {
+			continue
+		}
+
+		b.CPUPercent /= float64(b.Resolution)
+		b.MemoryUsage /= int64(b.Resolution)
+		b.MemoryLimit /= int64(b.Resolution)
+		b.Pids /= int64(b.Resolution)
+		b.Resolution = int(step / time.Second)
+	}
+
+	return res
+}
+
+// sampleMetrics records the resource usage of the running containers summed over the replicas.
+// last is the counters of the docker containers at the previous sampling and the current ones are returned.
+func (c *ContainerControllerUtil) sampleMetrics(ctx context.Context, last map[string]metricsCounters) (map[string]metricsCounters, error) {
+	list, err := c.DockerClient.ContainerList(ctx, types.ContainerListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", dockerLabelModokiID)),
+	})
+
+	if err != nil {
+		return last, errors.Wrap(err, "Docker API error")
+	}
+
+	var current []struct {
+		ID  int    `db:"id"`
+		CID string `db:"cid"`
+	}
+
+	// Docker containers being replaced by redeploying are not counted with the new ones
+	err = c.DB.SelectContext(ctx, &current, "SELECT id, cid FROM containers WHERE cid IS NOT NULL UNION ALL SELECT containerID AS id, cid FROM containerReplicas")
+
+	if err != nil {
+		return last, errors.Wrap(err, "DB Select error")
+	}
+
+	containerIDs := make(map[string]int, len(current))
+	for i := range current {
+		containerIDs[current[i].CID] = current[i].ID
+	}
+
+	now := time.Now()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, metricsConcurrency)
+	samples := make(map[int]*metricsSample)
+	counters := make(map[string]metricsCounters, len(list))
+
+	for i := range list {
+		id, ok := containerIDs[list[i].ID]
+
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(id int, cid string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			j, err := c.containerStats(ctx, cid)
+
+			if err != nil {
+				log.Printf("Getting stats of %s error: %v", cid, err)
+
+				return
+			}
+
+			stats := statsMedia(0, j)
+			cur := metricsCounters{
+				rx:    int64(stats.RxBytes),
+				tx:    int64(stats.TxBytes),
+				read:  int64(stats.BlockReadBytes),
+				write: int64(stats.BlockWriteBytes),
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			prev, ok := last[cid]
+			delta := cur.delta(prev, ok)
+			counters[cid] = cur
+
+			s, ok := samples[id]
+			if !ok {
+				s = &metricsSample{ContainerID: id, SampledAt: now, Resolution: int(metricsInterval / time.Second)}
+				samples[id] = s
+			}
+
+			s.CPUPercent += stats.CPUPercent
+			s.MemoryUsage += int64(stats.MemoryUsage)
+			s.MemoryLimit += int64(stats.MemoryLimit)
+			s.Pids += int64(stats.Pids)
+			s.RxBytes += delta.rx
+			s.TxBytes += delta.tx
+			s.BlockReadBytes += delta.read
+			s.BlockWriteBytes += delta.write
+		}(id, list[i].ID)
+	}
+
+	wg.Wait()
+
+	for _, s := range samples {
+		if err := c.insertMetrics(ctx, c.DB, s); err != nil {
+			return counters, err
+		}
+	}
+
+	return counters, nil
+}
+
+func (c *ContainerControllerUtil) insertMetrics(ctx context.Context, q queryExecer, s *metricsSample) error {
+	_, err := q.ExecContext(
+		ctx,
+		"INSERT INTO containerMetrics ("+metricsColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		s.ContainerID, s.SampledAt, s.Resolution, s.CPUPercent, s.MemoryUsage, s.MemoryLimit, s.RxBytes, s.TxBytes, s.BlockReadBytes, s.BlockWriteBytes, s.Pids,
+	)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Insert error")
+	}
+
+	return nil
+}
+
+// rollupMetrics downsamples the samples older than metricsRollupAfter and removes the ones older than retention
+func (c *ContainerControllerUtil) rollupMetrics(ctx context.Context, now time.Time, retention time.Duration) error {
+	cutoff := now.Add(-metricsRollupAfter).Truncate(metricsRollupInterval)
+	resolution := int(metricsInterval / time.Second)
+
+	var samples []*metricsSample
+	err := c.DB.SelectContext(ctx, &samples, "SELECT "+metricsColumns+" FROM containerMetrics WHERE resolution=? AND sampledAt<? ORDER BY containerID, sampledAt", resolution, cutoff)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	byContainer := make(map[int][]*metricsSample)
+	for _, s := range samples {
+		byContainer[s.ContainerID] = append(byContainer[s.ContainerID], s)
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Begin error")
+	}
+
+	for _, samples := range byContainer {
+		for _, s := range aggregateMetrics(samples, metricsRollupInterval) {
+			if err := c.insertMetrics(ctx, tx, s); err != nil {
+				tx.Rollback()
+
+				return err
+			}
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM containerMetrics WHERE resolution=? AND sampledAt<?", resolution, cutoff); err != nil {
+		tx.Rollback()
+
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "DB Commit error")
+	}
+
+	if _, err := c.DB.ExecContext(ctx, "DELETE FROM containerMetrics WHERE sampledAt<?", now.Add(-retention)); err != nil {
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	return nil
+}
+
+// containerMetrics returns the resource usage of the container in [from, to) in the buckets of step
+func (c *ContainerControllerUtil) containerMetrics(ctx context.Context, id int, from, to time.Time, step time.Duration) ([]*metricsSample, error) {
+	var samples []*metricsSample
+	err := c.DB.SelectContext(ctx, &samples, "SELECT "+metricsColumns+" FROM containerMetrics WHERE containerID=? AND sampledAt>=? AND sampledAt<? ORDER BY sampledAt", id, from, to)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return aggregateMetrics(samples, step), nil
+}
+
+// collectMetrics samples the resource usage of the containers every metricsInterval and downsamples them
+func (c *ContainerControllerUtil) collectMetrics(ctx context.Context, retention time.Duration) {
+	sampleTicker := time.NewTicker(metricsInterval)
+	defer sampleTicker.Stop()
+
+	rollupTicker := time.NewTicker(metricsRollupInterval)
+	defer rollupTicker.Stop()
+
+	last := make(map[string]metricsCounters)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sampleTicker.C:
+			// A slow sampling does not pile up with the next ones
+			sampleCtx, cancel := context.WithTimeout(ctx, metricsInterval)
+
+			var err error
+			if last, err = c.sampleMetrics(sampleCtx, last); err != nil {
+				log.Println("Sampling metrics error:", err)
+			}
+
+			cancel()
+		case <-rollupTicker.C:
+			if err := c.rollupMetrics(ctx, time.Now(), retention); err != nil {
+				log.Println("Rolling up metrics error:", err)
+			}
+		}
+	}
+}
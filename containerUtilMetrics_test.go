@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateMetrics(t *testing.T) {
+	base := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	samples := []*metricsSample{
+		{ContainerID: 1, SampledAt: base, Resolution: 60, CPUPercent: 10, MemoryUsage: 100, MemoryLimit: 1000, RxBytes: 1, TxBytes: 2, BlockReadBytes: 3, BlockWriteBytes: 4, Pids: 2},
+		{ContainerID: 1, SampledAt: base.Add(time.Minute), Resolution: 60, CPUPercent: 30, MemoryUsage: 300, MemoryLimit: 1000, RxBytes: 10, TxBytes: 20, BlockReadBytes: 30, BlockWriteBytes: 40, Pids: 4},
+		// An hourly sample weighs 60 times a minute one
+		{ContainerID: 1, SampledAt: base.Add(time.Hour), Resolution: 3600, CPUPercent: 50, MemoryUsage: 500, MemoryLimit: 2000, RxBytes: 100, Pids: 1},
+		{ContainerID: 1, SampledAt: base.Add(time.Hour + 30*time.Minute), Resolution: 60, CPUPercent: 111, MemoryUsage: 1110, MemoryLimit: 2000, RxBytes: 5, Pids: 62},
+	}
+
+	got := aggregateMetrics(samples, time.Hour)
+
+	if len(got) != 2 {
+		t.Fatalf("got %d buckets, want 2", len(got))
+	}
+
+	first := got[0]
+	if !first.SampledAt.Equal(base) || first.Resolution != 3600 {
+		t.Errorf("first bucket: got %v(%d), want %v(3600)", first.SampledAt, first.Resolution, base)
+	}
+	if first.CPUPercent != 20 || first.MemoryUsage != 200 || first.MemoryLimit != 1000 || first.Pids != 3 {
+		t.Errorf("first bucket averages: got %+v", first)
+	}
+	if first.RxBytes != 11 || first.TxBytes != 22 || first.BlockReadBytes != 33 || first.BlockWriteBytes != 44 {
+		t.Errorf("first bucket counters: got %+v", first)
+	}
+
+	second := got[1]
+	if !second.SampledAt.Equal(base.Add(time.Hour)) {
+		t.Errorf("second bucket: got %v, want %v", second.SampledAt, base.Add(time.Hour))
+	}
+	if second.CPUPercent != 51 || second.MemoryUsage != 510 || second.MemoryLimit != 2000 || second.Pids != 2 {
+		t.Errorf("second bucket averages: got %+v", second)
+	}
+	if second.RxBytes != 105 {
+		t.Errorf("second bucket counters: got %+v", second)
+	}
+
+	if got := aggregateMetrics(nil, time.Hour); len(got) != 0 {
+		t.Errorf("no samples: got %d buckets", len(got))
+	}
+}
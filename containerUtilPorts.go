@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/docker/libkv/store"
+	"github.com/pkg/errors"
+)
+
+var portNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,30}[a-z0-9])?$`)
+
+// containerPort is an additional port of a container exposed on <name>.<container name>.<addr>
+type containerPort struct {
+	Name     string `db:"name"`
+	Port     int    `db:"port"`
+	Protocol string `db:"protocol"`
+}
+
+// parseContainerPort parses a port specified as name:port or name:port/protocol
+func parseContainerPort(s, defaultProtocol string) (*containerPort, error) {
+	idx := strings.Index(s, ":")
+
+	if idx == -1 {
+		return nil, fmt.Errorf("Invalid port format(must be name:port or name:port/protocol): %s", s)
+	}
+
+	cp := &containerPort{
+		Name:     s[:idx],
+		Protocol: defaultProtocol,
+	}
+
+	if !portNameRegexp.MatchString(cp.Name) {
+		return nil, fmt.Errorf("Invalid port name: %s", cp.Name)
+	}
+
+	portStr := s[idx+1:]
+	if idx := strings.Index(portStr, "/"); idx != -1 {
+		cp.Protocol = portStr[idx+1:]
+		portStr = portStr[:idx]
+	}
+
+	if cp.Protocol != "http" && cp.Protocol != "https" {
+		return nil, fmt.Errorf("Unsupported protocol: %s", cp.Protocol)
+	}
+
+	port, err := strconv.Atoi(portStr)
+
+	if err != nil || port < 1 || port > 65535 {
+		return nil, fmt.Errorf("Invalid port number: %s", portStr)
+	}
+	cp.Port = port
+
+	return cp, nil
+}
+
+func parseContainerPorts(specs []string, defaultProtocol string) ([]*containerPort, error) {
+	ports := make([]*containerPort, 0, len(specs))
+	names := make(map[string]struct{})
+
+	for i := range specs {
+		cp, err := parseContainerPort(specs[i], defaultProtocol)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := names[cp.Name]; ok {
+			return nil, fmt.Errorf("Duplicated port name: %s", cp.Name)
+		}
+		names[cp.Name] = struct{}{}
+
+		ports = append(ports, cp)
+	}
+
+	return ports, nil
+}
+
+func (c *ContainerControllerUtil) listContainerPorts(ctx context.Context, id int) ([]*containerPort, error) {
+	var ports []*containerPort
+
+	if err := c.DB.SelectContext(ctx, &ports, "SELECT name, port, protocol FROM containerPorts WHERE containerID=?", id); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return ports, nil
+}
+
+// portsConfig is the main port and the additional ports of a container
+type portsConfig struct {
+	Port     sql.NullInt64
+	Protocol string
+	Ports    []*containerPort
+}
+
+func (c *ContainerControllerUtil) currentPorts(ctx context.Context, id int) (*portsConfig, error) {
+	var pc portsConfig
+	if err := c.DB.QueryRowContext(ctx, "SELECT port, protocol FROM containers WHERE id=?", id).Scan(&pc.Port, &pc.Protocol); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	ports, err := c.listContainerPorts(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+	pc.Ports = ports
+
+	return &pc, nil
+}
+
+// setPorts replaces the ports of the container and the frontends of the additional ports.
+// The backends are registered again when the status of the container is updated.
+func (c *ContainerControllerUtil) setPorts(ctx context.Context, id int, pc *portsConfig) error {
+	current, err := c.listContainerPorts(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	var name string
+	var sslRedirect bool
+	if err := c.DB.QueryRowContext(ctx, "SELECT name, sslRedirect FROM containers WHERE id=?", id).Scan(&name, &sslRedirect); err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Begin error")
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE containers SET port=?, protocol=? WHERE id=?", pc.Port, pc.Protocol, id); err != nil {
+		tx.Rollback()
+
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM containerPorts WHERE containerID=?", id); err != nil {
+		tx.Rollback()
+
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	for i := range pc.Ports {
+		_, err := tx.ExecContext(ctx, "INSERT INTO containerPorts (containerID, name, port, protocol) VALUES (?, ?, ?, ?)", id, pc.Ports[i].Name, pc.Ports[i].Port, pc.Ports[i].Protocol)
+
+		if err != nil {
+			tx.Rollback()
+
+			return errors.Wrap(err, "DB Insert error")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "DB Commit error")
+	}
+
+	names := make(map[string]bool, len(pc.Ports))
+	for i := range pc.Ports {
+		names[pc.Ports[i].Name] = true
+
+		frontendName := fmt.Sprintf(portFrontendFormat, id, pc.Ports[i].Name)
+		backendName := fmt.Sprintf(portBackendFormat, id, pc.Ports[i].Name)
+
+		if err := c.newFrontend(frontendName, backendName, pc.Ports[i].Name+"."+name+"."+*publicAddr, sslRedirect); err != nil {
+			return errors.Wrap(err, "Traefik Registeration Error")
+		}
+	}
+
+	for i := range current {
+		if names[current[i].Name] {
+			continue
+		}
+
+		if err := c.Consul.DeleteFrontend(fmt.Sprintf(portFrontendFormat, id, current[i].Name)); err != nil && err != store.ErrKeyNotFound {
+			return errors.Wrap(err, "Consul Error")
+		}
+
+		if err := c.Consul.DeleteBackend(fmt.Sprintf(portBackendFormat, id, current[i].Name)); err != nil && err != store.ErrKeyNotFound {
+			return errors.Wrap(err, "Consul Error")
+		}
+	}
+
+	return nil
+}
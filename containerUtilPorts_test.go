@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+func TestParseContainerPort(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    containerPort
+		wantErr bool
+	}{
+		{spec: "admin:8080", want: containerPort{Name: "admin", Port: 8080, Protocol: "http"}},
+		{spec: "api:443/https", want: containerPort{Name: "api", Port: 443, Protocol: "https"}},
+		{spec: "a:1", want: containerPort{Name: "a", Port: 1, Protocol: "http"}},
+		{spec: "a:65535", want: containerPort{Name: "a", Port: 65535, Protocol: "http"}},
+		{spec: "a:0", wantErr: true},
+		{spec: "a:65536", wantErr: true},
+		{spec: "a:http", wantErr: true},
+		{spec: "a:80/tcp", wantErr: true},
+		{spec: "8080", wantErr: true},
+		{spec: ":8080", wantErr: true},
+		{spec: "Admin:8080", wantErr: true},
+		{spec: "-admin:8080", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		got, err := parseContainerPort(tc.spec, "http")
+
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("parseContainerPort(%q) succeeded, want an error", tc.spec)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("parseContainerPort(%q) error: %v", tc.spec, err)
+		} else if *got != tc.want {
+			t.Errorf("parseContainerPort(%q) = %+v, want %+v", tc.spec, *got, tc.want)
+		}
+	}
+}
+
+func TestParseContainerPorts(t *testing.T) {
+	if _, err := parseContainerPorts([]string{"a:80", "a:81"}, "http"); err == nil {
+		t.Error("duplicated names succeeded, want an error")
+	}
+
+	ports, err := parseContainerPorts([]string{"a:80", "b:443/https"}, "https")
+
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	if len(ports) != 2 || ports[0].Protocol != "https" || ports[1].Port != 443 {
+		t.Errorf("got %+v %+v", ports[0], ports[1])
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/pkg/errors"
+)
+
+// prometheusTargetGroup is a target group in the Prometheus HTTP service discovery format
+type prometheusTargetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels"`
+}
+
+// prometheusTargets returns the running docker containers declaring metrics ports as Prometheus targets.
+// Only the containers of uid are returned unless it is empty.
+func (c *ContainerControllerUtil) prometheusTargets(ctx context.Context, uid string) ([]*prometheusTargetGroup, error) {
+	args := filters.NewArgs(
+		filters.Arg("label", dockerLabelModokiID),
+		filters.Arg("label", dockerLabelModokiMetricsPort),
+	)
+
+	if uid != "" {
+		args.Add("label", dockerLabelModokiUID+"="+uid)
+	}
+
+	list, err := c.DockerClient.ContainerList(ctx, types.ContainerListOptions{
+		Filters: args,
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "Docker API error")
+	}
+
+	groups := make([]*prometheusTargetGroup, 0, len(list))
+	for i := range list {
+		labels := list[i].Labels
+
+		if list[i].NetworkSettings == nil {
+			continue
+		}
+
+		n := list[i].NetworkSettings.Networks[containerNetwork()]
+
+		if n == nil || n.IPAddress == "" {
+			continue
+		}
+
+		replica := labels[dockerLabelModokiReplica]
+		if replica == "" {
+			replica = "0"
+		}
+
+		group := &prometheusTargetGroup{
+			Targets: []string{net.JoinHostPort(n.IPAddress, labels[dockerLabelModokiMetricsPort])},
+			Labels: map[string]string{
+				"modoki_id":      labels[dockerLabelModokiID],
+				"modoki_uid":     labels[dockerLabelModokiUID],
+				"modoki_name":    labels[dockerLabelModokiName],
+				"modoki_replica": replica,
+			},
+		}
+
+		if path := labels[dockerLabelModokiMetricsPath]; path != "" {
+			group.Labels["__metrics_path__"] = path
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// PrometheusSDHandler serves the running containers declaring metrics ports in the Prometheus HTTP service discovery format.
+// The containers can be limited to a user with ?uid=.
+type PrometheusSDHandler struct {
+	*ContainerControllerUtil
+}
+
+func (h *PrometheusSDHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	groups, err := h.prometheusTargets(r.Context(), r.URL.Query().Get("uid"))
+
+	if err != nil {
+		log.Println("Listing Prometheus targets error:", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(groups); err != nil {
+		log.Println("Writing Prometheus targets error:", err)
+	}
+}
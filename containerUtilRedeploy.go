@@ -0,0 +1,385 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/strslice"
+	"github.com/pkg/errors"
+)
+
+// redeployConfig is the configuration changed by redeploying.
+// nil fields are taken over from the current container.
+type redeployConfig struct {
+	Image      *string
+	Command    []string
+	Entrypoint []string
+	Env        []string
+	WorkingDir *string
+	Ports      *portsConfig
+	Timeout    time.Duration
+
+	// KeepImage recreates the container from the same image without pulling it if Image is nil
+	KeepImage bool
+
+	Strategy      string
+	HealthTimeout time.Duration
+	DrainPeriod   time.Duration
+
+	// Cause is recorded in the release. releaseCauseRedeploy is used if empty
+	Cause string
+}
+
+// containerLocks serializes the operations replacing the docker containers of each container
+type containerLocks struct {
+	mu    sync.Mutex
+	locks map[int]*containerLock
+}
+
+type containerLock struct {
+	sync.Mutex
+	waiters int
+}
+
+// lock blocks until the container of id is unlocked and returns the func to unlock it
+func (l *containerLocks) lock(id int) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[int]*containerLock)
+	}
+
+	cl, ok := l.locks[id]
+	if !ok {
+		cl = &containerLock{}
+		l.locks[id] = cl
+	}
+	cl.waiters++
+	l.mu.Unlock()
+
+	cl.Lock()
+
+	return func() {
+		cl.Unlock()
+
+		l.mu.Lock()
+		cl.waiters--
+		if cl.waiters == 0 {
+			delete(l.locks, id)
+		}
+		l.mu.Unlock()
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// userContainerConfig returns the config of the container without the defaults of the image
+func (c *ContainerControllerUtil) userContainerConfig(ctx context.Context, j types.ContainerJSON) *container.Config {
+	config := &container.Config{
+		Cmd:         j.Config.Cmd,
+		Entrypoint:  j.Config.Entrypoint,
+		Env:         j.Config.Env,
+		WorkingDir:  j.Config.WorkingDir,
+		Healthcheck: j.Config.Healthcheck,
+	}
+
+	img, _, err := c.DockerClient.ImageInspectWithRaw(ctx, j.Image)
+
+	if err != nil || img.Config == nil {
+		return config
+	}
+
+	if equalStrings(config.Cmd, img.Config.Cmd) {
+		config.Cmd = nil
+	}
+	if equalStrings(config.Entrypoint, img.Config.Entrypoint) {
+		config.Entrypoint = nil
+	}
+	if config.WorkingDir == img.Config.WorkingDir {
+		config.WorkingDir = ""
+	}
+	if reflect.DeepEqual(config.Healthcheck, img.Config.Healthcheck) {
+		config.Healthcheck = nil
+	}
+
+	imageEnv := make(map[string]struct{}, len(img.Config.Env))
+	for i := range img.Config.Env {
+		imageEnv[img.Config.Env[i]] = struct{}{}
+	}
+
+	env := make([]string, 0, len(config.Env))
+	for i := range config.Env {
+		if _, ok := imageEnv[config.Env[i]]; !ok {
+			env = append(env, config.Env[i])
+		}
+	}
+	config.Env = env
+
+	return config
+}
+
+// currentImageReference returns the reference to the image of the docker container.
+// The image name is used unless it has been pulled again and points to another image.
+func (c *ContainerControllerUtil) currentImageReference(ctx context.Context, j types.ContainerJSON) string {
+	img, _, err := c.DockerClient.ImageInspectWithRaw(ctx, j.Config.Image)
+
+	if err == nil && img.ID == j.Image {
+		return j.Config.Image
+	}
+
+	return j.Image
+}
+
+// containerBinds returns binds to mount the volumes of the container in another container
+func containerBinds(j types.ContainerJSON) []string {
+	binds := make([]string, 0, len(j.Mounts))
+
+	for i := range j.Mounts {
+		m := j.Mounts[i]
+
+		switch m.Type {
+		case mount.TypeVolume:
+			binds = append(binds, m.Name+":"+m.Destination)
+		case mount.TypeBind:
+			binds = append(binds, m.Source+":"+m.Destination)
+		}
+	}
+
+	return binds
+}
+
+// redeploy recreates the container with the new configuration keeping its id, name, labels and volumes
+func (c *ContainerControllerUtil) redeploy(ctx context.Context, id int, rc *redeployConfig) error {
+	defer c.locks.lock(id)()
+
+	// The docker container may have been replaced while waiting for the lock
+	var cid sql.NullString
+	if err := c.DB.QueryRowContext(ctx, "SELECT cid FROM containers WHERE id=?", id).Scan(&cid); err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	if !cid.Valid {
+		return errors.New("The container is not created yet")
+	}
+
+	j, err := c.DockerClient.ContainerInspect(ctx, cid.String)
+
+	if err != nil {
+		return errors.Wrap(err, "Container Inspect Error")
+	}
+
+	image := j.Config.Image
+	if rc.Image != nil {
+		image = *rc.Image
+	}
+
+	ref := image
+	if rc.KeepImage && rc.Image == nil {
+		ref = c.currentImageReference(ctx, j)
+	} else if err := c.pullImage(ctx, image); err != nil {
+		return err
+	}
+
+	digest, err := c.imageDigest(ctx, ref)
+
+	if err != nil {
+		return err
+	}
+
+	secrets, err := c.listContainerSecrets(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	current := c.userContainerConfig(ctx, j)
+	current.Env = removeEnv(current.Env, injectedEnvNames(secrets))
+
+	config := &container.Config{
+		Image:       ref,
+		Cmd:         current.Cmd,
+		Entrypoint:  current.Entrypoint,
+		Env:         current.Env,
+		Volumes:     j.Config.Volumes,
+		WorkingDir:  current.WorkingDir,
+		Labels:      j.Config.Labels,
+		Healthcheck: current.Healthcheck,
+	}
+
+	if rc.Command != nil {
+		config.Cmd = strslice.StrSlice(rc.Command)
+	}
+	if rc.Entrypoint != nil {
+		config.Entrypoint = rc.Entrypoint
+	}
+	if rc.Env != nil {
+		config.Env = rc.Env
+	} else {
+		var envPending bool
+		if err := c.DB.QueryRowContext(ctx, "SELECT envPending FROM containers WHERE id=?", id).Scan(&envPending); err != nil {
+			return errors.Wrap(err, "DB Select error")
+		}
+
+		// Apply the changes deferred until the next restart
+		if envPending {
+			env, err := c.containerEnvList(ctx, id)
+
+			if err != nil {
+				return err
+			}
+			config.Env = env
+		}
+	}
+	if rc.WorkingDir != nil {
+		config.WorkingDir = *rc.WorkingDir
+	}
+
+	secretEnv, secretFiles, err := c.decryptContainerSecrets(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	// Secrets are not stored in SQL nor in the release
+	env := config.Env
+	config.Env = append(append([]string{}, env...), secretEnv...)
+
+	hostConfig := *j.HostConfig
+	hostConfig.Binds = containerBinds(j)
+	hostConfig.Mounts = nil
+
+	networkingConfig, err := c.networkingConfig(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	body, err := c.DockerClient.ContainerCreate(ctx, config, &hostConfig, networkingConfig, "")
+
+	if err != nil {
+		return errors.Wrap(err, "Failed to create a container")
+	}
+
+	if err := c.copySecretFiles(ctx, body.ID, secretFiles); err != nil {
+		c.DockerClient.ContainerRemove(ctx, body.ID, types.ContainerRemoveOptions{Force: true})
+
+		return err
+	}
+
+	// The backends are registered with the ports when the new container is started
+	var previousPorts *portsConfig
+	if rc.Ports != nil {
+		if previousPorts, err = c.currentPorts(ctx, id); err != nil {
+			c.DockerClient.ContainerRemove(ctx, body.ID, types.ContainerRemoveOptions{Force: true})
+
+			return err
+		}
+
+		if err := c.setPorts(ctx, id, rc.Ports); err != nil {
+			c.DockerClient.ContainerRemove(ctx, body.ID, types.ContainerRemoveOptions{Force: true})
+			c.setPorts(ctx, id, previousPorts)
+
+			return err
+		}
+	}
+
+	if rc.Strategy == "blueGreen" && j.State.Running && !j.State.Paused {
+		err = c.switchBlueGreen(ctx, id, cid.String, body.ID, rc)
+	} else {
+		err = c.recreate(ctx, id, j, body.ID, rc)
+	}
+
+	if err != nil {
+		if previousPorts != nil {
+			c.setPorts(ctx, id, previousPorts)
+			c.updateContainerStatus(ctx, cid.String)
+		}
+
+		return err
+	}
+
+	if err := c.recreateReplicas(ctx, id, rc.Timeout); err != nil {
+		return errors.Wrap(err, "Recreating replicas error")
+	}
+
+	if err := c.replaceContainerEnv(ctx, id, env); err != nil {
+		return err
+	}
+
+	cause := rc.Cause
+	if cause == "" {
+		cause = releaseCauseRedeploy
+	}
+
+	return c.addRelease(ctx, id, &containerRelease{
+		Image:       image,
+		ImageDigest: digest,
+		Env:         env,
+		Command:     stringList(config.Cmd),
+		Entrypoint:  stringList(config.Entrypoint),
+		WorkingDir:  config.WorkingDir,
+		Cause:       cause,
+	})
+}
+
+// recreate stops the old container and starts the new one
+func (c *ContainerControllerUtil) recreate(ctx context.Context, id int, j types.ContainerJSON, newCID string, rc *redeployConfig) error {
+	cid := j.ID
+
+	// Events from the old container are ignored after the cid is swapped
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET cid=? WHERE id=?", newCID, id); err != nil {
+		c.DockerClient.ContainerRemove(ctx, newCID, types.ContainerRemoveOptions{})
+
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	if j.State.Running {
+		if err := c.DockerClient.ContainerStop(ctx, cid, &rc.Timeout); err != nil {
+			c.rollbackRedeploy(ctx, id, cid, newCID, false)
+
+			return errors.Wrap(err, "Failed to stop the current container")
+		}
+
+		if err := c.DockerClient.ContainerStart(ctx, newCID, types.ContainerStartOptions{}); err != nil {
+			c.rollbackRedeploy(ctx, id, cid, newCID, true)
+
+			return errors.Wrap(err, "Failed to start the new container")
+		}
+	}
+
+	if err := c.DockerClient.ContainerRemove(ctx, cid, types.ContainerRemoveOptions{Force: true}); err != nil {
+		return errors.Wrap(err, "Failed to remove the old container")
+	}
+
+	return c.updateContainerStatus(ctx, newCID)
+}
+
+// rollbackRedeploy restores the old container after failing to redeploy
+func (c *ContainerControllerUtil) rollbackRedeploy(ctx context.Context, id int, oldCID, newCID string, start bool) {
+	c.DockerClient.ContainerRemove(ctx, newCID, types.ContainerRemoveOptions{Force: true})
+
+	c.DB.ExecContext(ctx, "UPDATE containers SET cid=? WHERE id=?", oldCID, id)
+
+	if start {
+		c.DockerClient.ContainerStart(ctx, oldCID, types.ContainerStartOptions{})
+	}
+
+	c.updateContainerStatus(ctx, oldCID)
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+)
+
+// stringList is a []string stored as JSON in SQL
+type stringList []string
+
+// Scan implements sql.Scanner
+func (l *stringList) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	case nil:
+		*l = nil
+
+		return nil
+	default:
+		return fmt.Errorf("Unsupported type for stringList: %T", src)
+	}
+
+	return json.Unmarshal(b, (*[]string)(l))
+}
+
+// Value implements driver.Valuer
+func (l stringList) Value() (driver.Value, error) {
+	if l == nil {
+		l = stringList{}
+	}
+
+	b, err := json.Marshal([]string(l))
+
+	return string(b), err
+}
+
+// containerRelease is a configuration of a container recorded every time it is deployed
+type containerRelease struct {
+	Version     int           `db:"version"`
+	Image       string        `db:"image"`
+	ImageDigest string        `db:"imageDigest"`
+	Env         stringList    `db:"env"`
+	Command     stringList    `db:"command"`
+	Entrypoint  stringList    `db:"entrypoint"`
+	WorkingDir  string        `db:"workingDir"`
+	Port        sql.NullInt64 `db:"port"`
+	Protocol    string        `db:"protocol"`
+	Ports       stringList    `db:"ports"`
+	Cause       string        `db:"cause"`
+	CreatedAt   time.Time     `db:"createdAt"`
+}
+
+func (r *containerRelease) media() *app.GoaContainerRelease {
+	res := &app.GoaContainerRelease{
+		Version:     r.Version,
+		Image:       r.Image,
+		ImageDigest: r.ImageDigest,
+		Env:         r.Env,
+		Command:     r.Command,
+		Entrypoint:  r.Entrypoint,
+		WorkingDir:  r.WorkingDir,
+		Protocol:    r.Protocol,
+		Ports:       r.Ports,
+		Cause:       r.Cause,
+		CreatedAt:   r.CreatedAt,
+	}
+
+	if r.Port.Valid {
+		port := int(r.Port.Int64)
+		res.Port = &port
+	}
+
+	return res
+}
+
+// portsConfig returns the ports recorded in the release
+func (r *containerRelease) portsConfig() (*portsConfig, error) {
+	ports, err := parseContainerPorts(r.Ports, r.Protocol)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &portsConfig{
+		Port:     r.Port,
+		Protocol: r.Protocol,
+		Ports:    ports,
+	}, nil
+}
+
+// imageReference returns the reference to deploy exactly the same image again
+func (r *containerRelease) imageReference() string {
+	if r.ImageDigest != "" {
+		return r.ImageDigest
+	}
+
+	return r.Image
+}
+
+// imageDigest returns the repository digest of the image(e.g. nginx@sha256:...)
+func (c *ContainerControllerUtil) imageDigest(ctx context.Context, image string) (string, error) {
+	j, _, err := c.DockerClient.ImageInspectWithRaw(ctx, image)
+
+	if err != nil {
+		return "", errors.Wrap(err, "Image Inspect Error")
+	}
+
+	if len(j.RepoDigests) == 0 {
+		return "", nil
+	}
+
+	return j.RepoDigests[0], nil
+}
+
+// addRelease records the release with the next version.
+// The digest of the image if empty and the ports are filled from the current state.
+func (c *ContainerControllerUtil) addRelease(ctx context.Context, id int, r *containerRelease) error {
+	if r.ImageDigest == "" {
+		digest, err := c.imageDigest(ctx, r.Image)
+
+		if err != nil {
+			return err
+		}
+		r.ImageDigest = digest
+	}
+
+	if err := c.DB.QueryRowContext(ctx, "SELECT port, protocol FROM containers WHERE id=?", id).Scan(&r.Port, &r.Protocol); err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	ports, err := c.listContainerPorts(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	r.Ports = make(stringList, len(ports))
+	for i := range ports {
+		r.Ports[i] = ports[i].Name + ":" + strconv.Itoa(ports[i].Port) + "/" + ports[i].Protocol
+	}
+
+	_, err = c.DB.ExecContext(
+		ctx,
+		`INSERT INTO containerReleases (containerID, version, image, imageDigest, env, command, entrypoint, workingDir, port, protocol, ports, cause)
+		SELECT ?, COALESCE(MAX(version), 0)+1, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? FROM containerReleases WHERE containerID=?`,
+		id, r.Image, r.ImageDigest, r.Env, r.Command, r.Entrypoint, r.WorkingDir, r.Port, r.Protocol, r.Ports, r.Cause, id,
+	)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Insert error")
+	}
+
+	return nil
+}
+
+func (c *ContainerControllerUtil) listReleases(ctx context.Context, id int) ([]*containerRelease, error) {
+	var releases []*containerRelease
+
+	if err := c.DB.SelectContext(ctx, &releases, "SELECT version, image, imageDigest, env, command, entrypoint, workingDir, port, protocol, ports, cause, createdAt FROM containerReleases WHERE containerID=? ORDER BY version DESC", id); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return releases, nil
+}
@@ -0,0 +1,461 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/pkg/errors"
+)
+
+// containerReplica is a docker container run in addition to the main one of a container.
+// The main container is the replica 0 and is not stored in containerReplicas.
+type containerReplica struct {
+	Replica int    `db:"replica"`
+	CID     string `db:"cid"`
+}
+
+// replicaServerName returns the name of the server the replica is registered as in the backends
+func replicaServerName(n int) string {
+	if n == 0 {
+		return serverName
+	}
+
+	return fmt.Sprintf(replicaServerFormat, n)
+}
+
+func (c *ContainerControllerUtil) listReplicas(ctx context.Context, id int) ([]*containerReplica, error) {
+	var replicas []*containerReplica
+
+	if err := c.DB.SelectContext(ctx, &replicas, "SELECT replica, cid FROM containerReplicas WHERE containerID=? ORDER BY replica", id); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return replicas, nil
+}
+
+// replicaCID returns the docker container id of the replica of the container.
+// The id is invalid if the container or the replica does not exist.
+func (c *ContainerControllerUtil) replicaCID(ctx context.Context, id, n int) (sql.NullString, error) {
+	var cid sql.NullString
+	var err error
+
+	if n == 0 {
+		err = c.DB.QueryRowContext(ctx, "SELECT cid FROM containers WHERE id=?", id).Scan(&cid)
+	} else {
+		err = c.DB.QueryRowContext(ctx, "SELECT cid FROM containerReplicas WHERE containerID=? AND replica=?", id, n).Scan(&cid)
+	}
+
+	if err != nil && err != sql.ErrNoRows {
+		return cid, errors.Wrap(err, "DB Select error")
+	}
+
+	return cid, nil
+}
+
+// forEachReplica calls fn with the docker container ids of the replicas except the main one
+func (c *ContainerControllerUtil) forEachReplica(ctx context.Context, id int, fn func(cid string) error) error {
+	replicas, err := c.listReplicas(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range replicas {
+		if err := fn(replicas[i].CID); err != nil {
+			return errors.Wrapf(err, "replica %d", replicas[i].Replica)
+		}
+	}
+
+	return nil
+}
+
+// countRunningReplicas returns the number of running replicas including the main one
+func (c *ContainerControllerUtil) countRunningReplicas(ctx context.Context, id int, mainRunning bool) (int, error) {
+	running := 0
+	if mainRunning {
+		running++
+	}
+
+	err := c.forEachReplica(ctx, id, func(cid string) error {
+		j, err := c.DockerClient.ContainerInspect(ctx, cid)
+
+		if err != nil {
+			return errors.Wrap(err, "Container Inspect Error")
+		}
+
+		if j.State.Running && !j.State.Paused {
+			running++
+		}
+
+		return nil
+	})
+
+	return running, err
+}
+
+// createReplica creates a docker container with the same configuration and volumes as the main one,
+// and starts it if the main one is running
+func (c *ContainerControllerUtil) createReplica(ctx context.Context, id, n int, main types.ContainerJSON, files map[string]string) (string, error) {
+	config := *main.Config
+	config.Hostname = ""
+	config.Labels = make(map[string]string, len(main.Config.Labels)+1)
+
+	for k, v := range main.Config.Labels {
+		config.Labels[k] = v
+	}
+	config.Labels[dockerLabelModokiReplica] = strconv.Itoa(n)
+
+	hostConfig := *main.HostConfig
+	hostConfig.Binds = containerBinds(main)
+	hostConfig.Mounts = nil
+
+	networkingConfig, err := c.networkingConfig(ctx, id)
+
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.DockerClient.ContainerCreate(ctx, &config, &hostConfig, networkingConfig, "")
+
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to create a container")
+	}
+
+	if err := c.copySecretFiles(ctx, body.ID, files); err != nil {
+		c.DockerClient.ContainerRemove(ctx, body.ID, types.ContainerRemoveOptions{Force: true})
+
+		return "", err
+	}
+
+	if main.State.Running {
+		if err := c.DockerClient.ContainerStart(ctx, body.ID, types.ContainerStartOptions{}); err != nil {
+			c.DockerClient.ContainerRemove(ctx, body.ID, types.ContainerRemoveOptions{Force: true})
+
+			return "", errors.Wrap(err, "Failed to start the replica")
+		}
+	}
+
+	return body.ID, nil
+}
+
+// removeReplica removes the replica from the backends and removes its docker container
+func (c *ContainerControllerUtil) removeReplica(ctx context.Context, id int, replica *containerReplica, timeout time.Duration) error {
+	backends, err := c.backendURLs(ctx, id, "")
+
+	if err != nil {
+		return err
+	}
+
+	for backendName := range backends {
+		if err := c.Consul.DeleteServer(backendName, replicaServerName(replica.Replica)); err != nil && !strings.Contains(err.Error(), "Key not found") {
+			return errors.Wrap(err, "Traefik Unregisteration Error")
+		}
+	}
+
+	if _, err := c.DB.ExecContext(ctx, "DELETE FROM containerReplicas WHERE containerID=? AND replica=?", id, replica.Replica); err != nil {
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	c.DockerClient.ContainerStop(ctx, replica.CID, &timeout)
+
+	if err := c.DockerClient.ContainerRemove(ctx, replica.CID, types.ContainerRemoveOptions{Force: true}); err != nil {
+		return errors.Wrap(err, "Failed to remove the replica")
+	}
+
+	return nil
+}
+
+// scaleReplicas creates or removes docker containers to run the number of replicas
+func (c *ContainerControllerUtil) scaleReplicas(ctx context.Context, id, replicas int, timeout time.Duration) error {
+	defer c.locks.lock(id)()
+
+	return c.scaleReplicasLocked(ctx, id, replicas, timeout)
+}
+
+// scaleReplicasLocked is scaleReplicas called with the container locked
+func (c *ContainerControllerUtil) scaleReplicasLocked(ctx context.Context, id, replicas int, timeout time.Duration) error {
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET replicas=? WHERE id=?", replicas, id); err != nil {
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	var cid sql.NullString
+	if err := c.DB.QueryRowContext(ctx, "SELECT cid FROM containers WHERE id=?", id).Scan(&cid); err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	// Replicas are created with the main container
+	if !cid.Valid {
+		return nil
+	}
+
+	current, err := c.listReplicas(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	exists := make(map[int]bool, len(current))
+	for i := range current {
+		if current[i].Replica >= replicas {
+			if err := c.removeReplica(ctx, id, current[i], timeout); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		exists[current[i].Replica] = true
+	}
+
+	if len(exists)+1 >= replicas {
+		return nil
+	}
+
+	main, err := c.DockerClient.ContainerInspect(ctx, cid.String)
+
+	if err != nil {
+		return errors.Wrap(err, "Container Inspect Error")
+	}
+
+	_, files, err := c.decryptContainerSecrets(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	for n := 1; n < replicas; n++ {
+		if exists[n] {
+			continue
+		}
+
+		newCID, err := c.createReplica(ctx, id, n, main, files)
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := c.DB.ExecContext(ctx, "INSERT INTO containerReplicas (containerID, replica, cid) VALUES (?, ?, ?)", id, n, newCID); err != nil {
+			c.DockerClient.ContainerRemove(ctx, newCID, types.ContainerRemoveOptions{Force: true})
+
+			return errors.Wrap(err, "DB Insert error")
+		}
+
+		if main.State.Running {
+			if err := c.updateContainerStatus(ctx, newCID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// recreateReplicas replaces the replicas with ones with the same configuration as the main container one by one
+func (c *ContainerControllerUtil) recreateReplicas(ctx context.Context, id int, timeout time.Duration) error {
+	replicas, err := c.listReplicas(ctx, id)
+
+	if err != nil || len(replicas) == 0 {
+		return err
+	}
+
+	var cid string
+	if err := c.DB.QueryRowContext(ctx, "SELECT cid FROM containers WHERE id=?", id).Scan(&cid); err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	main, err := c.DockerClient.ContainerInspect(ctx, cid)
+
+	if err != nil {
+		return errors.Wrap(err, "Container Inspect Error")
+	}
+
+	_, files, err := c.decryptContainerSecrets(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range replicas {
+		newCID, err := c.createReplica(ctx, id, replicas[i].Replica, main, files)
+
+		if err != nil {
+			return err
+		}
+
+		// Events from the old replica are ignored after the cid is swapped
+		if _, err := c.DB.ExecContext(ctx, "UPDATE containerReplicas SET cid=? WHERE containerID=? AND replica=?", newCID, id, replicas[i].Replica); err != nil {
+			c.DockerClient.ContainerRemove(ctx, newCID, types.ContainerRemoveOptions{Force: true})
+
+			return errors.Wrap(err, "DB Update error")
+		}
+
+		if err := c.updateContainerStatus(ctx, newCID); err != nil {
+			return err
+		}
+
+		c.DockerClient.ContainerStop(ctx, replicas[i].CID, &timeout)
+
+		if err := c.DockerClient.ContainerRemove(ctx, replicas[i].CID, types.ContainerRemoveOptions{Force: true}); err != nil {
+			return errors.Wrap(err, "Failed to remove the old replica")
+		}
+	}
+
+	return nil
+}
+
+// updateReplicaStatus updates the backends for the replica
+func (c *ContainerControllerUtil) updateReplicaStatus(ctx context.Context, id, n int, j types.ContainerJSON) error {
+	var cid string
+	err := c.DB.QueryRowContext(ctx, "SELECT cid FROM containerReplicas WHERE containerID=? AND replica=?", id, n).Scan(&cid)
+
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	// Ignore replicas replaced by redeploying
+	if cid != j.ID {
+		return nil
+	}
+
+	return c.registerServer(ctx, id, replicaServerName(n), j)
+}
+
+// replicaLogWriter writes lines prefixed with the replica to w
+type replicaLogWriter struct {
+	mu     *sync.Mutex
+	w      io.Writer
+	prefix []byte
+	buf    []byte
+}
+
+func (w *replicaLogWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for {
+		idx := -1
+		for i := range w.buf {
+			if w.buf[i] == '\n' {
+				idx = i
+
+				break
+			}
+		}
+
+		if idx == -1 {
+			return len(p), nil
+		}
+
+		w.mu.Lock()
+		_, err := w.w.Write(append(append([]byte{}, w.prefix...), w.buf[:idx+1]...))
+		w.mu.Unlock()
+
+		w.buf = w.buf[idx+1:]
+
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// flush writes the last line without a newline
+func (w *replicaLogWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err := w.w.Write(append(append([]byte{}, w.prefix...), w.buf...))
+	w.buf = nil
+
+	return err
+}
+
+// copyReplicaLogs copies the logs of the replicas into w prefixing each line with the replica.
+// The logs are multiplexed in the same format as docker unless the containers use TTY.
+func (c *ContainerControllerUtil) copyReplicaLogs(ctx context.Context, w io.Writer, replicas []*containerReplica, opts types.ContainerLogsOptions) error {
+	ctx, cancel := context.WithCancel(ctx)
+
+	mu := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	errs := make(chan error, len(replicas))
+
+	// Nothing must be written to w after returning
+	defer wg.Wait()
+	defer cancel()
+
+	for i := range replicas {
+		j, err := c.DockerClient.ContainerInspect(ctx, replicas[i].CID)
+
+		if err != nil {
+			return errors.Wrap(err, "Container Inspect Error")
+		}
+
+		rc, err := c.DockerClient.ContainerLogs(ctx, replicas[i].CID, opts)
+
+		if err != nil {
+			return err
+		}
+
+		prefix := []byte(fmt.Sprintf("[replica %d] ", replicas[i].Replica))
+
+		wg.Add(1)
+		go func(rc io.ReadCloser, tty bool) {
+			defer wg.Done()
+			defer rc.Close()
+
+			if tty {
+				lw := &replicaLogWriter{mu: mu, w: w, prefix: prefix}
+
+				if _, err := io.Copy(lw, rc); err != nil {
+					errs <- err
+
+					return
+				}
+
+				errs <- lw.flush()
+
+				return
+			}
+
+			stdout := &replicaLogWriter{mu: mu, w: stdcopy.NewStdWriter(w, stdcopy.Stdout), prefix: prefix}
+			stderr := &replicaLogWriter{mu: mu, w: stdcopy.NewStdWriter(w, stdcopy.Stderr), prefix: prefix}
+
+			if _, err := stdcopy.StdCopy(stdout, stderr, rc); err != nil {
+				errs <- err
+
+				return
+			}
+
+			if err := stdout.flush(); err != nil {
+				errs <- err
+
+				return
+			}
+
+			errs <- stderr.flush()
+		}(rc, j.Config.Tty)
+	}
+
+	// The other logs stop being followed on the first error
+	var err error
+	for range replicas {
+		if e := <-errs; e != nil && err == nil {
+			err = e
+			cancel()
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestReplicaLogWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := &replicaLogWriter{mu: &sync.Mutex{}, w: &out, prefix: []byte("[2] ")}
+
+	for _, p := range []string{"first\nsec", "ond\n", "", "third\nlast"} {
+		if n, err := w.Write([]byte(p)); err != nil || n != len(p) {
+			t.Fatalf("Write(%q) = %d, %v", p, n, err)
+		}
+	}
+
+	if want := "[2] first\n[2] second\n[2] third\n"; out.String() != want {
+		t.Errorf("output before flush = %q, want %q", out.String(), want)
+	}
+
+	if err := w.flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "[2] first\n[2] second\n[2] third\n[2] last"; out.String() != want {
+		t.Errorf("output after flush = %q, want %q", out.String(), want)
+	}
+
+	if err := w.flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "[2] first\n[2] second\n[2] third\n[2] last"; out.String() != want {
+		t.Errorf("output after second flush = %q, want %q", out.String(), want)
+	}
+}
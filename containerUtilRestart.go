@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/pkg/errors"
+)
+
+// restartBackoff returns the delay before restarting a container which crashed n times in a row
+func restartBackoff(n int) time.Duration {
+	if n == 0 {
+		return 0
+	}
+
+	d := restartBackoffBase
+	for i := 1; i < n; i++ {
+		d *= 2
+
+		if d >= restartBackoffMax {
+			return restartBackoffMax
+		}
+	}
+
+	return d
+}
+
+// handleDie records the exit of the container and restarts it if its restart policy says so
+func (c *ContainerControllerUtil) handleDie(ctx context.Context, cid string) error {
+	j, err := c.DockerClient.ContainerInspect(ctx, cid)
+
+	if err != nil {
+		return errors.Wrap(err, "Container Inspect Error")
+	}
+
+	idStr, ok := j.Config.Labels[dockerLabelModokiID]
+
+	// One-off containers are not restarted
+	if !ok {
+		return nil
+	}
+
+	id, err := strconv.Atoi(idStr)
+
+	if err != nil {
+		return errors.Wrap(err, "Invalid id format")
+	}
+
+	replica := 0
+	if s, ok := j.Config.Labels[dockerLabelModokiReplica]; ok {
+		replica, err = strconv.Atoi(s)
+
+		if err != nil {
+			return errors.Wrap(err, "Invalid replica format")
+		}
+	}
+
+	var policy string
+	var maxRetries, restartCount, crashCount int
+	var manualStop bool
+	err = c.DB.QueryRowContext(ctx, "SELECT restartPolicy, restartMaxRetries, restartCount, crashCount, manualStop FROM containers WHERE id=?", id).
+		Scan(&policy, &maxRetries, &restartCount, &crashCount, &manualStop)
+
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	currentCID, err := c.replicaCID(ctx, id, replica)
+
+	if err != nil {
+		return err
+	}
+
+	// Ignore containers replaced by redeploying or removed by scaling in
+	if currentCID.String != j.ID {
+		return nil
+	}
+
+	exitCode := j.State.ExitCode
+
+	// The exit code of the container is the one of the main replica
+	if replica == 0 {
+		if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET exitCode=? WHERE id=?", exitCode, id); err != nil {
+			return errors.Wrap(err, "DB Update error")
+		}
+	}
+
+	if manualStop {
+		return nil
+	}
+
+	var restart bool
+	switch policy {
+	case "always", "unless-stopped":
+		restart = true
+	case "on-failure":
+		restart = exitCode != 0 && (maxRetries == 0 || restartCount < maxRetries)
+	}
+
+	if !restart {
+		return nil
+	}
+
+	s, _ := time.Parse(time.RFC3339Nano, j.State.StartedAt)
+	f, _ := time.Parse(time.RFC3339Nano, j.State.FinishedAt)
+
+	if f.Sub(s) < crashLoopWindow {
+		crashCount++
+	} else {
+		crashCount = 0
+	}
+
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET restartCount=restartCount+1, crashCount=? WHERE id=?", crashCount, id); err != nil {
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	delay := restartBackoff(crashCount)
+
+	if crashCount >= crashLoopThreshold {
+		msg := fmt.Sprintf("Exited with %d %d times in a row. Restarting in %v", exitCode, crashCount, delay)
+
+		if err := c.updateStatus(ctx, "CrashLooping", msg, id); err != nil {
+			return errors.Wrap(err, "DB Update error")
+		}
+	}
+
+	time.AfterFunc(delay, func() {
+		if err := c.restartDied(context.Background(), id, replica, cid); err != nil {
+			log.Println("Restarting the container error:", err)
+
+			c.updateMessage(context.Background(), fmt.Sprintf("Restarting the container error: %v", err), id)
+		}
+	})
+
+	return nil
+}
+
+// restartDied starts the replica of the container again unless it was stopped, started or replaced in the meantime
+func (c *ContainerControllerUtil) restartDied(ctx context.Context, id, replica int, cid string) error {
+	var manualStop bool
+	err := c.DB.QueryRowContext(ctx, "SELECT manualStop FROM containers WHERE id=?", id).Scan(&manualStop)
+
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	currentCID, err := c.replicaCID(ctx, id, replica)
+
+	if err != nil {
+		return err
+	}
+
+	if currentCID.String != cid || manualStop {
+		return nil
+	}
+
+	j, err := c.DockerClient.ContainerInspect(ctx, cid)
+
+	if err != nil {
+		return errors.Wrap(err, "Container Inspect Error")
+	}
+
+	if j.State.Running {
+		return nil
+	}
+
+	if err := c.DockerClient.ContainerStart(ctx, cid, types.ContainerStartOptions{}); err != nil {
+		return errors.Wrap(err, "Docker API error")
+	}
+
+	return nil
+}
+
+// startRestartedContainers starts the stopped containers and their replicas with the always policy,
+// and the ones with the unless-stopped policy not stopped by the user
+func (c *ContainerControllerUtil) startRestartedContainers(ctx context.Context) error {
+	var containers []struct {
+		ID  int    `db:"id"`
+		CID string `db:"cid"`
+	}
+	err := c.DB.SelectContext(ctx, &containers, `SELECT id, cid FROM containers WHERE cid IS NOT NULL AND (restartPolicy="always" OR (restartPolicy="unless-stopped" AND manualStop=FALSE))`)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	start := func(cid string) {
+		j, err := c.DockerClient.ContainerInspect(ctx, cid)
+
+		if err != nil {
+			log.Println("Container Inspect Error:", err)
+
+			return
+		}
+
+		if j.State.Running {
+			return
+		}
+
+		if err := c.DockerClient.ContainerStart(ctx, cid, types.ContainerStartOptions{}); err != nil {
+			log.Println("Starting the container error:", err)
+		}
+	}
+
+	for i := range containers {
+		if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET manualStop=FALSE WHERE id=?", containers[i].ID); err != nil {
+			return errors.Wrap(err, "DB Update error")
+		}
+
+		start(containers[i].CID)
+
+		err := c.forEachReplica(ctx, containers[i].ID, func(cid string) error {
+			start(cid)
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRestartBackoff(t *testing.T) {
+	tests := []struct {
+		n    int
+		want time.Duration
+	}{
+		{0, 0},
+		{1, restartBackoffBase},
+		{2, 2 * restartBackoffBase},
+		{5, 16 * restartBackoffBase},
+		{9, 256 * restartBackoffBase},
+		{10, restartBackoffMax},
+		{1000, restartBackoffMax},
+	}
+
+	for _, tc := range tests {
+		if got := restartBackoff(tc.n); got != tc.want {
+			t.Errorf("restartBackoff(%d) = %v, want %v", tc.n, got, tc.want)
+		}
+	}
+}
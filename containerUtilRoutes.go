@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/docker/libkv/store"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+)
+
+// containerRoute routes requests for a path prefix on a host to a container
+type containerRoute struct {
+	ID          int    `db:"id"`
+	Host        string `db:"host"`
+	PathPrefix  string `db:"pathPrefix"`
+	StripPrefix bool   `db:"stripPrefix"`
+	Priority    int    `db:"priority"`
+}
+
+// rule returns the traefik frontend rule for the route
+func (r *containerRoute) rule() string {
+	if r.StripPrefix {
+		return "Host:" + r.Host + ";PathPrefixStrip:" + r.PathPrefix
+	}
+
+	return "Host:" + r.Host + ";PathPrefix:" + r.PathPrefix
+}
+
+func (r *containerRoute) media() *app.GoaContainerRoute {
+	return &app.GoaContainerRoute{
+		ID:          r.ID,
+		Host:        r.Host,
+		PathPrefix:  r.PathPrefix,
+		StripPrefix: r.StripPrefix,
+		Priority:    r.Priority,
+	}
+}
+
+func (c *ContainerControllerUtil) listContainerRoutes(ctx context.Context, id int) ([]*containerRoute, error) {
+	var routes []*containerRoute
+
+	if err := c.DB.SelectContext(ctx, &routes, "SELECT id, host, pathPrefix, stripPrefix, priority FROM containerRoutes WHERE containerID=? ORDER BY id", id); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return routes, nil
+}
+
+// checkRouteHost checks the host is a subdomain of the user's container or a verified custom domain of the user
+func (c *ContainerControllerUtil) checkRouteHost(ctx context.Context, uid, host string) error {
+	var err error
+	var found int
+	if strings.HasSuffix(host, "."+*publicAddr) {
+		err = c.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM containers WHERE uid=? AND name=?", uid, strings.TrimSuffix(host, "."+*publicAddr)).Scan(&found)
+	} else {
+		err = c.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM containerDomains INNER JOIN containers ON containerDomains.containerID=containers.id WHERE containers.uid=? AND containerDomains.domain=? AND containerDomains.verified=TRUE", uid, host).Scan(&found)
+	}
+
+	if err != nil && err != sql.ErrNoRows {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	if found == 0 {
+		return fmt.Errorf("%s is neither a subdomain of your container nor your verified custom domain", host)
+	}
+
+	return nil
+}
+
+// removeRoutesByHost removes the routes on the host from all containers.
+// It must be called when the host is released so that it cannot be hijacked by others.
+func (c *ContainerControllerUtil) removeRoutesByHost(ctx context.Context, host string) error {
+	var routes []struct {
+		ID          int `db:"id"`
+		ContainerID int `db:"containerID"`
+	}
+
+	if err := c.DB.SelectContext(ctx, &routes, "SELECT id, containerID FROM containerRoutes WHERE host=?", host); err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	for i := range routes {
+		if err := c.Consul.DeleteFrontend(fmt.Sprintf(routeFrontendFormat, routes[i].ContainerID, routes[i].ID)); err != nil {
+			if err != store.ErrKeyNotFound {
+				return errors.Wrap(err, "Consul Error")
+			}
+		}
+	}
+
+	if _, err := c.DB.ExecContext(ctx, "DELETE FROM containerRoutes WHERE host=?", host); err != nil {
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	return nil
+}
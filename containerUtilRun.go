@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/strslice"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+)
+
+// tailBuffer is an io.Writer keeping only the last n bytes written
+type tailBuffer struct {
+	n   int
+	buf []byte
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+
+	if len(b.buf) > b.n {
+		b.buf = append(b.buf[:0], b.buf[len(b.buf)-b.n:]...)
+	}
+
+	return len(p), nil
+}
+
+// String returns the kept bytes as valid UTF-8
+func (b *tailBuffer) String() string {
+	return string([]rune(string(b.buf)))
+}
+
+// binaryMessageWriter is an io.Writer sending each write as a binary WebSocket message
+type binaryMessageWriter struct {
+	conn *websocket.Conn
+}
+
+func (w *binaryMessageWriter) Write(p []byte) (int, error) {
+	if err := websocket.Message.Send(w.conn, p); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// oneOffConfig returns the config of a one-off container run by the user
+func oneOffConfig(uid, image string, command, entrypoint, env []string, workingDir *string) *container.Config {
+	config := &container.Config{
+		Image:      image,
+		Cmd:        strslice.StrSlice(command),
+		Entrypoint: strslice.StrSlice(entrypoint),
+		Env:        env,
+		Labels: map[string]string{
+			dockerLabelModokiUID: uid,
+		},
+	}
+
+	if workingDir != nil {
+		config.WorkingDir = *workingDir
+	}
+
+	return config
+}
+
+// reserveOneOff records a one-off container of the user with the limits in oneOffContainers
+// if it fits in the user's quota. The returned function removes the record after the run.
+func (c *ContainerControllerUtil) reserveOneOff(ctx context.Context, uid string, limits resourceLimits) (func(), error) {
+	tx, err := c.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "DB Begin error")
+	}
+
+	if err := reserveQuota(ctx, tx, c.Consul, uid, 0, containerUsage(limits, 1, 0)); err != nil {
+		tx.Rollback()
+
+		return nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, "INSERT INTO oneOffContainers (uid, cpuLimit, memoryLimit, storageLimit) VALUES (?, ?, ?, ?)", uid, limits.CPU, limits.Memory, limits.Storage)
+
+	if err != nil {
+		tx.Rollback()
+
+		return nil, errors.Wrap(err, "DB Insert error")
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		tx.Rollback()
+
+		return nil, errors.Wrap(err, "DB Insert error")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "DB Commit error")
+	}
+
+	return func() {
+		if _, err := c.DB.Exec("DELETE FROM oneOffContainers WHERE id=?", id); err != nil {
+			log.Println("Releasing the quota of the one-off container error:", err)
+		}
+	}, nil
+}
+
+// releaseInterruptedOneOffs removes the one-off containers left running by the previous process from the quotas
+func (c *ContainerControllerUtil) releaseInterruptedOneOffs(ctx context.Context) error {
+	if _, err := c.DB.ExecContext(ctx, "DELETE FROM oneOffContainers"); err != nil {
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	return nil
+}
+
+// runOneOff runs the container with the limits until it exits and copies its output to out while it is running.
+// The container is killed after timeout unless it is 0, and removed after the run.
+func (c *ContainerControllerUtil) runOneOff(ctx context.Context, config *container.Config, limits resourceLimits, timeout time.Duration, out io.Writer) (int, bool, error) {
+	if err := c.pullImage(ctx, config.Image); err != nil {
+		return 0, false, err
+	}
+
+	body, err := c.DockerClient.ContainerCreate(ctx, config, limits.hostConfig(), containerNetworkingConfig(), "")
+
+	if err != nil {
+		return 0, false, errors.Wrap(err, "Failed to create a container")
+	}
+	defer c.DockerClient.ContainerRemove(context.Background(), body.ID, types.ContainerRemoveOptions{Force: true, RemoveVolumes: true})
+
+	waitCh, errCh := c.DockerClient.ContainerWait(ctx, body.ID, container.WaitConditionNextExit)
+
+	if err := c.DockerClient.ContainerStart(ctx, body.ID, types.ContainerStartOptions{}); err != nil {
+		return 0, false, errors.Wrap(err, "Failed to start the container")
+	}
+
+	var timedOut int32
+	if timeout != 0 {
+		timer := time.AfterFunc(timeout, func() {
+			atomic.StoreInt32(&timedOut, 1)
+
+			c.DockerClient.ContainerKill(context.Background(), body.ID, "KILL")
+		})
+		defer timer.Stop()
+	}
+
+	rc, err := c.DockerClient.ContainerLogs(ctx, body.ID, types.ContainerLogsOptions{ShowStdout: true, ShowStderr: true, Follow: true})
+
+	if err != nil {
+		return 0, false, errors.Wrap(err, "Docker API Error")
+	}
+	defer rc.Close()
+
+	if _, err := stdcopy.StdCopy(out, out, rc); err != nil {
+		return 0, false, errors.Wrap(err, "Reading the output error")
+	}
+
+	select {
+	case res := <-waitCh:
+		if res.Error != nil {
+			return 0, false, errors.New(res.Error.Message)
+		}
+
+		return int(res.StatusCode), atomic.LoadInt32(&timedOut) == 1, nil
+	case err := <-errCh:
+		return 0, false, errors.Wrap(err, "Waiting for the container error")
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+)
+
+const (
+	scheduleActionStart = "start"
+	scheduleActionStop  = "stop"
+)
+
+// scheduleWindowRegexp matches windows such as "mon-fri 09:00-18:00"
+var scheduleWindowRegexp = regexp.MustCompile(`^(?:(\S+)\s+)?([01]?[0-9]|2[0-3]):([0-5][0-9])-([01]?[0-9]|2[0-3]):([0-5][0-9])$`)
+
+// containerSchedule is a cron schedule starting or stopping a container
+type containerSchedule struct {
+	ID        int        `db:"id"`
+	Action    string     `db:"action"`
+	Schedule  string     `db:"schedule"`
+	Timezone  string     `db:"timezone"`
+	NextRunAt time.Time  `db:"nextRunAt"`
+	LastRunAt *time.Time `db:"lastRunAt"`
+}
+
+func (s *containerSchedule) media() *app.GoaContainerSchedule {
+	return &app.GoaContainerSchedule{
+		ID:        s.ID,
+		Action:    s.Action,
+		Schedule:  s.Schedule,
+		Timezone:  s.Timezone,
+		NextRunAt: s.NextRunAt,
+		LastRunAt: s.LastRunAt,
+	}
+}
+
+// parseScheduleWindow returns the cron expressions starting and stopping a container for the window
+func parseScheduleWindow(window string) (string, string, error) {
+	m := scheduleWindowRegexp.FindStringSubmatch(window)
+
+	if m == nil {
+		return "", "", fmt.Errorf("Invalid window: %s", window)
+	}
+
+	days := m[1]
+	if days == "" {
+		days = "*"
+	}
+
+	startHour, _ := strconv.Atoi(m[2])
+	startMinute, _ := strconv.Atoi(m[3])
+	stopHour, _ := strconv.Atoi(m[4])
+	stopMinute, _ := strconv.Atoi(m[5])
+
+	if startHour*60+startMinute >= stopHour*60+stopMinute {
+		return "", "", errors.New("Windows must end after they start on the same day. Use schedules for windows across midnight")
+	}
+
+	return fmt.Sprintf("%d %d * * %s", startMinute, startHour, days), fmt.Sprintf("%d %d * * %s", stopMinute, stopHour, days), nil
+}
+
+// nextScheduleRun returns the next time after now the cron expression in the time zone fires
+func nextScheduleRun(spec, timezone string, now time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(timezone)
+
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "Unknown time zone")
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "Invalid schedule")
+	}
+
+	return schedule.Next(now.In(loc)), nil
+}
+
+func (c *ContainerControllerUtil) listContainerSchedules(ctx context.Context, id int) ([]*containerSchedule, error) {
+	var schedules []*containerSchedule
+	if err := c.DB.SelectContext(ctx, &schedules, "SELECT id, action, schedule, timezone, nextRunAt, lastRunAt FROM containerSchedules WHERE containerID=? ORDER BY id", id); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return schedules, nil
+}
+
+// runSchedule takes the action of the schedule on the container and logs it
+func (c *ContainerControllerUtil) runSchedule(ctx context.Context, id, scheduleID int, action string) error {
+	var cid sql.NullString
+	err := c.DB.QueryRowContext(ctx, "SELECT cid FROM containers WHERE id=?", id).Scan(&cid)
+
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	if !cid.Valid {
+		err = errors.New("The container is not created")
+	} else if action == scheduleActionStart {
+		err = c.startContainer(ctx, id, cid.String)
+	} else {
+		err = c.stopContainer(ctx, id, cid.String, defaultStopTimeout)
+	}
+
+	status := "Succeeded"
+	var message *string
+	if err != nil {
+		status = "Failed"
+		msg := err.Error()
+		message = &msg
+	}
+
+	_, err = c.DB.ExecContext(ctx, "INSERT INTO containerScheduleLogs (containerID, scheduleID, action, status, message, createdAt) VALUES (?, ?, ?, ?, ?, ?)", id, scheduleID, action, status, message, time.Now())
+
+	if err != nil {
+		return errors.Wrap(err, "DB Insert error")
+	}
+
+	return nil
+}
+
+// runContainerSchedules takes the actions of the schedules whose time has come and schedules their next runs
+func (c *ContainerControllerUtil) runContainerSchedules(ctx context.Context) error {
+	type dueSchedule struct {
+		ID          int       `db:"id"`
+		ContainerID int       `db:"containerID"`
+		Action      string    `db:"action"`
+		Schedule    string    `db:"schedule"`
+		Timezone    string    `db:"timezone"`
+		NextRunAt   time.Time `db:"nextRunAt"`
+	}
+
+	now := time.Now()
+
+	var schedules []*dueSchedule
+	if err := c.DB.SelectContext(ctx, &schedules, "SELECT id, containerID, action, schedule, timezone, nextRunAt FROM containerSchedules WHERE nextRunAt<=?", now); err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	for i := range schedules {
+		s := schedules[i]
+
+		next, err := nextScheduleRun(s.Schedule, s.Timezone, now)
+
+		if err != nil {
+			log.Printf("Invalid schedule %d: %v", s.ID, err)
+
+			continue
+		}
+
+		res, err := c.DB.ExecContext(ctx, "UPDATE containerSchedules SET nextRunAt=?, lastRunAt=? WHERE id=? AND nextRunAt=?", next, now, s.ID, s.NextRunAt)
+
+		if err != nil {
+			return errors.Wrap(err, "DB Update error")
+		}
+
+		// The schedule was removed in the meantime
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			continue
+		}
+
+		go func() {
+			if err := c.runSchedule(context.Background(), s.ContainerID, s.ID, s.Action); err != nil {
+				log.Printf("Running the schedule %d error: %v", s.ID, err)
+			}
+		}()
+	}
+
+	return nil
+}
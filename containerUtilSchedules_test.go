@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func TestParseScheduleWindow(t *testing.T) {
+	tests := []struct {
+		window      string
+		start, stop string
+		wantErr     bool
+	}{
+		{window: "09:00-18:00", start: "0 9 * * *", stop: "0 18 * * *"},
+		{window: "mon-fri 09:30-18:05", start: "30 9 * * mon-fri", stop: "5 18 * * mon-fri"},
+		{window: "sat,sun 0:00-23:59", start: "0 0 * * sat,sun", stop: "59 23 * * sat,sun"},
+		{window: "22:00-06:00", wantErr: true},
+		{window: "09:00-09:00", wantErr: true},
+		{window: "24:00-25:00", wantErr: true},
+		{window: "09:60-10:00", wantErr: true},
+		{window: "9-18", wantErr: true},
+		{window: "", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		start, stop, err := parseScheduleWindow(tc.window)
+
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("parseScheduleWindow(%q) succeeded, want an error", tc.window)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("parseScheduleWindow(%q) error: %v", tc.window, err)
+
+			continue
+		}
+
+		if start != tc.start || stop != tc.stop {
+			t.Errorf("parseScheduleWindow(%q) = (%q, %q), want (%q, %q)", tc.window, start, stop, tc.start, tc.stop)
+		}
+	}
+}
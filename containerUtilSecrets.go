@@ -0,0 +1,196 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+)
+
+var envNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// containerSecret is a secret of the user injected into a container as an env var or a file
+type containerSecret struct {
+	Name string         `db:"name"`
+	Env  sql.NullString `db:"env"`
+	File sql.NullString `db:"file"`
+}
+
+func (s *containerSecret) media() *app.GoaContainerSecret {
+	res := &app.GoaContainerSecret{
+		Name: s.Name,
+	}
+
+	if s.Env.Valid {
+		res.Env = &s.Env.String
+	}
+	if s.File.Valid {
+		res.File = &s.File.String
+	}
+
+	return res
+}
+
+// newContainerSecret returns a secret injected as the env var, or as the file if path is not empty
+func newContainerSecret(name, env, path string) (*containerSecret, error) {
+	s := &containerSecret{
+		Name: name,
+	}
+
+	if path != "" {
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("The file path must be absolute: %s", path)
+		}
+
+		s.File = sql.NullString{String: path, Valid: true}
+
+		return s, nil
+	}
+
+	if env == "" {
+		env = name
+	}
+
+	if !envNameRegexp.MatchString(env) {
+		return nil, fmt.Errorf("Invalid environment variable name: %s", env)
+	}
+
+	s.Env = sql.NullString{String: env, Valid: true}
+
+	return s, nil
+}
+
+// parseContainerSecret parses a secret specified as <secret>, <secret>:<env var> or <secret>:<absolute file path>
+func parseContainerSecret(spec string) (*containerSecret, error) {
+	idx := strings.Index(spec, ":")
+
+	if idx == -1 {
+		return newContainerSecret(spec, "", "")
+	}
+
+	name, target := spec[:idx], spec[idx+1:]
+
+	if strings.HasPrefix(target, "/") {
+		return newContainerSecret(name, "", target)
+	}
+
+	return newContainerSecret(name, target, "")
+}
+
+func (c *ContainerControllerUtil) listContainerSecrets(ctx context.Context, id int) ([]*containerSecret, error) {
+	var secrets []*containerSecret
+
+	if err := c.DB.SelectContext(ctx, &secrets, "SELECT name, env, file FROM containerSecrets WHERE containerID=? ORDER BY id", id); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return secrets, nil
+}
+
+// injectedEnvNames returns the names of the env vars secrets are injected as
+func injectedEnvNames(secrets []*containerSecret) map[string]struct{} {
+	names := make(map[string]struct{})
+
+	for i := range secrets {
+		if secrets[i].Env.Valid {
+			names[secrets[i].Env.String] = struct{}{}
+		}
+	}
+
+	return names
+}
+
+// removeEnv returns env without the variables of the names
+func removeEnv(env []string, names map[string]struct{}) []string {
+	res := make([]string, 0, len(env))
+
+	for i := range env {
+		name, _ := parseEnv(env[i])
+
+		if _, ok := names[name]; !ok {
+			res = append(res, env[i])
+		}
+	}
+
+	return res
+}
+
+// decryptContainerSecrets returns the env vars and the files(path -> content) to inject into the container
+func (c *ContainerControllerUtil) decryptContainerSecrets(ctx context.Context, id int) ([]string, map[string]string, error) {
+	var uid string
+	if err := c.DB.QueryRowContext(ctx, "SELECT uid FROM containers WHERE id=?", id).Scan(&uid); err != nil {
+		return nil, nil, errors.Wrap(err, "DB Select error")
+	}
+
+	secrets, err := c.listContainerSecrets(ctx, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	env := make([]string, 0, len(secrets))
+	files := make(map[string]string)
+	for i := range secrets {
+		value, err := c.Secrets.Get(ctx, uid, secrets[i].Name)
+
+		if err == sql.ErrNoRows {
+			return nil, nil, fmt.Errorf("The secret %s is not found", secrets[i].Name)
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		if secrets[i].Env.Valid {
+			env = append(env, secrets[i].Env.String+"="+value)
+		}
+		if secrets[i].File.Valid {
+			files[secrets[i].File.String] = value
+		}
+	}
+
+	return env, files, nil
+}
+
+// copySecretFiles copies the files into the container, which must be created but need not be started
+func (c *ContainerControllerUtil) copySecretFiles(ctx context.Context, cid string, files map[string]string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	buf := bytes.NewBuffer(nil)
+	tw := tar.NewWriter(buf)
+
+	for path, content := range files {
+		hdr := &tar.Header{
+			Name:    strings.TrimPrefix(path, "/"),
+			Mode:    0444,
+			Size:    int64(len(content)),
+			ModTime: time.Now(),
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		if _, err := tw.Write([]byte(content)); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	if err := c.DockerClient.CopyToContainer(ctx, cid, "/", buf, types.CopyToContainerOptions{}); err != nil {
+		return errors.Wrap(err, "Copying secret files error")
+	}
+
+	return nil
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/pkg/errors"
+)
+
+// containerRxBytes returns the bytes the container has received since it started
+func (c *ContainerControllerUtil) containerRxBytes(ctx context.Context, cid string) (uint64, error) {
+	j, err := c.containerStats(ctx, cid)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var rx uint64
+	for _, n := range j.Networks {
+		rx += n.RxBytes
+	}
+
+	return rx, nil
+}
+
+// sleepIdleContainers stops the containers which have received no traffic for their idle timeout
+func (c *ContainerControllerUtil) sleepIdleContainers(ctx context.Context) error {
+	type idleContainer struct {
+		ID      int    `db:"id"`
+		CID     string `db:"cid"`
+		RxBytes uint64 `db:"rxBytes"`
+		Active  bool   `db:"active"`
+		Idle    bool   `db:"idle"`
+	}
+
+	var containers []idleContainer
+	err := c.DB.SelectContext(
+		ctx,
+		&containers,
+		`SELECT id, cid, rxBytes, activeAt IS NOT NULL AS active, (activeAt IS NOT NULL AND activeAt < NOW() - INTERVAL idleTimeout SECOND) AS idle
+		FROM containers WHERE idleTimeout > 0 AND sleeping=FALSE AND cid IS NOT NULL AND kind=?`,
+		containerKindWeb,
+	)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Select error")
+	}
+
+	for i := range containers {
+		j, err := c.DockerClient.ContainerInspect(ctx, containers[i].CID)
+
+		if err != nil {
+			log.Println("Container Inspect Error:", err)
+
+			continue
+		}
+
+		if !j.State.Running || j.State.Paused {
+			continue
+		}
+
+		rx, err := c.containerRxBytes(ctx, containers[i].CID)
+
+		if err != nil {
+			log.Println("Getting stats error:", err)
+
+			continue
+		}
+
+		if rx != containers[i].RxBytes || !containers[i].Idle {
+			// Containers started before activeAt was recorded start idling now
+			if rx != containers[i].RxBytes || !containers[i].Active {
+				if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET rxBytes=?, activeAt=NOW() WHERE id=?", rx, containers[i].ID); err != nil {
+					return errors.Wrap(err, "DB Update error")
+				}
+			}
+
+			continue
+		}
+
+		if err := c.sleep(ctx, containers[i].ID, containers[i].CID); err != nil {
+			log.Println("Sleeping the container error:", err)
+
+			c.updateMessage(ctx, fmt.Sprintf("Sleeping the container error: %v", err), containers[i].ID)
+		}
+	}
+
+	return nil
+}
+
+// sleep stops the container and its replicas, and points its backends at the wake endpoint
+func (c *ContainerControllerUtil) sleep(ctx context.Context, id int, cid string) error {
+	// Sleeping containers are not restarted by the restart policy
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET sleeping=TRUE, manualStop=TRUE WHERE id=?", id); err != nil {
+		return errors.Wrap(err, "DB Update error")
+	}
+
+	timeout := defaultStopTimeout
+	if err := c.DockerClient.ContainerStop(ctx, cid, &timeout); err != nil {
+		return errors.Wrap(err, "Docker API error")
+	}
+
+	err := c.forEachReplica(ctx, id, func(cid string) error {
+		return c.DockerClient.ContainerStop(ctx, cid, &timeout)
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "Docker API error")
+	}
+
+	return c.updateContainerStatus(ctx, cid)
+}
+
+// registerWake points the backends of the sleeping container at the wake endpoint
+func (c *ContainerControllerUtil) registerWake(ctx context.Context, id int) error {
+	backends, err := c.backendURLs(ctx, id, "")
+
+	if err != nil {
+		return err
+	}
+
+	for backendName := range backends {
+		if err := c.Consul.NewBackend(backendName, serverName, *wakeAddr); err != nil {
+			return errors.Wrap(err, "Traefik Registeration Error")
+		}
+
+		if err := c.Consul.SetServerWeight(backendName, serverName, defaultServerWeight); err != nil {
+			return errors.Wrap(err, "Traefik Registeration Error")
+		}
+	}
+
+	return nil
+}
+
+// wake starts the sleeping container and its replicas, and returns the address after it accepts connections
+func (c *ContainerControllerUtil) wake(ctx context.Context, id int) (string, error) {
+	var cid sql.NullString
+	var sleeping bool
+	if err := c.DB.QueryRowContext(ctx, "SELECT cid, sleeping FROM containers WHERE id=?", id).Scan(&cid, &sleeping); err != nil {
+		return "", errors.Wrap(err, "DB Select error")
+	}
+
+	if !cid.Valid {
+		return "", errors.New("The container is not created")
+	}
+
+	if !sleeping {
+		j, err := c.DockerClient.ContainerInspect(ctx, cid.String)
+
+		if err != nil {
+			return "", errors.Wrap(err, "Container Inspect Error")
+		}
+
+		if addr := containerIPAddress(j); addr != "" && j.State.Running && !j.State.Paused {
+			return addr, nil
+		}
+
+		return "", errors.New("The container is not running")
+	}
+
+	// Waking goes on even if the request is canceled not to leave the container half started
+	ctx = context.Background()
+
+	if err := c.DockerClient.ContainerStart(ctx, cid.String, types.ContainerStartOptions{}); err != nil {
+		return "", errors.Wrap(err, "Docker API error")
+	}
+
+	err := c.forEachReplica(ctx, id, func(cid string) error {
+		return c.DockerClient.ContainerStart(ctx, cid, types.ContainerStartOptions{})
+	})
+
+	if err != nil {
+		return "", errors.Wrap(err, "Docker API error")
+	}
+
+	addr, err := c.waitHealthy(ctx, id, cid.String, wakeTimeout)
+
+	if err != nil {
+		return "", err
+	}
+
+	// The container keeps sleeping until it is ready so that the next request tries to wake it again
+	if _, err := c.DB.ExecContext(ctx, "UPDATE containers SET sleeping=FALSE, manualStop=FALSE, rxBytes=0, activeAt=NOW() WHERE id=?", id); err != nil {
+		return "", errors.Wrap(err, "DB Update error")
+	}
+
+	// Requests go to the container directly without waiting for the start event
+	if err := c.updateContainerStatus(ctx, cid.String); err != nil {
+		return "", err
+	}
+
+	return addr, nil
+}
+
+// findContainerByHost returns the container and its backend serving the request to the host and the path
+func (c *ContainerControllerUtil) findContainerByHost(ctx context.Context, host, path string) (int, string, *containerRoute, error) {
+	var routes []struct {
+		ContainerID int    `db:"containerID"`
+		PathPrefix  string `db:"pathPrefix"`
+		StripPrefix bool   `db:"stripPrefix"`
+	}
+
+	if err := c.DB.SelectContext(ctx, &routes, "SELECT containerID, pathPrefix, stripPrefix FROM containerRoutes WHERE host=?", host); err != nil {
+		return 0, "", nil, errors.Wrap(err, "DB Select error")
+	}
+
+	// The longest prefix wins as traefik does
+	found := -1
+	for i := range routes {
+		if strings.HasPrefix(path, routes[i].PathPrefix) && (found == -1 || len(routes[i].PathPrefix) > len(routes[found].PathPrefix)) {
+			found = i
+		}
+	}
+
+	if found != -1 {
+		id := routes[found].ContainerID
+		route := &containerRoute{
+			Host:        host,
+			PathPrefix:  routes[found].PathPrefix,
+			StripPrefix: routes[found].StripPrefix,
+		}
+
+		return id, fmt.Sprintf(backendFormat, id), route, nil
+	}
+
+	var id int
+	if strings.HasSuffix(host, "."+*publicAddr) {
+		labels := strings.Split(strings.TrimSuffix(host, "."+*publicAddr), ".")
+
+		switch len(labels) {
+		case 1:
+			if err := c.DB.QueryRowContext(ctx, "SELECT id FROM containers WHERE name=?", labels[0]).Scan(&id); err != nil {
+				return 0, "", nil, err
+			}
+
+			return id, fmt.Sprintf(backendFormat, id), nil, nil
+		case 2:
+			if err := c.DB.QueryRowContext(ctx, "SELECT id FROM containers WHERE name=?", labels[1]).Scan(&id); err != nil {
+				return 0, "", nil, err
+			}
+
+			return id, fmt.Sprintf(portBackendFormat, id, labels[0]), nil, nil
+		}
+	}
+
+	if err := c.DB.QueryRowContext(ctx, "SELECT containerID FROM containerDomains WHERE verifiedDomain=?", host).Scan(&id); err != nil {
+		return 0, "", nil, err
+	}
+
+	return id, fmt.Sprintf(backendFormat, id), nil, nil
+}
+
+// WakeHandler serves requests to sleeping containers. It starts the container and proxies the request to it.
+type WakeHandler struct {
+	*ContainerControllerUtil
+}
+
+func (h *WakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+
+	id, backendName, route, err := h.findContainerByHost(r.Context(), host, r.URL.Path)
+
+	if err == sql.ErrNoRows {
+		http.NotFound(w, r)
+
+		return
+	} else if err != nil {
+		log.Println("Finding the container error:", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	// Serialized with redeploying and scaling, which replace the docker containers to start
+	unlock := h.locks.lock(id)
+	addr, err := h.wake(r.Context(), id)
+	unlock()
+
+	if err != nil {
+		log.Println("Waking the container error:", err)
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+
+		return
+	}
+
+	backends, err := h.backendURLs(r.Context(), id, addr)
+
+	if err != nil {
+		log.Println("Getting backends error:", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	target, err := url.Parse(backends[backendName])
+
+	if err != nil || backends[backendName] == "" {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	if route != nil && route.StripPrefix {
+		r.URL.Path = "/" + strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, route.PathPrefix), "/")
+	}
+
+	httputil.NewSingleHostReverseProxy(target).ServeHTTP(w, r)
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+)
+
+// containerStats returns a snapshot of the stats of the docker container
+func (c *ContainerControllerUtil) containerStats(ctx context.Context, cid string) (*types.StatsJSON, error) {
+	stats, err := c.DockerClient.ContainerStats(ctx, cid, false)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "Docker API error")
+	}
+	defer stats.Body.Close()
+
+	var j types.StatsJSON
+	if err := json.NewDecoder(stats.Body).Decode(&j); err != nil {
+		return nil, errors.Wrap(err, "Decoding stats error")
+	}
+
+	return &j, nil
+}
+
+// statsMedia normalizes the stats of a replica in the same way as docker stats
+func statsMedia(replica int, j *types.StatsJSON) *app.GoaContainerStats {
+	m := &app.GoaContainerStats{
+		Replica: replica,
+		Read:    j.Read,
+		Pids:    int(j.PidsStats.Current),
+	}
+
+	cpuDelta := float64(j.CPUStats.CPUUsage.TotalUsage) - float64(j.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(j.CPUStats.SystemUsage) - float64(j.PreCPUStats.SystemUsage)
+
+	cpus := float64(j.CPUStats.OnlineCPUs)
+	if cpus == 0 {
+		cpus = float64(len(j.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	if cpuDelta > 0 && systemDelta > 0 {
+		m.CPUPercent = cpuDelta / systemDelta * cpus * 100
+	}
+
+	// The page cache can be reclaimed
+	usage := j.MemoryStats.Usage
+	if cache := j.MemoryStats.Stats["cache"]; cache < usage {
+		usage -= cache
+	}
+
+	m.MemoryUsage = int(usage)
+	m.MemoryLimit = int(j.MemoryStats.Limit)
+
+	if j.MemoryStats.Limit != 0 {
+		m.MemoryPercent = float64(usage) / float64(j.MemoryStats.Limit) * 100
+	}
+
+	for _, n := range j.Networks {
+		m.RxBytes += int(n.RxBytes)
+		m.TxBytes += int(n.TxBytes)
+	}
+
+	for _, e := range j.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(e.Op) {
+		case "read":
+			m.BlockReadBytes += int(e.Value)
+		case "write":
+			m.BlockWriteBytes += int(e.Value)
+		}
+	}
+
+	return m
+}
+
+// replicaContainers returns the main docker container and the replicas of the container
+func (c *ContainerControllerUtil) replicaContainers(ctx context.Context, id int, cid string) ([]*containerReplica, error) {
+	replicas, err := c.listReplicas(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*containerReplica{{Replica: 0, CID: cid}}, replicas...), nil
+}
+
+// streamStats calls send with the stats of each replica every time docker reports them until ctx is done or send fails
+func (c *ContainerControllerUtil) streamStats(ctx context.Context, replicas []*containerReplica, send func(*app.GoaContainerStats) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(chan error, len(replicas))
+	bodies := make([]io.Closer, 0, len(replicas))
+
+	// Closing the bodies stops the decoders
+	defer func() {
+		for i := range bodies {
+			bodies[i].Close()
+		}
+		wg.Wait()
+	}()
+
+	for i := range replicas {
+		stats, err := c.DockerClient.ContainerStats(ctx, replicas[i].CID, true)
+
+		if err != nil {
+			return errors.Wrap(err, "Docker API error")
+		}
+		bodies = append(bodies, stats.Body)
+
+		wg.Add(1)
+		go func(replica int, body io.Reader) {
+			defer wg.Done()
+
+			dec := json.NewDecoder(body)
+			for {
+				var j types.StatsJSON
+				if err := dec.Decode(&j); err != nil {
+					if err != io.EOF && ctx.Err() == nil {
+						errs <- errors.Wrap(err, "Decoding stats error")
+					}
+					cancel()
+
+					return
+				}
+
+				mu.Lock()
+				err := send(statsMedia(replica, &j))
+				mu.Unlock()
+
+				if err != nil {
+					cancel()
+
+					return
+				}
+			}
+		}(replicas[i].Replica, stats.Body)
+	}
+
+	<-ctx.Done()
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+		return nil
+	}
+}
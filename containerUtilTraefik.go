@@ -0,0 +1,49 @@
+package main
+
+import "strconv"
+
+// newFrontend registers a frontend which routes requests for the host to the backend
+func (c *ContainerControllerUtil) newFrontend(frontendName, backendName, host string, sslRedirect bool) error {
+	return c.newFrontendWithRule(frontendName, backendName, "Host: "+host, sslRedirect)
+}
+
+// newRouteFrontend registers a frontend which routes requests for the path prefix on the host to the backend
+func (c *ContainerControllerUtil) newRouteFrontend(frontendName, backendName string, route *containerRoute, sslRedirect bool) error {
+	if err := c.newFrontendWithRule(frontendName, backendName, route.rule(), sslRedirect); err != nil {
+		return err
+	}
+
+	return c.Consul.AddValueForFrontend(frontendName, "priority", strconv.Itoa(route.Priority))
+}
+
+func (c *ContainerControllerUtil) newFrontendWithRule(frontendName, backendName, rule string, sslRedirect bool) error {
+	if err := c.Consul.NewFrontend(frontendName, rule); err != nil {
+		return err
+	}
+
+	if err := c.Consul.AddValueForFrontend(frontendName, "passHostHeader", true); err != nil {
+		return err
+	}
+
+	if *https {
+		if err := c.Consul.AddValueForFrontend(frontendName, "headers", "sslredirect", sslRedirect); err != nil {
+			return err
+		}
+	}
+
+	return c.Consul.AddValueForFrontend(frontendName, "backend", backendName)
+}
+
+// endpoints returns the URLs the host is served on
+func endpoints(host string) []string {
+	if *https {
+		return []string{
+			"https://" + host,
+			"http://" + host,
+		}
+	}
+
+	return []string{
+		"http://" + host,
+	}
+}
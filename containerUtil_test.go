@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+func TestPrivateAlias(t *testing.T) {
+	tests := []struct {
+		name, uid, want string
+	}{
+		{"db", "alice", "db.alice"},
+		{"db", "Alice", "db.alice"},
+		{"cache", "github|12345", "cache.github-12345"},
+		{"db", "a.b_c", "db.a-b-c"},
+	}
+
+	for _, tc := range tests {
+		if got := privateAlias(tc.name, tc.uid); got != tc.want {
+			t.Errorf("privateAlias(%q, %q) = %q, want %q", tc.name, tc.uid, got, tc.want)
+		}
+	}
+}
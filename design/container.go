@@ -5,6 +5,24 @@ import (
 	. "github.com/goadesign/goa/design/apidsl"
 )
 
+var ContainerInspectHealthMedia = MediaType("vnd.application/goa.container.inspect.health+json", func() {
+	Attributes(func() {
+		Attribute("status", String, func() {
+			Enum("starting", "healthy", "unhealthy")
+		})
+		Attribute("failingStreak", Integer, "Number of consecutive failures")
+		Attribute("lastOutput", String, "Output of the last health check")
+
+		Required("status", "failingStreak")
+	})
+
+	View("default", func() {
+		Attribute("status")
+		Attribute("failingStreak")
+		Attribute("lastOutput")
+	})
+})
+
 var ContainerInspectRawStateMedia = MediaType("vnd.application/goa.container.inspect.raw_state+json", func() {
 	Attributes(func() {
 		Attribute("exitCode", Integer)
@@ -19,6 +37,7 @@ var ContainerInspectRawStateMedia = MediaType("vnd.application/goa.container.ins
 		Attribute("status", String, func() {
 			Enum("created", "running", "paused", "restarting", "removing", "exited", "dead")
 		})
+		Attribute("health", ContainerInspectHealthMedia, "Result of the health check. Missing if the container has no health check")
 
 		Required("exitCode", "finishedAt", "oomKilled", "dead", "paused", "pid", "restarting", "running", "startedAt", "status")
 	})
@@ -34,6 +53,7 @@ var ContainerInspectRawStateMedia = MediaType("vnd.application/goa.container.ins
 		Attribute("running")
 		Attribute("startedAt")
 		Attribute("status")
+		Attribute("health")
 	})
 })
 
@@ -46,8 +66,14 @@ var ContainerListEachMedia = MediaType("vpn.application/goa.container.list.each+
 		Attribute("command", String, "Command to run when starting the container")
 		Attribute("created", DateTime, "The time the container was created")
 		Attribute("volumes", ArrayOf(String), "Paths to mount volumes in")
+		Attribute("port", Integer, "Port the container serves HTTP on")
+		Attribute("protocol", String, "Protocol the container serves on the port")
+		Attribute("kind", String, "Kind of the container(web, worker or private)")
+		Attribute("replicas", Integer, "Number of replicas")
+		Attribute("runningReplicas", Integer, "Number of running replicas")
+		Attribute("expiresAt", DateTime, "The time the container is removed at")
 		Attribute("status", String, func() {
-			Enum("Creating", "Created", "Running", "Stopped", "Error")
+			Enum("Creating", "Created", "Running", "Healthy", "Unhealthy", "Paused", "Stopped", "Sleeping", "CrashLooping", "Error")
 		})
 
 		Required("name", "id", "image", "imageID", "command", "created", "status", "volumes")
@@ -61,6 +87,12 @@ var ContainerListEachMedia = MediaType("vpn.application/goa.container.list.each+
 		Attribute("command")
 		Attribute("created")
 		Attribute("volumes")
+		Attribute("port")
+		Attribute("protocol")
+		Attribute("kind")
+		Attribute("replicas")
+		Attribute("runningReplicas")
+		Attribute("expiresAt")
 		Attribute("status")
 	})
 })
@@ -75,11 +107,22 @@ var ContainerInspectMedia = MediaType("vpn.application/goa.container.inspect+jso
 		Attribute("args", ArrayOf(String), "The arguments to the command being run")
 		Attribute("created", DateTime, "The time the container was created")
 		Attribute("volumes", ArrayOf(String), "Paths to mount volumes in")
+		Attribute("port", Integer, "Port the container serves HTTP on")
+		Attribute("protocol", String, "Protocol the container serves on the port")
+		Attribute("kind", String, "Kind of the container(web, worker or private)")
 
 		Attribute("status", String, func() {
-			Enum("Image Downloading", "Created", "Running", "Stopped", "Error")
+			Enum("Image Downloading", "Created", "Running", "Healthy", "Unhealthy", "Paused", "Stopped", "Sleeping", "CrashLooping", "Error")
 		})
 		Attribute("raw_state", ContainerInspectRawStateMedia)
+		Attribute("replicas", Integer, "Number of replicas")
+		Attribute("runningReplicas", Integer, "Number of running replicas")
+		Attribute("restartPolicy", String, "Restart policy of the container")
+		Attribute("restartCount", Integer, "Number of restarts by the restart policy since the container was started by the user")
+		Attribute("exitCode", Integer, "Exit code of the last exit")
+		Attribute("schedules", ArrayOf(ContainerScheduleMedia), "Schedules starting or stopping the container")
+		Attribute("expiresAt", DateTime, "The time the container is removed at")
+		Attribute("limits", ContainerLimitsMedia, "Effective resource limits")
 
 		Required("name", "id", "image", "imageID", "path", "args", "created", "status", "raw_state", "volumes")
 	})
@@ -93,8 +136,117 @@ var ContainerInspectMedia = MediaType("vpn.application/goa.container.inspect+jso
 		Attribute("args")
 		Attribute("created")
 		Attribute("volumes")
+		Attribute("port")
+		Attribute("protocol")
+		Attribute("kind")
 		Attribute("status")
 		Attribute("raw_state")
+		Attribute("replicas")
+		Attribute("runningReplicas")
+		Attribute("restartPolicy")
+		Attribute("restartCount")
+		Attribute("exitCode")
+		Attribute("schedules")
+		Attribute("expiresAt")
+		Attribute("limits")
+	})
+})
+
+var ContainerLimitsMedia = MediaType("vpn.application/goa.container.limits+json", func() {
+	Description("Resource limits applied to a container. Missing limits are unlimited")
+	Attributes(func() {
+		Attribute("cpu", Integer, "Percentage of a CPU")
+		Attribute("memory", String, "Memory size such as 512M")
+		Attribute("storage", String, "Storage size such as 10G")
+		Attribute("pids", Integer, "Maximum number of processes")
+	})
+
+	View("default", func() {
+		Attribute("cpu")
+		Attribute("memory")
+		Attribute("storage")
+		Attribute("pids")
+	})
+})
+
+var ContainerStatsMedia = MediaType("vpn.application/goa.container.stats+json", func() {
+	Description("Resource usage of a replica of a container")
+	Attributes(func() {
+		Attribute("replica", Integer, "Replica number. 0 is the main one")
+		Attribute("read", DateTime, "The time the stats were read")
+		Attribute("cpuPercent", Number, "CPU usage in the percentage of a CPU")
+		Attribute("memoryUsage", Integer, "Memory used in bytes excluding the page cache")
+		Attribute("memoryLimit", Integer, "Memory available in bytes")
+		Attribute("memoryPercent", Number, "Memory usage in the percentage of the limit")
+		Attribute("rxBytes", Integer, "Bytes received over the network since the container started")
+		Attribute("txBytes", Integer, "Bytes sent over the network since the container started")
+		Attribute("blockReadBytes", Integer, "Bytes read from block devices since the container started")
+		Attribute("blockWriteBytes", Integer, "Bytes written to block devices since the container started")
+		Attribute("pids", Integer, "Number of processes")
+
+		Required("replica", "read", "cpuPercent", "memoryUsage", "memoryLimit", "memoryPercent", "rxBytes", "txBytes", "blockReadBytes", "blockWriteBytes", "pids")
+	})
+
+	View("default", func() {
+		Attribute("replica")
+		Attribute("read")
+		Attribute("cpuPercent")
+		Attribute("memoryUsage")
+		Attribute("memoryLimit")
+		Attribute("memoryPercent")
+		Attribute("rxBytes")
+		Attribute("txBytes")
+		Attribute("blockReadBytes")
+		Attribute("blockWriteBytes")
+		Attribute("pids")
+	})
+})
+
+var ContainerMetricsPointMedia = MediaType("vpn.application/goa.container.metrics.point+json", func() {
+	Description("Resource usage of a container summed over the replicas in a step")
+	Attributes(func() {
+		Attribute("time", DateTime, "The start of the step")
+		Attribute("cpuPercent", Number, "Average CPU usage in the percentage of a CPU")
+		Attribute("memoryUsage", Integer, "Average memory used in bytes")
+		Attribute("memoryLimit", Integer, "Average memory available in bytes")
+		Attribute("rxBytes", Integer, "Bytes received over the network in the step")
+		Attribute("txBytes", Integer, "Bytes sent over the network in the step")
+		Attribute("blockReadBytes", Integer, "Bytes read from block devices in the step")
+		Attribute("blockWriteBytes", Integer, "Bytes written to block devices in the step")
+		Attribute("pids", Integer, "Average number of processes")
+
+		Required("time", "cpuPercent", "memoryUsage", "memoryLimit", "rxBytes", "txBytes", "blockReadBytes", "blockWriteBytes", "pids")
+	})
+
+	View("default", func() {
+		Attribute("time")
+		Attribute("cpuPercent")
+		Attribute("memoryUsage")
+		Attribute("memoryLimit")
+		Attribute("rxBytes")
+		Attribute("txBytes")
+		Attribute("blockReadBytes")
+		Attribute("blockWriteBytes")
+		Attribute("pids")
+	})
+})
+
+var ContainerMetricsMedia = MediaType("vpn.application/goa.container.metrics+json", func() {
+	Description("Time series of the resource usage of a container. Steps without samples are omitted")
+	Attributes(func() {
+		Attribute("from", DateTime, "The start of the series")
+		Attribute("to", DateTime, "The end of the series")
+		Attribute("step", Integer, "Seconds between the points")
+		Attribute("points", CollectionOf(ContainerMetricsPointMedia))
+
+		Required("from", "to", "step", "points")
+	})
+
+	View("default", func() {
+		Attribute("from")
+		Attribute("to")
+		Attribute("step")
+		Attribute("points")
 	})
 })
 
@@ -123,18 +275,257 @@ var ContainerDownloadOK = MediaType("vpn.application/goa.container.download.resu
 	})
 })
 
+var ContainerRunOK = MediaType("vpn.application/goa.container.run.result+json", func() {
+	Description("The result of a one-off container")
+	Attributes(func() {
+		Attribute("exitCode", Integer, "Exit code of the command")
+		Attribute("output", String, "Stdout and stderr of the command. Only the end is kept if it is long")
+		Attribute("timedOut", Boolean, "Whether the container was killed by the timeout")
+
+		Required("exitCode", "output", "timedOut")
+	})
+
+	View("default", func() {
+		Attribute("exitCode")
+		Attribute("output")
+		Attribute("timedOut")
+	})
+})
+
+// runParams are the parameters of one-off containers
+var runParams = func() {
+	Param("image", String, "Name of image")
+	Param("command", ArrayOf(String), "Command to run specified as a string or an array of strings.")
+	Param("entrypoint", ArrayOf(String), "The entry point for the container as a string or an array of strings")
+	Param("env", ArrayOf(String), "Environment variables")
+	Param("workingDir", String, "Current directory (PWD) in the command will be launched")
+	Param("timeout", Integer, func() {
+		Description("Seconds until the container is killed")
+		Minimum(1)
+		Maximum(3600)
+		Default(600)
+	})
+
+	Required("image")
+}
+
 var ContainerConfig = Type("ContainerConfig", func() {
 	Attribute("defaultShell", String)
+	Attribute("idleTimeout", Integer, func() {
+		Description("Seconds without incoming traffic until the container sleeps. A sleeping container is started by the next request. 0 disables sleeping")
+		Minimum(0)
+	})
 })
 
 var ContainerConfigOK = MediaType("vpn.application/goa.container.config+json", func() {
 	Reference(ContainerConfig)
 	Attributes(func() {
 		Attribute("defaultShell")
+		Attribute("idleTimeout")
 	})
 
 	View("default", func() {
 		Attribute("defaultShell")
+		Attribute("idleTimeout")
+	})
+})
+
+var ContainerDomainMedia = MediaType("vpn.application/goa.container.domain+json", func() {
+	Description("A custom domain of a container")
+	Attributes(func() {
+		Attribute("domain", String, "Domain name")
+		Attribute("verified", Boolean, "Whether the ownership of the domain has been verified")
+		Attribute("token", String, "Token to prove the ownership of the domain")
+		Attribute("txtRecord", String, "Name of the TXT record to put the token in")
+		Attribute("httpURL", String, "URL to serve the token at")
+
+		Required("domain", "verified", "token", "txtRecord", "httpURL")
+	})
+
+	View("default", func() {
+		Attribute("domain")
+		Attribute("verified")
+		Attribute("token")
+		Attribute("txtRecord")
+		Attribute("httpURL")
+	})
+})
+
+var ContainerRouteMedia = MediaType("vpn.application/goa.container.route+json", func() {
+	Description("A path-prefix route to a container")
+	Attributes(func() {
+		Attribute("id", Integer, "Route ID")
+		Attribute("host", String, "Host name")
+		Attribute("pathPrefix", String, "Path prefix")
+		Attribute("stripPrefix", Boolean, "Whether the prefix is stripped before forwarding requests")
+		Attribute("priority", Integer, "Priority of the route. Routes with higher priority are matched first")
+
+		Required("id", "host", "pathPrefix", "stripPrefix", "priority")
+	})
+
+	View("default", func() {
+		Attribute("id")
+		Attribute("host")
+		Attribute("pathPrefix")
+		Attribute("stripPrefix")
+		Attribute("priority")
+	})
+})
+
+var ContainerReleaseMedia = MediaType("vpn.application/goa.container.release+json", func() {
+	Description("A configuration of a container recorded every time it is deployed")
+	Attributes(func() {
+		Attribute("version", Integer, "Release number")
+		Attribute("image", String, "The name of the image")
+		Attribute("imageDigest", String, "The repository digest of the image")
+		Attribute("env", ArrayOf(String), "Environment variables")
+		Attribute("command", ArrayOf(String), "Command to run")
+		Attribute("entrypoint", ArrayOf(String), "The entry point for the container")
+		Attribute("workingDir", String, "Current directory (PWD) in the command will be launched")
+		Attribute("port", Integer, "Port the container serves HTTP on")
+		Attribute("protocol", String, "Protocol the container serves on the port")
+		Attribute("ports", ArrayOf(String), "Additional ports")
+		Attribute("cause", String, func() {
+			Description("What deployed the release")
+			Enum("create", "redeploy", "rollback")
+		})
+		Attribute("createdAt", DateTime, "The time the release was deployed")
+
+		Required("version", "image", "imageDigest", "env", "command", "entrypoint", "workingDir", "protocol", "ports", "cause", "createdAt")
+	})
+
+	View("default", func() {
+		Attribute("version")
+		Attribute("image")
+		Attribute("imageDigest")
+		Attribute("env")
+		Attribute("command")
+		Attribute("entrypoint")
+		Attribute("workingDir")
+		Attribute("port")
+		Attribute("protocol")
+		Attribute("ports")
+		Attribute("cause")
+		Attribute("createdAt")
+	})
+})
+
+var ContainerEnvType = Type("ContainerEnv", func() {
+	Attribute("name", String, func() {
+		Description("Name of the environment variable")
+		Pattern("^[a-zA-Z_][a-zA-Z0-9_]*$")
+		MaxLength(255)
+	})
+	Attribute("value", String, "Value of the environment variable")
+	Attribute("secret", Boolean, func() {
+		Description("Mask the value in responses")
+		Default(false)
+	})
+
+	Required("name", "value")
+})
+
+var ContainerEnvMedia = MediaType("vpn.application/goa.container.env+json", func() {
+	Description("An environment variable of a container")
+	Reference(ContainerEnvType)
+	Attributes(func() {
+		Attribute("name")
+		Attribute("value", String, "Value of the environment variable. Masked if secret")
+		Attribute("secret", Boolean, "Whether the value is masked")
+
+		Required("name", "value", "secret")
+	})
+
+	View("default", func() {
+		Attribute("name")
+		Attribute("value")
+		Attribute("secret")
+	})
+})
+
+var ContainerSecretMedia = MediaType("vpn.application/goa.container.secret+json", func() {
+	Description("A secret injected into a container")
+	Attributes(func() {
+		Attribute("name", String, "Name of the secret")
+		Attribute("env", String, "Environment variable the secret is injected as")
+		Attribute("file", String, "Path of the file the secret is copied to")
+
+		Required("name")
+	})
+
+	View("default", func() {
+		Attribute("name")
+		Attribute("env")
+		Attribute("file")
+	})
+})
+
+var ContainerScheduleMedia = MediaType("vpn.application/goa.container.schedule+json", func() {
+	Description("A schedule starting or stopping a container")
+	Attributes(func() {
+		Attribute("id", Integer, "ID")
+		Attribute("action", String, func() {
+			Enum("start", "stop")
+		})
+		Attribute("schedule", String, "Cron expression(minute hour day month weekday)")
+		Attribute("timezone", String, "Time zone the schedule is in")
+		Attribute("nextRunAt", DateTime, "The time the action is taken next")
+		Attribute("lastRunAt", DateTime, "The time the action was taken last")
+
+		Required("id", "action", "schedule", "timezone", "nextRunAt")
+	})
+
+	View("default", func() {
+		Attribute("id")
+		Attribute("action")
+		Attribute("schedule")
+		Attribute("timezone")
+		Attribute("nextRunAt")
+		Attribute("lastRunAt")
+	})
+})
+
+var ContainerScheduleLogMedia = MediaType("vpn.application/goa.container.schedule.log+json", func() {
+	Description("An action taken by a schedule")
+	Attributes(func() {
+		Attribute("schedule", Integer, "ID of the schedule")
+		Attribute("action", String, func() {
+			Enum("start", "stop")
+		})
+		Attribute("status", String, func() {
+			Enum("Succeeded", "Failed")
+		})
+		Attribute("message", String, "Error message if the action failed")
+		Attribute("createdAt", DateTime, "The time the action was taken")
+
+		Required("schedule", "action", "status", "createdAt")
+	})
+
+	View("default", func() {
+		Attribute("schedule")
+		Attribute("action")
+		Attribute("status")
+		Attribute("message")
+		Attribute("createdAt")
+	})
+})
+
+var ContainerEventMedia = MediaType("vpn.application/goa.container.event+json", func() {
+	Description("An event which happened to a container")
+	Attributes(func() {
+		Attribute("type", String, func() {
+			Enum("ExpiryWarning")
+		})
+		Attribute("message", String, "Description of the event")
+		Attribute("createdAt", DateTime, "The time the event happened")
+
+		Required("type", "message", "createdAt")
+	})
+
+	View("default", func() {
+		Attribute("type")
+		Attribute("message")
+		Attribute("createdAt")
 	})
 })
 
@@ -164,6 +555,97 @@ var _ = Resource("container", func() { // Defines the Operands resource
 
 				Default(true)
 			})
+			Param("port", Integer, func() {
+				Description("Port the container serves HTTP on. Defaults to the port exposed by the image, or 80")
+				Minimum(1)
+				Maximum(65535)
+			})
+			Param("protocol", String, func() {
+				Description("Protocol the container serves on the port")
+				Enum("http", "https")
+				Default("http")
+			})
+			Param("secrets", ArrayOf(String), func() {
+				Description("Your secrets injected into the container, specified as <secret>(as the env var of the same name), <secret>:<env var> or <secret>:<absolute file path>")
+			})
+			Param("kind", String, func() {
+				Description("web is served on the subdomain. worker has no endpoint. private is not exposed publicly and reachable from other containers by <name>.<uid>")
+				Enum("web", "worker", "private")
+				Default("web")
+			})
+			Param("ports", ArrayOf(String), func() {
+				Description("Additional ports exposed on their own subdomains(<port name>.<container name>.<addr>), specified as name:port or name:port/protocol")
+			})
+			Param("replicas", Integer, func() {
+				Description("Number of containers run behind the subdomain")
+				Minimum(1)
+				Default(1)
+			})
+			Param("idleTimeout", Integer, func() {
+				Description("Seconds without incoming traffic until the container sleeps. A sleeping container is started by the next request. 0 disables sleeping")
+				Minimum(0)
+				Default(0)
+			})
+			Param("ttl", Integer, func() {
+				Description("Seconds until the container is removed")
+				Minimum(1)
+			})
+			Param("expiresAt", DateTime, "The time the container is removed at")
+			Param("cpu", Integer, func() {
+				Description("Percentage of a CPU the container can use. Defaults to the maximum set by the administrator")
+				Minimum(1)
+				Maximum(100)
+			})
+			Param("memory", String, "Memory the container can use such as 512M. Defaults to the maximum set by the administrator")
+			Param("storage", String, "Storage the container can use such as 10G. Defaults to the maximum set by the administrator")
+			Param("pids", Integer, func() {
+				Description("Maximum number of processes in the container. Defaults to the maximum set by the administrator")
+				Minimum(1)
+			})
+			Param("restartPolicy", String, func() {
+				Description("Policy to restart the container when it exits. Containers dying repeatedly shortly after starting are restarted with exponential backoff")
+				Enum("no", "on-failure", "always", "unless-stopped")
+				Default("no")
+			})
+			Param("restartMaxRetries", Integer, func() {
+				Description("Maximum number of restarts with on-failure. 0 means unlimited")
+				Minimum(0)
+				Default(0)
+			})
+			Param("metricsPort", Integer, func() {
+				Description("Port the container serves Prometheus metrics on. The container is listed in the service discovery for Prometheus")
+				Minimum(1)
+				Maximum(65535)
+			})
+			Param("metricsPath", String, func() {
+				Description("Path of the Prometheus metrics. Defaults to /metrics")
+				Pattern(`^/\S*$`)
+			})
+			Param("healthCheckPath", String, func() {
+				Description("Path requested over HTTP on the port to check the health. The image needs curl or wget")
+				Pattern(`^/[^'\s]*$`)
+			})
+			Param("healthCheckCommand", ArrayOf(String), "Command run in the container to check the health. Healthy if it exits with 0")
+			Param("healthCheckInterval", Integer, func() {
+				Description("Seconds between health checks")
+				Minimum(1)
+				Default(30)
+			})
+			Param("healthCheckTimeout", Integer, func() {
+				Description("Seconds to wait for a health check to finish")
+				Minimum(1)
+				Default(10)
+			})
+			Param("healthCheckRetries", Integer, func() {
+				Description("Consecutive failures to become unhealthy")
+				Minimum(1)
+				Default(3)
+			})
+			Param("healthCheckStartPeriod", Integer, func() {
+				Description("Seconds for the container to initialize, in which failures are not counted")
+				Minimum(0)
+				Default(0)
+			})
 
 			Required("name", "image")
 		})
@@ -172,6 +654,7 @@ var _ = Resource("container", func() { // Defines the Operands resource
 			Media(ContainerCreateOK)
 		})
 		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
 		Response("Conflict", func() {
 			Status(409)
 			Media(ErrorMedia)
@@ -197,6 +680,11 @@ var _ = Resource("container", func() { // Defines the Operands resource
 		Description("stop a container")
 		Params(func() {
 			Param("id", String, "id or name")
+			Param("timeout", Integer, func() {
+				Description("Seconds to wait before killing the container")
+				Default(15)
+				Minimum(0)
+			})
 
 			Required("id")
 		})
@@ -205,124 +693,440 @@ var _ = Resource("container", func() { // Defines the Operands resource
 		Response(InternalServerError, ErrorMedia)
 	})
 
-	Action("remove", func() {
-		Routing(GET("/:id/remove"))
-		Description("remove a container")
+	Action("restart", func() {
+		Routing(GET("/:id/restart"))
+		Description("restart a container")
 		Params(func() {
 			Param("id", String, "id or name")
-			Param("force", Boolean, func() {
-				Default(false)
-				Description("If the container is running, kill it before removing it.")
+			Param("timeout", Integer, func() {
+				Description("Seconds to wait before killing the container")
+				Default(15)
+				Minimum(0)
 			})
 
-			Required("id", "force")
+			Required("id")
 		})
 		Response(NoContent)
 		Response(NotFound)
-		Response("RunningContainer", func() {
-			Status(409)
-			Description("You cannot remove a running container")
-		})
 		Response(InternalServerError, ErrorMedia)
 	})
 
-	Action("inspect", func() {
-		Routing(GET("/:id/inspect"))
-		Description("Return details of a container")
-
+	Action("scale", func() {
+		Routing(GET("/:id/scale"))
+		Description("Change the number of replicas of a container. Requests are balanced among the replicas, which share the volumes")
 		Params(func() {
-			Param("id", String, "ID or name")
+			Param("id", String, "id or name")
+			Param("replicas", Integer, func() {
+				Description("Number of replicas")
+				Minimum(1)
+			})
+			Param("timeout", Integer, func() {
+				Description("Seconds to wait before killing the removed replicas")
+				Default(15)
+				Minimum(0)
+			})
 
-			Required("id")
+			Required("id", "replicas")
 		})
-
-		Response(OK, ContainerInspectMedia)
+		Response(NoContent)
+		Response(Forbidden, ErrorMedia)
 		Response(NotFound)
 		Response(InternalServerError, ErrorMedia)
 	})
-	Action("list", func() {
-		Routing(GET("/list"))
-		Description("Return a list of containers")
-
-		Response(OK, CollectionOf(ContainerListEachMedia))
-		Response(InternalServerError, ErrorMedia)
-	})
-	Action("upload", func() {
-		Routing(POST("/:id/upload"))
-		Description("Copy files to the container")
-		MultipartForm()
-		Payload(UploadPayload)
 
+	Action("redeploy", func() {
+		Routing(GET("/:id/redeploy"))
+		Description("Recreate a container with a new image or configuration keeping its id, name and volumes. Omitted parameters are taken over from the current container")
 		Params(func() {
-			Param("id", String, "ID or name")
+			Param("id", String, "id or name")
+			Param("image", String, "Name of image. The current image is pulled again if omitted")
+			Param("command", ArrayOf(String), "Command to run specified as a string or an array of strings.")
+			Param("entrypoint", ArrayOf(String), "The entry point for the container as a string or an array of strings")
+			Param("env", ArrayOf(String), "Environment variables")
+			Param("workingDir", String, "Current directory (PWD) in the command will be launched")
+			Param("timeout", Integer, func() {
+				Description("Seconds to wait before killing the current container")
+				Default(15)
+				Minimum(0)
+			})
+			Param("strategy", String, func() {
+				Description("recreate: stop the current container and start the new one, blueGreen: switch to the new container after it becomes healthy without downtime")
+				Enum("recreate", "blueGreen")
+				Default("recreate")
+			})
+			Param("healthTimeout", Integer, func() {
+				Description("Seconds to wait for the new container to become healthy in blueGreen. It is rolled back on timeout")
+				Default(60)
+				Minimum(1)
+			})
+			Param("drainPeriod", Integer, func() {
+				Description("Seconds to keep the current container serving in-flight requests after switching in blueGreen")
+				Default(10)
+				Minimum(0)
+			})
 
 			Required("id")
 		})
-
-		Response(NoContent)
-		Response(NotFound, ErrorMedia)
-		Response(BadRequest, ErrorMedia)
-		Response(RequestEntityTooLarge)
+		Response(Accepted)
+		Response(NotFound)
 		Response(InternalServerError, ErrorMedia)
 	})
 
-	Action("download", func() {
-		Routing(GET("/:id/download"), HEAD("/download"))
-		Description("Copy files from the container")
+	Action("releases", func() {
+		Routing(GET("/:id/releases"))
+		Description("Return the release history of a container, newest first")
 		Params(func() {
-			Param("id", String, "ID or name")
-			Param("internalPath", String, "Path in the container to save files")
+			Param("id", String, "id or name")
 
-			Required("id", "internalPath")
+			Required("id")
 		})
-
-		Response(OK, "application/octet-stream")
-		Response(NotFound, ErrorMedia)
+		Response(OK, CollectionOf(ContainerReleaseMedia))
+		Response(NotFound)
 		Response(InternalServerError, ErrorMedia)
 	})
 
-	Action("logs", func() { // WebSocket API
-		Routing(GET("/:id/logs"))
-		Scheme("ws")
-		Description("Get stdout and stderr logs from a container.")
-
+	Action("rollback", func() {
+		Routing(GET("/:id/rollback"))
+		Description("Recreate a container from a prior release")
 		Params(func() {
 			Param("id", String, "id or name")
-
-			Param("follow", Boolean, func() {
-				Default(false)
-			})
-			Param("stdout", Boolean, func() {
-				Default(false)
+			Param("release", Integer, "Release number to roll back to. Defaults to the previous release")
+			Param("timeout", Integer, func() {
+				Description("Seconds to wait before killing the current container")
+				Default(15)
+				Minimum(0)
 			})
-			Param("stderr", Boolean, func() {
-				Default(false)
+			Param("strategy", String, func() {
+				Description("recreate: stop the current container and start the new one, blueGreen: switch to the new container after it becomes healthy without downtime")
+				Enum("recreate", "blueGreen")
+				Default("recreate")
 			})
-			Param("since", DateTime)
-			Param("until", DateTime)
-			Param("timestamps", Boolean, func() {
-				Default(false)
+			Param("healthTimeout", Integer, func() {
+				Description("Seconds to wait for the new container to become healthy in blueGreen. It is rolled back on timeout")
+				Default(60)
+				Minimum(1)
 			})
-			Param("tail", String, func() {
-				Default("all")
+			Param("drainPeriod", Integer, func() {
+				Description("Seconds to keep the current container serving in-flight requests after switching in blueGreen")
+				Default(10)
+				Minimum(0)
 			})
 
 			Required("id")
 		})
-
-		Response(SwitchingProtocols)
-		Response(NotFound, ErrorMedia)
+		Response(Accepted)
+		Response(NotFound)
+		Response(BadRequest, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
 
-	Action("getConfig", func() {
-		Routing(GET("/:id/config"))
-		Description("Get the config of a container")
-
+	Action("getEnv", func() {
+		Routing(GET("/:id/env"))
+		Description("Return environment variables of a container")
 		Params(func() {
 			Param("id", String, "id or name")
+
+			Required("id")
 		})
-		Response(OK, ContainerConfigOK)
+		Response(OK, CollectionOf(ContainerEnvMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("setEnv", func() {
+		Routing(PUT("/:id/env"))
+		Description("Add or update environment variables of a container. The container is recreated to apply them")
+		Payload(ArrayOf(ContainerEnvType))
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("deferred", Boolean, func() {
+				Description("Apply the changes at the next restart instead of recreating the container now")
+				Default(false)
+			})
+
+			Required("id")
+		})
+		Response(Accepted)
+		Response(NoContent)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("removeEnv", func() {
+		Routing(DELETE("/:id/env"))
+		Description("Remove environment variables from a container. The container is recreated to apply them")
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("name", ArrayOf(String), "Names of environment variables")
+			Param("deferred", Boolean, func() {
+				Description("Apply the changes at the next restart instead of recreating the container now")
+				Default(false)
+			})
+
+			Required("id", "name")
+		})
+		Response(Accepted)
+		Response(NoContent)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("listSecrets", func() {
+		Routing(GET("/:id/secrets"))
+		Description("Return secrets injected into a container")
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+		Response(OK, CollectionOf(ContainerSecretMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("addSecret", func() {
+		Routing(PUT("/:id/secrets"))
+		Description("Inject your secret into a container as an environment variable or a file. The container is recreated to apply it")
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("name", String, "Name of the secret")
+			Param("env", String, func() {
+				Description("Environment variable to inject the secret as")
+				Pattern("^[a-zA-Z_][a-zA-Z0-9_]*$")
+			})
+			Param("file", String, func() {
+				Description("Absolute path of the file to copy the secret to")
+				Pattern("^/.+$")
+			})
+			Param("deferred", Boolean, func() {
+				Description("Apply the changes at the next restart instead of recreating the container now")
+				Default(false)
+			})
+
+			Required("id", "name")
+		})
+		Response(Accepted)
+		Response(NoContent)
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("removeSecret", func() {
+		Routing(DELETE("/:id/secrets"))
+		Description("Stop injecting a secret into a container. The container is recreated to apply it")
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("name", String, "Name of the secret")
+			Param("deferred", Boolean, func() {
+				Description("Apply the changes at the next restart instead of recreating the container now")
+				Default(false)
+			})
+
+			Required("id", "name")
+		})
+		Response(Accepted)
+		Response(NoContent)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("pause", func() {
+		Routing(GET("/:id/pause"))
+		Description("pause all processes in a container")
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+		Response(NoContent)
+		Response(NotFound)
+		Response("NotRunning", func() {
+			Status(409)
+			Description("You cannot pause a container which is not running")
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("unpause", func() {
+		Routing(GET("/:id/unpause"))
+		Description("unpause all processes in a container")
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+		Response(NoContent)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("remove", func() {
+		Routing(GET("/:id/remove"))
+		Description("remove a container")
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("force", Boolean, func() {
+				Default(false)
+				Description("If the container is running, kill it before removing it.")
+			})
+
+			Required("id", "force")
+		})
+		Response(NoContent)
+		Response(NotFound)
+		Response("RunningContainer", func() {
+			Status(409)
+			Description("You cannot remove a running container")
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("inspect", func() {
+		Routing(GET("/:id/inspect"))
+		Description("Return details of a container")
+
+		Params(func() {
+			Param("id", String, "ID or name")
+
+			Required("id")
+		})
+
+		Response(OK, ContainerInspectMedia)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+	Action("list", func() {
+		Routing(GET("/list"))
+		Description("Return a list of containers")
+
+		Response(OK, CollectionOf(ContainerListEachMedia))
+		Response(InternalServerError, ErrorMedia)
+	})
+	Action("upload", func() {
+		Routing(POST("/:id/upload"))
+		Description("Copy files to the container")
+		MultipartForm()
+		Payload(UploadPayload)
+
+		Params(func() {
+			Param("id", String, "ID or name")
+
+			Required("id")
+		})
+
+		Response(NoContent)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(RequestEntityTooLarge)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("download", func() {
+		Routing(GET("/:id/download"), HEAD("/download"))
+		Description("Copy files from the container")
+		Params(func() {
+			Param("id", String, "ID or name")
+			Param("internalPath", String, "Path in the container to save files")
+
+			Required("id", "internalPath")
+		})
+
+		Response(OK, "application/octet-stream")
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("logs", func() { // WebSocket API
+		Routing(GET("/:id/logs"))
+		Scheme("ws")
+		Description("Get stdout and stderr logs from a container. Lines are prefixed with [replica <n>] if the container has multiple replicas")
+
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Param("follow", Boolean, func() {
+				Default(false)
+			})
+			Param("stdout", Boolean, func() {
+				Default(false)
+			})
+			Param("stderr", Boolean, func() {
+				Default(false)
+			})
+			Param("since", DateTime)
+			Param("until", DateTime)
+			Param("timestamps", Boolean, func() {
+				Default(false)
+			})
+			Param("tail", String, func() {
+				Default("all")
+			})
+
+			Required("id")
+		})
+
+		Response(SwitchingProtocols)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("stats", func() {
+		Routing(GET("/:id/stats"))
+		Description("Return the current resource usage of each replica of a container")
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+		Response(OK, CollectionOf(ContainerStatsMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("statsStream", func() { // WebSocket API
+		Routing(GET("/:id/stats/stream"))
+		Scheme("ws")
+		Description("Stream the resource usage of each replica of a container. Every message is a JSON of vpn.application/goa.container.stats+json sent about every second per replica")
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+		Response(SwitchingProtocols)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("metrics", func() {
+		Routing(GET("/:id/metrics"))
+		Description("Return the history of the resource usage of a container. Samples are taken every minute and rolled up into hours after a day")
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("from", DateTime, "The start of the series. Defaults to an hour before to")
+			Param("to", DateTime, "The end of the series. Defaults to now")
+			Param("step", Integer, func() {
+				Description("Seconds between the points")
+				Minimum(60)
+				Default(60)
+			})
+
+			Required("id")
+		})
+		Response(OK, ContainerMetricsMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("getConfig", func() {
+		Routing(GET("/:id/config"))
+		Description("Get the config of a container")
+
+		Params(func() {
+			Param("id", String, "id or name")
+		})
+		Response(OK, ContainerConfigOK)
 		Response(NotFound)
 		Response(InternalServerError, ErrorMedia)
 	})
@@ -339,10 +1143,36 @@ var _ = Resource("container", func() { // Defines the Operands resource
 		})
 
 		Response(NoContent)
+		Response(BadRequest, ErrorMedia)
 		Response(NotFound)
 		Response(InternalServerError, ErrorMedia)
 	})
 
+	Action("run", func() {
+		Routing(POST("/run"))
+		Description("Run a command in a one-off container without a public endpoint and return the result after it exits. The container is removed afterwards")
+
+		Params(runParams)
+
+		Response(OK, ContainerRunOK)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("runStream", func() { // WebSocket API
+		Routing(GET("/run/stream"))
+		Scheme("ws")
+		Description("Run a command in a one-off container streaming stdout and stderr as binary messages. The result is sent as a JSON text message(vpn.application/goa.container.run.result+json without output) at the end")
+
+		Params(runParams)
+
+		Response(SwitchingProtocols)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
 	Action("exec", func() { // WebSocket API
 		Routing(GET("/:id/exec"))
 		Scheme("ws")
@@ -360,6 +1190,299 @@ var _ = Resource("container", func() { // Defines the Operands resource
 		Response(NotFound, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
+
+	Action("listDomains", func() {
+		Routing(GET("/:id/domains"))
+		Description("Return custom domains of a container")
+
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+
+		Response(OK, CollectionOf(ContainerDomainMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("addDomain", func() {
+		Routing(PUT("/:id/domains"))
+		Description("Claim a custom domain for a container. Requests for the domain are routed to the container after the ownership is verified")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("domain", String, func() {
+				Description("Domain name")
+				Pattern("^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\\.)+[a-zA-Z]{2,63}$")
+				MaxLength(253)
+			})
+
+			Required("id", "domain")
+		})
+
+		Response(OK, ContainerDomainMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound)
+		Response("Conflict", func() {
+			Status(409)
+			Media(ErrorMedia)
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("verifyDomain", func() {
+		Routing(POST("/:id/domains/verify"))
+		Description("Verify the ownership of a custom domain with the TXT record or the HTTP token")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("domain", String, "Domain name")
+
+			Required("id", "domain")
+		})
+
+		Response(OK, ContainerDomainMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound)
+		Response("Conflict", func() {
+			Status(409)
+			Media(ErrorMedia)
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("removeDomain", func() {
+		Routing(DELETE("/:id/domains"))
+		Description("Remove a custom domain from a container")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("domain", String, "Domain name")
+
+			Required("id", "domain")
+		})
+
+		Response(NoContent)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("listRoutes", func() {
+		Routing(GET("/:id/routes"))
+		Description("Return path-prefix routes to a container")
+
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+
+		Response(OK, CollectionOf(ContainerRouteMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("addRoute", func() {
+		Routing(PUT("/:id/routes"))
+		Description("Route requests for the path prefix on the host to a container. The host must be a subdomain of your container or a verified custom domain")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("host", String, func() {
+				Description("Host name")
+				Pattern("^([a-zA-Z0-9]([a-zA-Z0-9_-]{0,62}[a-zA-Z0-9])?\\.)+[a-zA-Z]{2,63}$")
+				MaxLength(253)
+			})
+			Param("pathPrefix", String, func() {
+				Description("Path prefix")
+				Pattern("^/[a-zA-Z0-9._~/-]*$")
+				MaxLength(255)
+			})
+			Param("stripPrefix", Boolean, func() {
+				Description("Strip the prefix before forwarding requests")
+				Default(false)
+			})
+			Param("priority", Integer, func() {
+				Description("Priority of the route. Routes with higher priority are matched first")
+				Default(0)
+				Minimum(0)
+				Maximum(1000)
+			})
+
+			Required("id", "host", "pathPrefix")
+		})
+
+		Response(OK, ContainerRouteMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound)
+		Response("Conflict", func() {
+			Status(409)
+			Media(ErrorMedia)
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("removeRoute", func() {
+		Routing(DELETE("/:id/routes"))
+		Description("Remove a path-prefix route from a container")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("route", Integer, "Route ID")
+
+			Required("id", "route")
+		})
+
+		Response(NoContent)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("listSchedules", func() {
+		Routing(GET("/:id/schedules"))
+		Description("Return schedules starting or stopping a container")
+
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+
+		Response(OK, CollectionOf(ContainerScheduleMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("addSchedule", func() {
+		Routing(PUT("/:id/schedules"))
+		Description("Start or stop a container on a schedule. Specify action and schedule, or window which adds a pair of schedules starting and stopping it")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("action", String, func() {
+				Description("Action taken on the schedule")
+				Enum("start", "stop")
+			})
+			Param("schedule", String, "Cron expression(minute hour day month weekday) or a descriptor such as @daily")
+			Param("window", String, func() {
+				Description("Days and time the container runs in, such as mon-fri 09:00-18:00. Days are in the weekday format of cron and can be omitted for every day")
+			})
+			Param("timezone", String, func() {
+				Description("IANA time zone the schedule is in, such as Asia/Tokyo")
+				Default("UTC")
+			})
+
+			Required("id")
+		})
+
+		Response(OK, CollectionOf(ContainerScheduleMedia))
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("removeSchedule", func() {
+		Routing(DELETE("/:id/schedules"))
+		Description("Remove a schedule from a container")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("schedule", Integer, "Schedule ID")
+
+			Required("id", "schedule")
+		})
+
+		Response(NoContent)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("scheduleLogs", func() {
+		Routing(GET("/:id/schedules/logs"))
+		Description("Return the actions taken by the schedules of a container, newest first")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("limit", Integer, func() {
+				Description("Maximum number of logs")
+				Minimum(1)
+				Default(50)
+			})
+
+			Required("id")
+		})
+
+		Response(OK, CollectionOf(ContainerScheduleLogMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("extend", func() {
+		Routing(GET("/:id/extend"))
+		Description("Change the time the container is removed at. The container is kept if neither ttl nor expiresAt is specified")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("ttl", Integer, func() {
+				Description("Seconds from now until the container is removed")
+				Minimum(1)
+			})
+			Param("expiresAt", DateTime, "The time the container is removed at")
+
+			Required("id")
+		})
+
+		Response(NoContent)
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("events", func() {
+		Routing(GET("/:id/events"))
+		Description("Return events of a container, newest first")
+
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("limit", Integer, func() {
+				Description("Maximum number of events")
+				Minimum(1)
+				Default(50)
+			})
+
+			Required("id")
+		})
+
+		Response(OK, CollectionOf(ContainerEventMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("setLimits", func() {
+		Routing(PUT("/:id/limits"))
+		Description("Update the resource limits of a container without restarting it. Limits not specified are left unchanged and 0 removes them up to the maximum set by the administrator. The storage can not be changed")
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("cpu", Integer, func() {
+				Description("Percentage of a CPU the container can use")
+				Minimum(0)
+				Maximum(100)
+			})
+			Param("memory", String, "Memory the container can use such as 512M")
+			Param("pids", Integer, func() {
+				Description("Maximum number of processes in the container")
+				Minimum(0)
+			})
+
+			Required("id")
+		})
+		Response(OK, ContainerLimitsMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
 })
 
 var UploadPayload = Type("UploadPayload", func() {
@@ -0,0 +1,164 @@
+package api
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var JobMedia = MediaType("vpn.application/goa.job+json", func() {
+	Description("A job run on a cron schedule")
+	Attributes(func() {
+		Attribute("id", Integer, "ID")
+		Attribute("name", String, "Name of the job")
+		Attribute("schedule", String, "Cron expression(minute hour day month weekday)")
+		Attribute("container", Integer, "ID of the container the command is executed in")
+		Attribute("image", String, "Image run as a one-off container")
+		Attribute("command", ArrayOf(String), "Command to run")
+		Attribute("env", ArrayOf(String), "Environment variables")
+		Attribute("nextRunAt", DateTime, "The time the job runs next")
+
+		Required("id", "name", "schedule", "command", "env", "nextRunAt")
+	})
+
+	View("default", func() {
+		Attribute("id")
+		Attribute("name")
+		Attribute("schedule")
+		Attribute("container")
+		Attribute("image")
+		Attribute("command")
+		Attribute("env")
+		Attribute("nextRunAt")
+	})
+})
+
+var JobRunMedia = MediaType("vpn.application/goa.job.run+json", func() {
+	Description("A run of a job")
+	Attributes(func() {
+		Attribute("id", Integer, "ID")
+		Attribute("trigger", String, func() {
+			Description("What started the run")
+			Enum("schedule", "manual")
+		})
+		Attribute("status", String, func() {
+			Enum("Running", "Succeeded", "Failed", "Error")
+		})
+		Attribute("startedAt", DateTime, "The time the run started")
+		Attribute("finishedAt", DateTime, "The time the run finished")
+		Attribute("exitCode", Integer, "Exit code of the command")
+		Attribute("output", String, "Stdout and stderr of the command. Only the end is kept if it is long")
+		Attribute("message", String, "Error message if the command could not be run")
+
+		Required("id", "trigger", "status", "startedAt", "output")
+	})
+
+	View("default", func() {
+		Attribute("id")
+		Attribute("trigger")
+		Attribute("status")
+		Attribute("startedAt")
+		Attribute("finishedAt")
+		Attribute("exitCode")
+		Attribute("output")
+		Attribute("message")
+	})
+})
+
+var _ = Resource("job", func() {
+	Security(JWT)
+	BasePath("/job")
+
+	Action("create", func() {
+		Routing(GET("/create"))
+		Description("Create a job which executes the command in the container, or runs the image as a one-off container on the schedule")
+		Params(func() {
+			Param("name", String, func() {
+				Description("Name of the job")
+				Pattern("^[a-zA-Z0-9_]+$")
+				MaxLength(64)
+				MinLength(1)
+			})
+			Param("schedule", String, func() {
+				Description("Cron expression(minute hour day month weekday) or a descriptor such as @daily")
+			})
+			Param("container", String, "id or name of the container the command is executed in")
+			Param("image", String, "Name of the image run as a one-off container")
+			Param("command", ArrayOf(String), "Command to run. Required with container")
+			Param("env", ArrayOf(String), "Environment variables")
+
+			Required("name", "schedule")
+		})
+		Response(OK, JobMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound)
+		Response("Conflict", func() {
+			Status(409)
+			Media(ErrorMedia)
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("list", func() {
+		Routing(GET("/list"))
+		Description("Return jobs")
+		Response(OK, CollectionOf(JobMedia))
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("inspect", func() {
+		Routing(GET("/:id/inspect"))
+		Description("Return the job")
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+		Response(OK, JobMedia)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("remove", func() {
+		Routing(GET("/:id/remove"))
+		Description("Remove the job and its history")
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+		Response(NoContent)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("run", func() {
+		Routing(GET("/:id/run"))
+		Description("Run the job now in the background")
+		Params(func() {
+			Param("id", String, "id or name")
+
+			Required("id")
+		})
+		Response(Accepted)
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("runs", func() {
+		Routing(GET("/:id/runs"))
+		Description("Return the run history of the job, newest first")
+		Params(func() {
+			Param("id", String, "id or name")
+			Param("limit", Integer, func() {
+				Description("Maximum number of runs")
+				Minimum(1)
+				Default(20)
+			})
+
+			Required("id")
+		})
+		Response(OK, CollectionOf(JobRunMedia))
+		Response(NotFound)
+		Response(InternalServerError, ErrorMedia)
+	})
+})
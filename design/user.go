@@ -63,6 +63,82 @@ var UserDefaultShellOK = MediaType("vpn.application/goa.user.defaultShell+json",
 	})
 })
 
+var UserSecretType = Type("UserSecret", func() {
+	Attribute("name", String, func() {
+		Description("Name of the secret")
+		Pattern("^[a-zA-Z0-9_.-]+$")
+		MaxLength(64)
+		MinLength(1)
+	})
+	Attribute("value", String, func() {
+		Description("Value of the secret")
+		MaxLength(65536)
+	})
+
+	Required("name", "value")
+})
+
+var UserSecretOK = MediaType("vpn.application/goa.user.secret+json", func() {
+	Description("A secret stored encrypted. The value is never returned")
+	Attributes(func() {
+		Attribute("name", String, "Name of the secret")
+		Attribute("updatedAt", DateTime, "The time the secret was updated")
+
+		Required("name", "updatedAt")
+	})
+
+	View("default", func() {
+		Attribute("name")
+		Attribute("updatedAt")
+	})
+})
+
+var UserQuotaResourcesMedia = MediaType("vpn.application/goa.user.quota.resources+json", func() {
+	Attributes(func() {
+		Attribute("containers", Integer, "Number of containers")
+		Attribute("cpu", Integer, "Sum of the percentages of a CPU of the containers and their replicas. Containers without a cpu limit count as 100")
+		Attribute("memory", String, "Sum of the memory limits of the containers and their replicas")
+		Attribute("storage", String, "Sum of the storage limits of the containers and their replicas")
+		Attribute("volumes", Integer, "Number of volumes")
+	})
+
+	View("default", func() {
+		Attribute("containers")
+		Attribute("cpu")
+		Attribute("memory")
+		Attribute("storage")
+		Attribute("volumes")
+	})
+})
+
+var UserQuotaType = Type("UserQuota", func() {
+	Attribute("containers", Integer, "Number of containers", func() {
+		Minimum(0)
+	})
+	Attribute("cpu", Integer, "Sum of the percentages of a CPU of the containers and their replicas", func() {
+		Minimum(0)
+	})
+	Attribute("memory", String, "Sum of the memory limits of the containers and their replicas such as 4G")
+	Attribute("storage", String, "Sum of the storage limits of the containers and their replicas such as 100G")
+	Attribute("volumes", Integer, "Number of volumes", func() {
+		Minimum(0)
+	})
+})
+
+var UserQuotaOK = MediaType("vpn.application/goa.user.quota+json", func() {
+	Attributes(func() {
+		Attribute("limits", UserQuotaResourcesMedia, "Quota of the user. Missing ones are unlimited")
+		Attribute("usage", UserQuotaResourcesMedia, "Resources used by the user")
+
+		Required("limits", "usage")
+	})
+
+	View("default", func() {
+		Attribute("limits")
+		Attribute("usage")
+	})
+})
+
 var _ = Resource("user", func() {
 	Security(JWT)
 	BasePath("/user")
@@ -131,4 +207,62 @@ var _ = Resource("user", func() {
 		Response(NotFound)
 		Response(InternalServerError, ErrorMedia)
 	})
+
+	Action("listSecrets", func() {
+		Routing(GET("/secrets"))
+		Description("Return names of your secrets")
+
+		Response(OK, CollectionOf(UserSecretOK))
+		Response(InternalServerError, ErrorMedia)
+	})
+	Action("setSecret", func() {
+		Routing(PUT("/secrets"))
+		Description("Add or update a secret. Containers using it get the new value when they are recreated")
+
+		Payload(UserSecretType)
+
+		Response(NoContent)
+		Response(InternalServerError, ErrorMedia)
+	})
+	Action("removeSecret", func() {
+		Routing(DELETE("/secrets"))
+		Description("Remove a secret which is not used by any container")
+
+		Params(func() {
+			Param("name", String)
+
+			Required("name")
+		})
+
+		Response(NoContent)
+		Response(NotFound)
+		Response("Conflict", func() {
+			Status(409)
+			Media(ErrorMedia)
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+	Action("getQuota", func() {
+		Routing(GET("/quota"))
+		Description("Return the quota and the current usage")
+
+		Response(OK, UserQuotaOK)
+		Response(InternalServerError, ErrorMedia)
+	})
+	Action("setQuota", func() {
+		Routing(PUT("/quota/:uid"))
+		Description("Override the default quota of a user. Missing ones use the defaults and 0 is unlimited. Only the administrators listed in modoki/admins on consul can set quotas")
+
+		Params(func() {
+			Param("uid", String, "ID of the user")
+
+			Required("uid")
+		})
+		Payload(UserQuotaType)
+
+		Response(NoContent)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
 })
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/goadesign/goa"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+)
+
+// JobController implements the job resource.
+type JobController struct {
+	*goa.Controller
+	*ContainerControllerUtil
+}
+
+// NewJobController creates a job controller.
+func NewJobController(service *goa.Service) *JobController {
+	return &JobController{Controller: service.NewController("JobController")}
+}
+
+// Create runs the create action.
+func (c *JobController) Create(ctx *app.CreateJobContext) error {
+	// JobController_Create: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	schedule, err := cron.ParseStandard(ctx.Schedule)
+
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.Wrap(err, "Invalid schedule")))
+	}
+
+	if (ctx.Container == nil) == (ctx.Image == nil) {
+		return ctx.BadRequest(goa.ErrBadRequest(errors.New("Either container or image must be specified")))
+	}
+
+	j := &job{
+		UID:       uid,
+		Name:      ctx.Name,
+		Schedule:  ctx.Schedule,
+		Command:   stringList(ctx.Command),
+		Env:       stringList(ctx.Env),
+		NextRunAt: schedule.Next(time.Now()),
+	}
+
+	if ctx.Container != nil {
+		if len(ctx.Command) == 0 {
+			return ctx.BadRequest(goa.ErrBadRequest(errors.New("command is required to execute in the container")))
+		}
+
+		id, _, err := c.lookupContainer(ctx, uid, *ctx.Container)
+
+		if err == sql.ErrNoRows {
+			return ctx.NotFound()
+		} else if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+		}
+
+		j.ContainerID = sql.NullInt64{Int64: int64(id), Valid: true}
+	} else {
+		j.Image = sql.NullString{String: *ctx.Image, Valid: true}
+	}
+
+	res, err := c.DB.ExecContext(ctx, "INSERT INTO jobs (uid, name, schedule, containerID, image, command, env, nextRunAt) VALUES (?, ?, ?, ?, ?, ?, ?, ?)", j.UID, j.Name, j.Schedule, j.ContainerID, j.Image, j.Command, j.Env, j.NextRunAt)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "Duplicate") {
+			return ctx.Conflict(goa.ErrInvalidRequest(errors.New("The name is already used by another job")))
+		}
+
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	j.ID = int(id)
+
+	return ctx.OK(j.media())
+
+	// JobController_Create: end_implement
+}
+
+// Inspect runs the inspect action.
+func (c *JobController) Inspect(ctx *app.InspectJobContext) error {
+	// JobController_Inspect: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	j, err := c.lookupJob(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	return ctx.OK(j.media())
+
+	// JobController_Inspect: end_implement
+}
+
+// List runs the list action.
+func (c *JobController) List(ctx *app.ListJobContext) error {
+	// JobController_List: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var jobs []*job
+	if err := c.DB.SelectContext(ctx, &jobs, "SELECT "+jobColumns+" FROM jobs WHERE uid=? ORDER BY id", uid); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	res := make(app.GoaJobCollection, len(jobs))
+	for i := range jobs {
+		res[i] = jobs[i].media()
+	}
+
+	return ctx.OK(res)
+
+	// JobController_List: end_implement
+}
+
+// Remove runs the remove action.
+func (c *JobController) Remove(ctx *app.RemoveJobContext) error {
+	// JobController_Remove: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	j, err := c.lookupJob(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	if _, err := c.DB.ExecContext(ctx, "DELETE FROM jobs WHERE id=?", j.ID); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Deletion From Database Error")))
+	}
+
+	if _, err := c.DB.ExecContext(ctx, "DELETE FROM jobRuns WHERE jobID=?", j.ID); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Deletion From Database Error")))
+	}
+
+	return ctx.NoContent()
+
+	// JobController_Remove: end_implement
+}
+
+// Run runs the run action.
+func (c *JobController) Run(ctx *app.RunJobContext) error {
+	// JobController_Run: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	j, err := c.lookupJob(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	go func() {
+		if err := c.runJob(context.Background(), j, jobTriggerManual); err != nil {
+			log.Printf("Running the job %d error: %v", j.ID, err)
+		}
+	}()
+
+	return ctx.Accepted()
+
+	// JobController_Run: end_implement
+}
+
+// Runs runs the runs action.
+func (c *JobController) Runs(ctx *app.RunsJobContext) error {
+	// JobController_Runs: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	j, err := c.lookupJob(ctx, uid, ctx.ID)
+
+	if err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	var runs []*jobRun
+	err = c.DB.SelectContext(ctx, &runs, "SELECT id, `trigger`, status, startedAt, finishedAt, exitCode, output, message FROM jobRuns WHERE jobID=? ORDER BY id DESC LIMIT ?", j.ID, ctx.Limit)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	res := make(app.GoaJobRunCollection, len(runs))
+	for i := range runs {
+		res[i] = runs[i].media()
+	}
+
+	return ctx.OK(res)
+
+	// JobController_Runs: end_implement
+}
@@ -6,6 +6,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/modoki-paas/modoki/app"
 	"github.com/modoki-paas/modoki/consul_traefik"
@@ -14,6 +17,7 @@ import (
 	"github.com/goadesign/goa"
 	"github.com/goadesign/goa/middleware"
 	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 var (
@@ -24,9 +28,14 @@ var (
 	sqlHost          = flag.String("db", "root:password@tcp(localhost:3306)/modoki?charset=utf8mb4&parseTime=True", "SQL")
 	consulHost       = flag.String("consul", "localhost:8500", "Consul(KV)")
 	traefikAddr      = flag.String("traefikAddr", "http://modoki", "Address to register on traefik")
+	wakeAddr         = flag.String("wakeAddr", "http://modoki:8080", "Address of the endpoint waking sleeping containers to register on traefik")
 	publicAddr       = flag.String("addr", "modoki.example.com", "API ep: modoki.example.com Service ep: *.modoki.example.com")
 	networkName      = flag.String("net", "", "network for containers to join")
 	https            = flag.Bool("https", true, "Enable HTTPS")
+	secretKeyPath    = flag.String("secretKey", "/usr/local/modoki/auth/secret.key", "Path to the key(32 bytes) to encrypt secrets with")
+	dnsResolver      = flag.String("resolver", "", "DNS server(host:port) to verify custom domains with. The system resolver is used if empty")
+	internalAddr     = flag.String("internalAddr", ":9090", "Address to serve the metrics and Prometheus service discovery on. It must not be exposed publicly")
+	metricsRetention = flag.Duration("metricsRetention", 30*24*time.Hour, "Period to keep the metrics of containers for")
 	help             = flag.Bool("help", false, "Show this")
 )
 
@@ -50,6 +59,18 @@ func main() {
 	db := dbInit()
 	consul := consulInit()
 
+	secretKey, err := loadSecretKey(*secretKeyPath)
+
+	if err != nil {
+		log.Println("warning: Secrets are disabled because the secret key cannot be loaded: ", err)
+	}
+
+	secrets, err := NewSecretStore(db, secretKey)
+
+	if err != nil {
+		log.Fatal("error: Failed to initialize the secret store: ", err)
+	}
+
 	defer finalize(consul)
 
 	// Create service
@@ -57,12 +78,15 @@ func main() {
 
 	app.UseJWTMiddleware(service, jwtMiddleware)
 
-	service.Use(middleware.RequestID())
-	service.Use(middleware.LogRequest(true))
-	service.Use(middleware.ErrorHandler(service, true))
-	service.Use(middleware.Recover())
+	useMiddleware(service)
 
-	dockerClient, err := client.NewClient(*docker, *dockerAPIVersion, nil, nil)
+	dockerHTTPClient, err := newDockerHTTPClient(*docker)
+
+	if err != nil {
+		log.Fatal("Docker client initialization error", err)
+	}
+
+	dockerClient, err := client.NewClient(*docker, *dockerAPIVersion, dockerHTTPClient, nil)
 
 	if err != nil {
 		log.Fatal("Docker client initialization error", err)
@@ -72,13 +96,42 @@ func main() {
 		DockerClient: dockerClient,
 		DB:           db,
 		Consul:       consul,
+		Secrets:      secrets,
 	}
 	userUtil := &UserControllerUtil{
 		DockerClient: dockerClient,
 		DB:           db,
 		Consul:       consul,
+		Secrets:      secrets,
 	}
 	go containerUtil.run(context.Background())
+	go containerUtil.loop(context.Background())
+	go containerUtil.collectMetrics(context.Background(), *metricsRetention)
+
+	wakeURL, err := url.Parse(*wakeAddr)
+
+	if err != nil {
+		log.Fatal("error: Invalid wake address: ", err)
+	}
+
+	wakePort := wakeURL.Port()
+
+	if wakePort == "" {
+		switch wakeURL.Scheme {
+		case "http":
+			wakePort = "80"
+		case "https":
+			wakePort = "443"
+		default:
+			log.Fatal("error: No port in the wake address: ", *wakeAddr)
+		}
+	}
+
+	go func() {
+		if err := http.ListenAndServe(":"+wakePort, &WakeHandler{ContainerControllerUtil: containerUtil}); err != nil {
+			log.Fatal("error: Wake endpoint error: ", err)
+		}
+	}()
 
 	// Mount "container" controller
 	c := NewContainerController(service)
@@ -87,6 +140,13 @@ func main() {
 
 	app.MountContainerController(service, c)
 
+	// Mount "job" controller
+	c4 := NewJobController(service)
+
+	c4.ContainerControllerUtil = containerUtil
+
+	app.MountJobController(service, c4)
+
 	// Mount "user" controller
 	c2 := NewUserController(service)
 
@@ -94,6 +154,20 @@ func main() {
 
 	app.MountUserController(service, c2)
 
+	// Serve metrics and Prometheus service discovery for the metrics of containers
+	// on the internal listener not to expose them publicly
+	registerMetrics(db)
+
+	internalMux := http.NewServeMux()
+	internalMux.Handle("/metrics", promhttp.Handler())
+	internalMux.Handle("/prometheus/targets", &PrometheusSDHandler{ContainerControllerUtil: containerUtil})
+
+	go func() {
+		if err := http.ListenAndServe(*internalAddr, internalMux); err != nil {
+			log.Fatal("error: Internal endpoint error: ", err)
+		}
+	}()
+
 	// Mount "swagger" controller
 	c3 := NewSwaggerController(service)
 
@@ -106,6 +180,16 @@ func main() {
 	}
 }
 
+// useMiddleware registers the middleware of the service.
+// Requests are not logged verbosely not to write secrets in the payloads to the log.
+func useMiddleware(service *goa.Service) {
+	service.Use(middleware.RequestID())
+	service.Use(middleware.LogRequest(false))
+	service.Use(middleware.ErrorHandler(service, true))
+	service.Use(metricsMiddleware)
+	service.Use(middleware.Recover())
+}
+
 func dbInit() *sqlx.DB {
 	db, err := sqlx.Connect(*sqlDriver, *sqlHost)
 
@@ -117,6 +201,74 @@ func dbInit() *sqlx.DB {
 		log.Fatal("error: Failed to create containers table: ", err)
 	}
 
+	if err := addColumns(db, "containers", containerColumns); err != nil {
+		log.Fatal("error: Failed to add columns to containers table: ", err)
+	}
+
+	if _, err := db.Exec(containerPortsSchema); err != nil {
+		log.Fatal("error: Failed to create containerPorts table: ", err)
+	}
+
+	if _, err := db.Exec(containerDomainsSchema); err != nil {
+		log.Fatal("error: Failed to create containerDomains table: ", err)
+	}
+
+	if _, err := db.Exec(containerRoutesSchema); err != nil {
+		log.Fatal("error: Failed to create containerRoutes table: ", err)
+	}
+
+	if _, err := db.Exec(containerReleasesSchema); err != nil {
+		log.Fatal("error: Failed to create containerReleases table: ", err)
+	}
+
+	if _, err := db.Exec(containerEnvSchema); err != nil {
+		log.Fatal("error: Failed to create containerEnv table: ", err)
+	}
+
+	if _, err := db.Exec(containerSecretsSchema); err != nil {
+		log.Fatal("error: Failed to create containerSecrets table: ", err)
+	}
+
+	if _, err := db.Exec(containerReplicasSchema); err != nil {
+		log.Fatal("error: Failed to create containerReplicas table: ", err)
+	}
+
+	if _, err := db.Exec(containerEventsSchema); err != nil {
+		log.Fatal("error: Failed to create containerEvents table: ", err)
+	}
+
+	if _, err := db.Exec(containerMetricsSchema); err != nil {
+		log.Fatal("error: Failed to create containerMetrics table: ", err)
+	}
+
+	if _, err := db.Exec(containerSchedulesSchema); err != nil {
+		log.Fatal("error: Failed to create containerSchedules table: ", err)
+	}
+
+	if _, err := db.Exec(containerScheduleLogsSchema); err != nil {
+		log.Fatal("error: Failed to create containerScheduleLogs table: ", err)
+	}
+
+	if _, err := db.Exec(jobsSchema); err != nil {
+		log.Fatal("error: Failed to create jobs table: ", err)
+	}
+
+	if _, err := db.Exec(jobRunsSchema); err != nil {
+		log.Fatal("error: Failed to create jobRuns table: ", err)
+	}
+
+	if _, err := db.Exec(oneOffContainersSchema); err != nil {
+		log.Fatal("error: Failed to create oneOffContainers table: ", err)
+	}
+
+	if _, err := db.Exec(userSecretsSchema); err != nil {
+		log.Fatal("error: Failed to create userSecrets table: ", err)
+	}
+
+	if _, err := db.Exec(userQuotasSchema); err != nil {
+		log.Fatal("error: Failed to create userQuotas table: ", err)
+	}
+
 	if _, err := db.Exec(authorizedKeysSchema); err != nil {
 		log.Fatal("error: Failed to create authorizedKeys schema: ", err)
 	}
@@ -124,6 +276,34 @@ func dbInit() *sqlx.DB {
 	return db
 }
 
+// tableColumn is a column added to a table after it was created
+type tableColumn struct {
+	name       string
+	definition string
+}
+
+// addColumns adds the columns missing in the table in order
+func addColumns(db *sqlx.DB, table string, columns []tableColumn) error {
+	for _, c := range columns {
+		var count int
+		err := db.Get(&count, "SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME=? AND COLUMN_NAME=?", table, c.name)
+
+		if err != nil {
+			return err
+		}
+
+		if count != 0 {
+			continue
+		}
+
+		if _, err := db.Exec("ALTER TABLE " + table + " ADD COLUMN " + c.name + " " + c.definition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func consulInit() *consulTraefik.Client {
 	consul, err := consulTraefik.NewClient("traefik", *consulHost)
 
@@ -131,6 +311,8 @@ func consulInit() *consulTraefik.Client {
 		log.Fatal("error: Connecting to consul server error", err)
 	}
 
+	consul.Client = &instrumentedStore{Store: consul.Client}
+
 	if ok, err := consul.HasFrontend(traefikFrontendName); err != nil {
 		log.Fatal("error: consul.HasFrontend error", err)
 	} else if !ok {
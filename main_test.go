@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goadesign/goa"
+	"github.com/modoki-paas/modoki/app"
+)
+
+// secretUserController accepts secrets without storing them
+type secretUserController struct {
+	app.UserController
+	*goa.Controller
+}
+
+func (c *secretUserController) MuxHandler(name string, hdlr goa.Handler, unm goa.Unmarshaler) goa.MuxHandler {
+	return c.Controller.MuxHandler(name, hdlr, unm)
+}
+
+func (c *secretUserController) SetSecret(ctx *app.SetSecretUserContext) error {
+	return ctx.NoContent()
+}
+
+func TestSecretsAreNotLogged(t *testing.T) {
+	var buf bytes.Buffer
+
+	service := goa.New("test")
+	service.WithLogger(goa.NewLogger(log.New(&buf, "", 0)))
+
+	app.UseJWTMiddleware(service, func(h goa.Handler) goa.Handler { return h })
+	useMiddleware(service)
+
+	app.MountUserController(service, &secretUserController{Controller: service.NewController("UserController")})
+
+	const secret = "s3cr3t-value-never-logged"
+
+	req := httptest.NewRequest("PUT", "/api/v2/user/secrets", strings.NewReader(`{"name": "token", "value": "`+secret+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rw := httptest.NewRecorder()
+
+	service.Mux.ServeHTTP(rw, req)
+
+	if rw.Code != http.StatusNoContent {
+		t.Fatalf("status: got %d, want %d(body: %s)", rw.Code, http.StatusNoContent, rw.Body.String())
+	}
+
+	if buf.Len() == 0 {
+		t.Fatal("the request was not logged")
+	}
+
+	if strings.Contains(buf.String(), secret) {
+		t.Errorf("the secret was logged: %s", buf.String())
+	}
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-connections/sockets"
+	"github.com/docker/libkv/store"
+	"github.com/goadesign/goa"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics of modoki itself exposed on /metrics
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "modoki",
+		Name:      "http_requests_total",
+		Help:      "Number of API requests by controller, action and status code.",
+	}, []string{"controller", "action", "code"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "modoki",
+		Name:      "http_request_duration_seconds",
+		Help:      "Latencies of API requests by controller and action. WebSocket requests last for the sessions.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"controller", "action"})
+
+	dockerRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "modoki",
+		Name:      "docker_api_duration_seconds",
+		Help:      "Latencies until the response headers of Docker API calls by method and endpoint.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "endpoint"})
+
+	dockerErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "modoki",
+		Name:      "docker_api_errors_total",
+		Help:      "Number of Docker API calls failed with connection errors or 5xx by method and endpoint.",
+	}, []string{"method", "endpoint"})
+
+	consulWriteFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "modoki",
+		Name:      "consul_write_failures_total",
+		Help:      "Number of failed writes to Consul by operation.",
+	}, []string{"operation"})
+
+	imagePullDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "modoki",
+		Name:      "image_pull_duration_seconds",
+		Help:      "Durations of image pulls by result(success or error).",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12),
+	}, []string{"result"})
+
+	websocketSessions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "modoki",
+		Name:      "websocket_sessions",
+		Help:      "Number of active WebSocket sessions by type.",
+	}, []string{"type"})
+)
+
+const (
+	websocketSessionExec  = "exec"
+	websocketSessionLogs  = "logs"
+	websocketSessionStats = "stats"
+	websocketSessionRun   = "run"
+)
+
+// trackWebsocketSession counts the session of the type as active until the returned func is called
+func trackWebsocketSession(sessionType string) func() {
+	g := websocketSessions.WithLabelValues(sessionType)
+	g.Inc()
+
+	return g.Dec
+}
+
+// metricsMiddleware records the requests to the goa actions
+func metricsMiddleware(h goa.Handler) goa.Handler {
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		started := time.Now()
+
+		err := h(ctx, rw, req)
+
+		controller, action := goa.ContextController(ctx), goa.ContextAction(ctx)
+		code := http.StatusInternalServerError
+		if resp := goa.ContextResponse(ctx); resp != nil && resp.Status != 0 {
+			code = resp.Status
+		} else if se, ok := errors.Cause(err).(goa.ServiceError); ok {
+			// The error is written to the response by ErrorHandler after this returns
+			code = se.ResponseStatus()
+		}
+
+		requestsTotal.WithLabelValues(controller, action, strconv.Itoa(code)).Inc()
+		requestDuration.WithLabelValues(controller, action).Observe(time.Since(started).Seconds())
+
+		return err
+	}
+}
+
+var dockerAPIVersionRegexp = regexp.MustCompile(`^v[0-9]+\.[0-9]+$`)
+
+// dockerEndpoint returns the path of a Docker API request without the version and the ids to keep the cardinality low
+// e.g. /v1.37/containers/abcdef/start -> /containers/:id/start
+func dockerEndpoint(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(segments) != 0 && dockerAPIVersionRegexp.MatchString(segments[0]) {
+		segments = segments[1:]
+	}
+
+	switch len(segments) {
+	case 0:
+		return "/"
+	case 1:
+		return "/" + segments[0]
+	}
+
+	switch segments[1] {
+	case "create", "json", "prune", "load", "search", "get", "build":
+		return "/" + segments[0] + "/" + segments[1]
+	}
+
+	// Image names may contain slashes
+	if len(segments) == 2 {
+		return "/" + segments[0] + "/:id"
+	}
+
+	return "/" + segments[0] + "/:id/" + segments[len(segments)-1]
+}
+
+// instrumentedTransport records the latencies and the errors of the requests to Docker
+type instrumentedTransport struct {
+	http.RoundTripper
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := dockerEndpoint(req.URL.Path)
+	started := time.Now()
+
+	resp, err := t.RoundTripper.RoundTrip(req)
+
+	dockerRequestDuration.WithLabelValues(req.Method, endpoint).Observe(time.Since(started).Seconds())
+
+	if err != nil || resp.StatusCode >= 500 {
+		dockerErrorsTotal.WithLabelValues(req.Method, endpoint).Inc()
+	}
+
+	return resp, err
+}
+
+// newDockerHTTPClient returns an HTTP client for the docker daemon at host recording the metrics
+func newDockerHTTPClient(host string) (*http.Client, error) {
+	u, err := url.Parse(host)
+
+	if err != nil {
+		return nil, err
+	}
+
+	addr := u.Host
+	if u.Scheme == "unix" {
+		addr = u.Path
+	}
+
+	transport := new(http.Transport)
+	if err := sockets.ConfigureTransport(transport, u.Scheme, addr); err != nil {
+		return nil, err
+	}
+
+	return &http.Client{
+		Transport: &instrumentedTransport{RoundTripper: transport},
+	}, nil
+}
+
+// instrumentedStore counts the failed writes to the store
+type instrumentedStore struct {
+	store.Store
+}
+
+func (s *instrumentedStore) count(operation string, err error) error {
+	if err != nil && err != store.ErrKeyNotFound {
+		consulWriteFailuresTotal.WithLabelValues(operation).Inc()
+	}
+
+	return err
+}
+
+func (s *instrumentedStore) Put(key string, value []byte, options *store.WriteOptions) error {
+	return s.count("put", s.Store.Put(key, value, options))
+}
+
+func (s *instrumentedStore) Delete(key string) error {
+	return s.count("delete", s.Store.Delete(key))
+}
+
+func (s *instrumentedStore) DeleteTree(directory string) error {
+	return s.count("delete_tree", s.Store.DeleteTree(directory))
+}
+
+func (s *instrumentedStore) AtomicPut(key string, value []byte, previous *store.KVPair, options *store.WriteOptions) (bool, *store.KVPair, error) {
+	ok, pair, err := s.Store.AtomicPut(key, value, previous, options)
+
+	return ok, pair, s.count("atomic_put", err)
+}
+
+func (s *instrumentedStore) AtomicDelete(key string, previous *store.KVPair) (bool, error) {
+	ok, err := s.Store.AtomicDelete(key, previous)
+
+	return ok, s.count("atomic_delete", err)
+}
+
+// containerStatusCollector reports the number of containers by status from the containers table on every scrape
+type containerStatusCollector struct {
+	db   *sqlx.DB
+	desc *prometheus.Desc
+}
+
+func newContainerStatusCollector(db *sqlx.DB) *containerStatusCollector {
+	return &containerStatusCollector{
+		db: db,
+		desc: prometheus.NewDesc(
+			"modoki_containers",
+			"Number of containers by status.",
+			[]string{"status"},
+			nil,
+		),
+	}
+}
+
+func (c *containerStatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *containerStatusCollector) Collect(ch chan<- prometheus.Metric) {
+	var counts []struct {
+		Status string `db:"status"`
+		Count  int    `db:"count"`
+	}
+
+	if err := c.db.Select(&counts, "SELECT COALESCE(status, '') AS status, COUNT(*) AS count FROM containers GROUP BY status"); err != nil {
+		log.Println("Counting containers for metrics error:", err)
+
+		return
+	}
+
+	for i := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(counts[i].Count), counts[i].Status)
+	}
+}
+
+// registerMetrics registers the metrics of modoki to the default registry
+func registerMetrics(db *sqlx.DB) {
+	prometheus.MustRegister(
+		requestsTotal,
+		requestDuration,
+		dockerRequestDuration,
+		dockerErrorsTotal,
+		consulWriteFailuresTotal,
+		imagePullDuration,
+		websocketSessions,
+		newContainerStatusCollector(db),
+	)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goadesign/goa"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestDockerEndpoint(t *testing.T) {
+	tests := []struct {
+		path, want string
+	}{
+		{"/_ping", "/_ping"},
+		{"/", "/"},
+		{"/v1.37/containers/json", "/containers/json"},
+		{"/v1.37/containers/create", "/containers/create"},
+		{"/v1.37/containers/abcdef/start", "/containers/:id/start"},
+		{"/v1.37/containers/abcdef", "/containers/:id"},
+		{"/containers/abcdef/stats", "/containers/:id/stats"},
+		{"/v1.37/images/library/nginx/json", "/images/:id/json"},
+		{"/v1.37/images/create", "/images/create"},
+		{"/v1.37/volumes/myvolume", "/volumes/:id"},
+		{"/v1.37/version", "/version"},
+		{"/volumes/myvolume", "/volumes/:id"},
+		{"/version", "/version"},
+	}
+
+	for _, tc := range tests {
+		if got := dockerEndpoint(tc.path); got != tc.want {
+			t.Errorf("dockerEndpoint(%q) = %q, want %q", tc.path, got, tc.want)
+		}
+	}
+}
+
+// notFoundUserController responds 404 to removeSecret by the response or by the error
+type notFoundUserController struct {
+	app.UserController
+	*goa.Controller
+
+	returnError bool
+}
+
+func (c *notFoundUserController) MuxHandler(name string, hdlr goa.Handler, unm goa.Unmarshaler) goa.MuxHandler {
+	return c.Controller.MuxHandler(name, hdlr, unm)
+}
+
+func (c *notFoundUserController) RemoveSecret(ctx *app.RemoveSecretUserContext) error {
+	if c.returnError {
+		return goa.ErrNotFound("The secret is not found")
+	}
+
+	return ctx.NotFound()
+}
+
+func TestMetricsMiddlewareCode(t *testing.T) {
+	for _, returnError := range []bool{false, true} {
+		service := goa.New("test")
+		service.WithLogger(nil)
+
+		app.UseJWTMiddleware(service, func(h goa.Handler) goa.Handler { return h })
+		useMiddleware(service)
+
+		app.MountUserController(service, &notFoundUserController{Controller: service.NewController("UserController"), returnError: returnError})
+
+		counter := requestsTotal.WithLabelValues("UserController", "removeSecret", "404")
+		before := testutil.ToFloat64(counter)
+
+		rw := httptest.NewRecorder()
+		service.Mux.ServeHTTP(rw, httptest.NewRequest("DELETE", "/api/v2/user/secrets?name=token", nil))
+
+		if rw.Code != http.StatusNotFound {
+			t.Fatalf("returnError=%v: status: got %d, want %d", returnError, rw.Code, http.StatusNotFound)
+		}
+
+		if got := testutil.ToFloat64(counter) - before; got != 1 {
+			t.Errorf("returnError=%v: requests with code 404: got %v, want 1", returnError, got)
+		}
+	}
+}
@@ -2,4 +2,5 @@
 
 ssh-keygen -t rsa -b 4096 -f jwt.key
 openssl rsa -in jwt.key -pubout -outform PEM -out jwt.key.pub
-ssh-keygen -t rsa -b 2048 -N '' -f ssh.key
\ No newline at end of file
+ssh-keygen -t rsa -b 2048 -N '' -f ssh.key
+openssl rand -out secret.key 32
\ No newline at end of file
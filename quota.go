@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"github.com/modoki-paas/modoki/app"
+	"github.com/modoki-paas/modoki/consul_traefik"
+	"github.com/pkg/errors"
+)
+
+// unlimitedCPUUsage is the cpu usage counted for containers without a cpu limit
+const unlimitedCPUUsage = 100
+
+// quota is the amount of resources used by or allowed to a user. 0 means unlimited in quotas.
+type quota struct {
+	Containers int64
+	CPU        int64 // sum of the percentages of a CPU of the containers
+	Memory     int64 // bytes
+	Storage    int64 // bytes
+	Volumes    int64
+}
+
+// quotaExceededError is returned when a user requests resources over the quota
+type quotaExceededError struct {
+	message string
+}
+
+func (e *quotaExceededError) Error() string {
+	return e.message
+}
+
+// queryExecer is implemented by both *sqlx.DB and *sql.Tx
+type queryExecer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// isAdmin returns whether the user is one of the administrators listed in modoki/admins on consul separated by commas
+func isAdmin(consul *consulTraefik.Client, uid string) bool {
+	pair, err := consul.Client.Get("modoki/admins")
+
+	if err != nil {
+		return false
+	}
+
+	for _, admin := range strings.Split(string(pair.Value), ",") {
+		if strings.TrimSpace(admin) == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// defaultQuota returns the quota configured by the administrator for all users
+func defaultQuota(consul *consulTraefik.Client) quota {
+	var q quota
+
+	get := func(key string, parse func(string) (int64, error)) int64 {
+		pair, err := consul.Client.Get(key)
+
+		if err != nil {
+			return 0
+		}
+
+		if v, err := parse(string(pair.Value)); err == nil && v > 0 {
+			return v
+		}
+
+		return 0
+	}
+
+	parseInt := func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	}
+	toBytes := func(s string) (int64, error) {
+		v, err := bytefmt.ToBytes(s)
+
+		return int64(v), err
+	}
+
+	q.Containers = get("modoki/quota/containers", parseInt)
+	q.CPU = get("modoki/quota/cpu", parseInt)
+	q.Memory = get("modoki/quota/memory", toBytes)
+	q.Storage = get("modoki/quota/storage", toBytes)
+	q.Volumes = get("modoki/quota/volumes", parseInt)
+
+	return q
+}
+
+// userQuota returns the quota of the user, the default one overridden by the user's row in userQuotas.
+// With lock, the row is locked until the end of the transaction q to serialize the changes of the user's usage.
+func userQuota(ctx context.Context, q queryExecer, consul *consulTraefik.Client, uid string, lock bool) (quota, error) {
+	query := "SELECT containers, cpu, memory, storage, volumes FROM userQuotas WHERE uid=?"
+
+	if lock {
+		if _, err := q.ExecContext(ctx, "INSERT IGNORE INTO userQuotas (uid) VALUES (?)", uid); err != nil {
+			return quota{}, errors.Wrap(err, "DB Insert error")
+		}
+
+		query += " FOR UPDATE"
+	}
+
+	var containers, cpu, memory, storage, volumes sql.NullInt64
+	err := q.QueryRowContext(ctx, query, uid).Scan(&containers, &cpu, &memory, &storage, &volumes)
+
+	if err != nil && err != sql.ErrNoRows {
+		return quota{}, errors.Wrap(err, "DB Select error")
+	}
+
+	res := defaultQuota(consul)
+
+	override := func(v sql.NullInt64, dst *int64) {
+		if v.Valid {
+			*dst = v.Int64
+		}
+	}
+
+	override(containers, &res.Containers)
+	override(cpu, &res.CPU)
+	override(memory, &res.Memory)
+	override(storage, &res.Storage)
+	override(volumes, &res.Volumes)
+
+	return res, nil
+}
+
+// quotaUsage returns the resources used by the user's containers except the one of excludeID
+// and the running one-off containers
+func quotaUsage(ctx context.Context, q queryExecer, uid string, excludeID int) (quota, error) {
+	var usage quota
+	err := q.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*),
+			COALESCE(SUM(IF(cpuLimit=0, ?, cpuLimit)*replicas), 0),
+			COALESCE(SUM(memoryLimit*replicas), 0),
+			COALESCE(SUM(storageLimit*replicas), 0),
+			COALESCE(SUM(volumeCount), 0)
+		FROM containers WHERE uid=? AND id<>?`,
+		unlimitedCPUUsage, uid, excludeID,
+	).Scan(&usage.Containers, &usage.CPU, &usage.Memory, &usage.Storage, &usage.Volumes)
+
+	if err != nil {
+		return quota{}, errors.Wrap(err, "DB Select error")
+	}
+
+	var oneOffs quota
+	err = q.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*),
+			COALESCE(SUM(IF(cpuLimit=0, ?, cpuLimit)), 0),
+			COALESCE(SUM(memoryLimit), 0),
+			COALESCE(SUM(storageLimit), 0)
+		FROM oneOffContainers WHERE uid=?`,
+		unlimitedCPUUsage, uid,
+	).Scan(&oneOffs.Containers, &oneOffs.CPU, &oneOffs.Memory, &oneOffs.Storage)
+
+	if err != nil {
+		return quota{}, errors.Wrap(err, "DB Select error")
+	}
+
+	usage.Containers += oneOffs.Containers
+	usage.CPU += oneOffs.CPU
+	usage.Memory += oneOffs.Memory
+	usage.Storage += oneOffs.Storage
+
+	return usage, nil
+}
+
+// containerUsage returns the resources used by a container with the limits
+func containerUsage(limits resourceLimits, replicas, volumes int) quota {
+	cpu := limits.CPU
+	if cpu == 0 {
+		cpu = unlimitedCPUUsage
+	}
+
+	return quota{
+		Containers: 1,
+		CPU:        cpu * int64(replicas),
+		Memory:     limits.Memory * int64(replicas),
+		Storage:    limits.Storage * int64(replicas),
+		Volumes:    int64(volumes),
+	}
+}
+
+// allows returns a *quotaExceededError if the usage added by the container exceeds the quota
+func (q quota) allows(usage, container quota) error {
+	check := func(name string, limit, used, added int64, format func(int64) string) error {
+		if limit == 0 || added == 0 {
+			return nil
+		}
+
+		if used+added > limit {
+			return &quotaExceededError{message: fmt.Sprintf("Your %s quota is exceeded: %s of %s used", name, format(used), format(limit))}
+		}
+
+		return nil
+	}
+
+	// Unlimited containers would make the quotas meaningless
+	if q.Memory != 0 && container.Memory == 0 {
+		return &quotaExceededError{message: "A memory limit is required by your quota"}
+	}
+	if q.Storage != 0 && container.Storage == 0 {
+		return &quotaExceededError{message: "A storage limit is required by your quota"}
+	}
+
+	if err := check("containers", q.Containers, usage.Containers, container.Containers, formatInt); err != nil {
+		return err
+	}
+	if err := check("cpu", q.CPU, usage.CPU, container.CPU, formatInt); err != nil {
+		return err
+	}
+	if err := check("memory", q.Memory, usage.Memory, container.Memory, formatBytes); err != nil {
+		return err
+	}
+	if err := check("storage", q.Storage, usage.Storage, container.Storage, formatBytes); err != nil {
+		return err
+	}
+	if err := check("volumes", q.Volumes, usage.Volumes, container.Volumes, formatInt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// reserveQuota locks the user's quota in the transaction and checks the container of id(0 for a new one)
+// fits in it with the usage. The lock is held until the end of tx.
+func reserveQuota(ctx context.Context, tx *sql.Tx, consul *consulTraefik.Client, uid string, id int, container quota) error {
+	q, err := userQuota(ctx, tx, consul, uid, true)
+
+	if err != nil {
+		return err
+	}
+
+	usage, err := quotaUsage(ctx, tx, uid, id)
+
+	if err != nil {
+		return err
+	}
+
+	return q.allows(usage, container)
+}
+
+// lockContainerLimits locks the container in tx and returns its limits, replicas and volumes recorded for the quota
+func lockContainerLimits(ctx context.Context, tx *sql.Tx, id int) (resourceLimits, int, int, error) {
+	var limits resourceLimits
+	var replicas, volumes int
+	err := tx.QueryRowContext(ctx, "SELECT cpuLimit, memoryLimit, storageLimit, replicas, volumeCount FROM containers WHERE id=? FOR UPDATE", id).
+		Scan(&limits.CPU, &limits.Memory, &limits.Storage, &replicas, &volumes)
+
+	if err != nil {
+		return resourceLimits{}, 0, 0, errors.Wrap(err, "DB Select error")
+	}
+
+	return limits, replicas, volumes, nil
+}
+
+// media returns the quota as a media type. With omitZero, zeros(unlimited in quotas) are omitted.
+func (q quota) media(omitZero bool) *app.GoaUserQuotaResources {
+	m := &app.GoaUserQuotaResources{}
+
+	set := func(v int64, dst **int) {
+		if v != 0 || !omitZero {
+			i := int(v)
+			*dst = &i
+		}
+	}
+	setBytes := func(v int64, dst **string) {
+		if v != 0 || !omitZero {
+			s := formatBytes(v)
+			*dst = &s
+		}
+	}
+
+	set(q.Containers, &m.Containers)
+	set(q.CPU, &m.CPU)
+	setBytes(q.Memory, &m.Memory)
+	setBytes(q.Storage, &m.Storage)
+	set(q.Volumes, &m.Volumes)
+
+	return m
+}
@@ -0,0 +1,85 @@
+package main
+
+import "testing"
+
+func TestQuotaAllows(t *testing.T) {
+	const gi = 1 << 30
+
+	tests := []struct {
+		name      string
+		quota     quota
+		usage     quota
+		container quota
+		wantErr   bool
+	}{
+		{
+			name:      "unlimited",
+			container: quota{Containers: 1, CPU: 100, Memory: gi, Storage: gi, Volumes: 3},
+		},
+		{
+			name:      "within",
+			quota:     quota{Containers: 3, CPU: 200, Memory: 2 * gi},
+			usage:     quota{Containers: 2, CPU: 100, Memory: gi},
+			container: quota{Containers: 1, CPU: 100, Memory: gi},
+		},
+		{
+			name:      "containers",
+			quota:     quota{Containers: 2},
+			usage:     quota{Containers: 2},
+			container: quota{Containers: 1},
+			wantErr:   true,
+		},
+		{
+			name:      "cpu",
+			quota:     quota{CPU: 200},
+			usage:     quota{CPU: 150},
+			container: quota{Containers: 1, CPU: 100},
+			wantErr:   true,
+		},
+		{
+			name:      "memory",
+			quota:     quota{Memory: 2 * gi},
+			usage:     quota{Memory: 2 * gi},
+			container: quota{Containers: 1, Memory: 1},
+			wantErr:   true,
+		},
+		{
+			name:      "memory limit required",
+			quota:     quota{Memory: 2 * gi},
+			container: quota{Containers: 1},
+			wantErr:   true,
+		},
+		{
+			name:      "storage limit required",
+			quota:     quota{Storage: gi},
+			container: quota{Containers: 1, Memory: gi},
+			wantErr:   true,
+		},
+		{
+			name:      "volumes",
+			quota:     quota{Volumes: 2},
+			usage:     quota{Volumes: 1},
+			container: quota{Containers: 1, Volumes: 2},
+			wantErr:   true,
+		},
+		{
+			// Existing containers over the quota do not block changes adding nothing
+			name:      "nothing added",
+			quota:     quota{Containers: 1, CPU: 100},
+			usage:     quota{Containers: 3, CPU: 300},
+			container: quota{},
+		},
+	}
+
+	for _, tc := range tests {
+		err := tc.quota.allows(tc.usage, tc.container)
+
+		if tc.wantErr {
+			if _, ok := err.(*quotaExceededError); !ok {
+				t.Errorf("%s: got %v, want a quotaExceededError", tc.name, err)
+			}
+		} else if err != nil {
+			t.Errorf("%s: error: %v", tc.name, err)
+		}
+	}
+}
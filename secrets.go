@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+var errSecretsDisabled = errors.New("Secrets are disabled because the secret key is not loaded")
+
+// SecretStore stores secrets of users encrypted with the server key
+type SecretStore struct {
+	DB  *sqlx.DB
+	aes cipher.AEAD
+}
+
+// userSecret is a secret without the value
+type userSecret struct {
+	Name      string    `db:"name"`
+	UpdatedAt time.Time `db:"updatedAt"`
+}
+
+// loadSecretKey loads a 32 bytes key for AES-256 from the file in binary or hex
+func loadSecretKey(path string) ([]byte, error) {
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) != 32 {
+		b, err = hex.DecodeString(strings.TrimSpace(string(b)))
+
+		if err != nil || len(b) != 32 {
+			return nil, errors.New("The secret key must be 32 bytes in binary or hex")
+		}
+	}
+
+	return b, nil
+}
+
+// NewSecretStore returns a store. All operations fail with errSecretsDisabled if key is nil.
+func NewSecretStore(db *sqlx.DB, key []byte) (*SecretStore, error) {
+	s := &SecretStore{
+		DB: db,
+	}
+
+	if key == nil {
+		return s, nil
+	}
+
+	block, err := aes.NewCipher(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.aes, err = cipher.NewGCM(block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// The uid and the name are bound as additional data so that encrypted values cannot be swapped
+func secretAdditionalData(uid, name string) []byte {
+	return []byte(uid + "/" + name)
+}
+
+// Put adds or updates the secret
+func (s *SecretStore) Put(ctx context.Context, uid, name, value string) error {
+	if s.aes == nil {
+		return errSecretsDisabled
+	}
+
+	nonce := make([]byte, s.aes.NonceSize())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	encrypted := s.aes.Seal(nonce, nonce, []byte(value), secretAdditionalData(uid, name))
+
+	_, err := s.DB.ExecContext(ctx, "INSERT INTO userSecrets (uid, name, value) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE value=VALUES(value)", uid, name, encrypted)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Insert error")
+	}
+
+	return nil
+}
+
+// Get returns the decrypted value of the secret. sql.ErrNoRows is returned if it is not found.
+func (s *SecretStore) Get(ctx context.Context, uid, name string) (string, error) {
+	if s.aes == nil {
+		return "", errSecretsDisabled
+	}
+
+	var encrypted []byte
+	if err := s.DB.QueryRowContext(ctx, "SELECT value FROM userSecrets WHERE uid=? AND name=?", uid, name).Scan(&encrypted); err != nil {
+		return "", err
+	}
+
+	if len(encrypted) < s.aes.NonceSize() {
+		return "", errors.New("The encrypted secret is broken")
+	}
+
+	nonce := encrypted[:s.aes.NonceSize()]
+	b, err := s.aes.Open(nil, nonce, encrypted[s.aes.NonceSize():], secretAdditionalData(uid, name))
+
+	if err != nil {
+		return "", errors.Wrap(err, "Decrypting the secret error")
+	}
+
+	return string(b), nil
+}
+
+// Exists returns whether the user has the secret
+func (s *SecretStore) Exists(ctx context.Context, uid, name string) (bool, error) {
+	var n int
+	if err := s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM userSecrets WHERE uid=? AND name=?", uid, name).Scan(&n); err != nil {
+		return false, errors.Wrap(err, "DB Select error")
+	}
+
+	return n != 0, nil
+}
+
+// List returns the secrets of the user without values
+func (s *SecretStore) List(ctx context.Context, uid string) ([]*userSecret, error) {
+	var secrets []*userSecret
+
+	if err := s.DB.SelectContext(ctx, &secrets, "SELECT name, updatedAt FROM userSecrets WHERE uid=? ORDER BY name", uid); err != nil {
+		return nil, errors.Wrap(err, "DB Select error")
+	}
+
+	return secrets, nil
+}
+
+// Delete removes the secret. sql.ErrNoRows is returned if it is not found.
+func (s *SecretStore) Delete(ctx context.Context, uid, name string) error {
+	res, err := s.DB.ExecContext(ctx, "DELETE FROM userSecrets WHERE uid=? AND name=?", uid, name)
+
+	if err != nil {
+		return errors.Wrap(err, "DB Delete error")
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
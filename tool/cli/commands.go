@@ -28,20 +28,120 @@ import (
 )
 
 type (
+	// AddDomainContainerCommand is the command line data structure for the addDomain action of container
+	AddDomainContainerCommand struct {
+		// id or name
+		ID string
+		// Domain name
+		Domain      string
+		PrettyPrint bool
+	}
+
+	// AddRouteContainerCommand is the command line data structure for the addRoute action of container
+	AddRouteContainerCommand struct {
+		// id or name
+		ID string
+		// Host name
+		Host string
+		// Path prefix
+		PathPrefix string
+		// Priority of the route. Routes with higher priority are matched first
+		Priority int
+		// Strip the prefix before forwarding requests
+		StripPrefix string
+		PrettyPrint bool
+	}
+
+	// AddScheduleContainerCommand is the command line data structure for the addSchedule action of container
+	AddScheduleContainerCommand struct {
+		// id or name
+		ID string
+		// Action taken on the schedule
+		Action string
+		// Cron expression(minute hour day month weekday) or a descriptor such as @daily
+		Schedule string
+		// IANA time zone the schedule is in, such as Asia/Tokyo
+		Timezone string
+		// Days and time the container runs in, such as mon-fri 09:00-18:00. Days are in the weekday format of cron and can be omitted for every day
+		Window      string
+		PrettyPrint bool
+	}
+
+	// AddSecretContainerCommand is the command line data structure for the addSecret action of container
+	AddSecretContainerCommand struct {
+		// id or name
+		ID string
+		// Apply the changes at the next restart instead of recreating the container now
+		Deferred string
+		// Environment variable to inject the secret as
+		Env string
+		// Absolute path of the file to copy the secret to
+		File string
+		// Name of the secret
+		Name        string
+		PrettyPrint bool
+	}
+
 	// CreateContainerCommand is the command line data structure for the create action of container
 	CreateContainerCommand struct {
 		// Command to run specified as a string or an array of strings.
 		Command []string
+		// Percentage of a CPU the container can use. Defaults to the maximum set by the administrator
+		CPU int
 		// The entry point for the container as a string or an array of strings
 		Entrypoint []string
 		// Environment variables
 		Env []string
+		// The time the container is removed at
+		ExpiresAt string
+		// Command run in the container to check the health. Healthy if it exits with 0
+		HealthCheckCommand []string
+		// Seconds between health checks
+		HealthCheckInterval int
+		// Path requested over HTTP on the port to check the health. The image needs curl or wget
+		HealthCheckPath string
+		// Consecutive failures to become unhealthy
+		HealthCheckRetries int
+		// Seconds for the container to initialize, in which failures are not counted
+		HealthCheckStartPeriod int
+		// Seconds to wait for a health check to finish
+		HealthCheckTimeout int
+		// Seconds without incoming traffic until the container sleeps. A sleeping container is started by the next request. 0 disables sleeping
+		IdleTimeout int
 		// Name of image
 		Image string
+		// web is served on the subdomain. worker has no endpoint. private is not exposed publicly and reachable from other containers by <name>.<uid>
+		Kind string
+		// Memory the container can use such as 512M. Defaults to the maximum set by the administrator
+		Memory string
+		// Path of the Prometheus metrics. Defaults to /metrics
+		MetricsPath string
+		// Port the container serves Prometheus metrics on. The container is listed in the service discovery for Prometheus
+		MetricsPort int
 		// Name of container and subdomain
 		Name string
+		// Maximum number of processes in the container. Defaults to the maximum set by the administrator
+		Pids int
+		// Port the container serves HTTP on. Defaults to the port exposed by the image, or 80
+		Port int
+		// Additional ports exposed on their own subdomains(<port name>.<container name>.<addr>), specified as name:port or name:port/protocol
+		Ports []string
+		// Protocol the container serves on the port
+		Protocol string
+		// Number of containers run behind the subdomain
+		Replicas int
+		// Maximum number of restarts with on-failure. 0 means unlimited
+		RestartMaxRetries int
+		// Policy to restart the container when it exits. Containers dying repeatedly shortly after starting are restarted with exponential backoff
+		RestartPolicy string
+		// Your secrets injected into the container, specified as <secret>(as the env var of the same name), <secret>:<env var> or <secret>:<absolute file path>
+		Secrets []string
 		// Whether HTTP is redirected to HTTPS
 		SslRedirect string
+		// Storage the container can use such as 10G. Defaults to the maximum set by the administrator
+		Storage string
+		// Seconds until the container is removed
+		TTL int
 		// Path to volumes in a container
 		Volumes []string
 		// Current directory (PWD) in the command will be launched
@@ -58,6 +158,15 @@ type (
 		PrettyPrint  bool
 	}
 
+	// EventsContainerCommand is the command line data structure for the events action of container
+	EventsContainerCommand struct {
+		// id or name
+		ID string
+		// Maximum number of events
+		Limit       int
+		PrettyPrint bool
+	}
+
 	// ExecContainerCommand is the command line data structure for the exec action of container
 	ExecContainerCommand struct {
 		// id or name
@@ -69,6 +178,17 @@ type (
 		PrettyPrint bool
 	}
 
+	// ExtendContainerCommand is the command line data structure for the extend action of container
+	ExtendContainerCommand struct {
+		// id or name
+		ID string
+		// The time the container is removed at
+		ExpiresAt string
+		// Seconds from now until the container is removed
+		TTL         int
+		PrettyPrint bool
+	}
+
 	// GetConfigContainerCommand is the command line data structure for the getConfig action of container
 	GetConfigContainerCommand struct {
 		// id or name
@@ -76,6 +196,13 @@ type (
 		PrettyPrint bool
 	}
 
+	// GetEnvContainerCommand is the command line data structure for the getEnv action of container
+	GetEnvContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
 	// InspectContainerCommand is the command line data structure for the inspect action of container
 	InspectContainerCommand struct {
 		// ID or name
@@ -88,6 +215,34 @@ type (
 		PrettyPrint bool
 	}
 
+	// ListDomainsContainerCommand is the command line data structure for the listDomains action of container
+	ListDomainsContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
+	// ListRoutesContainerCommand is the command line data structure for the listRoutes action of container
+	ListRoutesContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
+	// ListSchedulesContainerCommand is the command line data structure for the listSchedules action of container
+	ListSchedulesContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
+	// ListSecretsContainerCommand is the command line data structure for the listSecrets action of container
+	ListSecretsContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
 	// LogsContainerCommand is the command line data structure for the logs action of container
 	LogsContainerCommand struct {
 		// id or name
@@ -102,6 +257,58 @@ type (
 		PrettyPrint bool
 	}
 
+	// MetricsContainerCommand is the command line data structure for the metrics action of container
+	MetricsContainerCommand struct {
+		// id or name
+		ID string
+		// The start of the series. Defaults to an hour before to
+		From string
+		// Seconds between the points
+		Step int
+		// The end of the series. Defaults to now
+		To          string
+		PrettyPrint bool
+	}
+
+	// PauseContainerCommand is the command line data structure for the pause action of container
+	PauseContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
+	// RedeployContainerCommand is the command line data structure for the redeploy action of container
+	RedeployContainerCommand struct {
+		// id or name
+		ID string
+		// Command to run specified as a string or an array of strings.
+		Command []string
+		// Seconds to keep the current container serving in-flight requests after switching in blueGreen
+		DrainPeriod int
+		// The entry point for the container as a string or an array of strings
+		Entrypoint []string
+		// Environment variables
+		Env []string
+		// Seconds to wait for the new container to become healthy in blueGreen. It is rolled back on timeout
+		HealthTimeout int
+		// Name of image. The current image is pulled again if omitted
+		Image string
+		// recreate: stop the current container and start the new one, blueGreen: switch to the new container after it becomes healthy without downtime
+		Strategy string
+		// Seconds to wait before killing the current container
+		Timeout int
+		// Current directory (PWD) in the command will be launched
+		WorkingDir  string
+		PrettyPrint bool
+	}
+
+	// ReleasesContainerCommand is the command line data structure for the releases action of container
+	ReleasesContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
 	// RemoveContainerCommand is the command line data structure for the remove action of container
 	RemoveContainerCommand struct {
 		// id or name
@@ -111,6 +318,135 @@ type (
 		PrettyPrint bool
 	}
 
+	// RemoveDomainContainerCommand is the command line data structure for the removeDomain action of container
+	RemoveDomainContainerCommand struct {
+		// id or name
+		ID string
+		// Domain name
+		Domain      string
+		PrettyPrint bool
+	}
+
+	// RemoveEnvContainerCommand is the command line data structure for the removeEnv action of container
+	RemoveEnvContainerCommand struct {
+		// id or name
+		ID string
+		// Apply the changes at the next restart instead of recreating the container now
+		Deferred string
+		// Names of environment variables
+		Name        []string
+		PrettyPrint bool
+	}
+
+	// RemoveRouteContainerCommand is the command line data structure for the removeRoute action of container
+	RemoveRouteContainerCommand struct {
+		// id or name
+		ID string
+		// Route ID
+		Route       int
+		PrettyPrint bool
+	}
+
+	// RemoveScheduleContainerCommand is the command line data structure for the removeSchedule action of container
+	RemoveScheduleContainerCommand struct {
+		// id or name
+		ID string
+		// Schedule ID
+		Schedule    int
+		PrettyPrint bool
+	}
+
+	// RemoveSecretContainerCommand is the command line data structure for the removeSecret action of container
+	RemoveSecretContainerCommand struct {
+		// id or name
+		ID string
+		// Apply the changes at the next restart instead of recreating the container now
+		Deferred string
+		// Name of the secret
+		Name        string
+		PrettyPrint bool
+	}
+
+	// RestartContainerCommand is the command line data structure for the restart action of container
+	RestartContainerCommand struct {
+		// id or name
+		ID string
+		// Seconds to wait before killing the container
+		Timeout     int
+		PrettyPrint bool
+	}
+
+	// RollbackContainerCommand is the command line data structure for the rollback action of container
+	RollbackContainerCommand struct {
+		// id or name
+		ID string
+		// Seconds to keep the current container serving in-flight requests after switching in blueGreen
+		DrainPeriod int
+		// Seconds to wait for the new container to become healthy in blueGreen. It is rolled back on timeout
+		HealthTimeout int
+		// Release number to roll back to. Defaults to the previous release
+		Release int
+		// recreate: stop the current container and start the new one, blueGreen: switch to the new container after it becomes healthy without downtime
+		Strategy string
+		// Seconds to wait before killing the current container
+		Timeout     int
+		PrettyPrint bool
+	}
+
+	// RunContainerCommand is the command line data structure for the run action of container
+	RunContainerCommand struct {
+		// Command to run specified as a string or an array of strings.
+		Command []string
+		// The entry point for the container as a string or an array of strings
+		Entrypoint []string
+		// Environment variables
+		Env []string
+		// Name of image
+		Image string
+		// Seconds until the container is killed
+		Timeout int
+		// Current directory (PWD) in the command will be launched
+		WorkingDir  string
+		PrettyPrint bool
+	}
+
+	// RunStreamContainerCommand is the command line data structure for the runStream action of container
+	RunStreamContainerCommand struct {
+		// Command to run specified as a string or an array of strings.
+		Command []string
+		// The entry point for the container as a string or an array of strings
+		Entrypoint []string
+		// Environment variables
+		Env []string
+		// Name of image
+		Image string
+		// Seconds until the container is killed
+		Timeout int
+		// Current directory (PWD) in the command will be launched
+		WorkingDir  string
+		PrettyPrint bool
+	}
+
+	// ScaleContainerCommand is the command line data structure for the scale action of container
+	ScaleContainerCommand struct {
+		// id or name
+		ID string
+		// Number of replicas
+		Replicas int
+		// Seconds to wait before killing the removed replicas
+		Timeout     int
+		PrettyPrint bool
+	}
+
+	// ScheduleLogsContainerCommand is the command line data structure for the scheduleLogs action of container
+	ScheduleLogsContainerCommand struct {
+		// id or name
+		ID string
+		// Maximum number of logs
+		Limit       int
+		PrettyPrint bool
+	}
+
 	// SetConfigContainerCommand is the command line data structure for the setConfig action of container
 	SetConfigContainerCommand struct {
 		Payload     string
@@ -120,6 +456,30 @@ type (
 		PrettyPrint bool
 	}
 
+	// SetEnvContainerCommand is the command line data structure for the setEnv action of container
+	SetEnvContainerCommand struct {
+		Payload     string
+		ContentType string
+		// id or name
+		ID string
+		// Apply the changes at the next restart instead of recreating the container now
+		Deferred    string
+		PrettyPrint bool
+	}
+
+	// SetLimitsContainerCommand is the command line data structure for the setLimits action of container
+	SetLimitsContainerCommand struct {
+		// id or name
+		ID string
+		// Percentage of a CPU the container can use
+		CPU int
+		// Memory the container can use such as 512M
+		Memory string
+		// Maximum number of processes in the container
+		Pids        int
+		PrettyPrint bool
+	}
+
 	// StartContainerCommand is the command line data structure for the start action of container
 	StartContainerCommand struct {
 		// id or name
@@ -127,8 +487,31 @@ type (
 		PrettyPrint bool
 	}
 
+	// StatsContainerCommand is the command line data structure for the stats action of container
+	StatsContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
+	// StatsStreamContainerCommand is the command line data structure for the statsStream action of container
+	StatsStreamContainerCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
 	// StopContainerCommand is the command line data structure for the stop action of container
 	StopContainerCommand struct {
+		// id or name
+		ID string
+		// Seconds to wait before killing the container
+		Timeout     int
+		PrettyPrint bool
+	}
+
+	// UnpauseContainerCommand is the command line data structure for the unpause action of container
+	UnpauseContainerCommand struct {
 		// id or name
 		ID          string
 		PrettyPrint bool
@@ -143,6 +526,67 @@ type (
 		PrettyPrint bool
 	}
 
+	// VerifyDomainContainerCommand is the command line data structure for the verifyDomain action of container
+	VerifyDomainContainerCommand struct {
+		// id or name
+		ID string
+		// Domain name
+		Domain      string
+		PrettyPrint bool
+	}
+
+	// CreateJobCommand is the command line data structure for the create action of job
+	CreateJobCommand struct {
+		// Command to run. Required with container
+		Command []string
+		// id or name of the container the command is executed in
+		Container string
+		// Environment variables
+		Env []string
+		// Name of the image run as a one-off container
+		Image string
+		// Name of the job
+		Name string
+		// Cron expression(minute hour day month weekday) or a descriptor such as @daily
+		Schedule    string
+		PrettyPrint bool
+	}
+
+	// InspectJobCommand is the command line data structure for the inspect action of job
+	InspectJobCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
+	// ListJobCommand is the command line data structure for the list action of job
+	ListJobCommand struct {
+		PrettyPrint bool
+	}
+
+	// RemoveJobCommand is the command line data structure for the remove action of job
+	RemoveJobCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
+	// RunJobCommand is the command line data structure for the run action of job
+	RunJobCommand struct {
+		// id or name
+		ID          string
+		PrettyPrint bool
+	}
+
+	// RunsJobCommand is the command line data structure for the runs action of job
+	RunsJobCommand struct {
+		// id or name
+		ID string
+		// Maximum number of runs
+		Limit       int
+		PrettyPrint bool
+	}
+
 	// AddAuthorizedKeysUserCommand is the command line data structure for the addAuthorizedKeys action of user
 	AddAuthorizedKeysUserCommand struct {
 		Payload     string
@@ -160,17 +604,33 @@ type (
 		PrettyPrint bool
 	}
 
+	// GetQuotaUserCommand is the command line data structure for the getQuota action of user
+	GetQuotaUserCommand struct {
+		PrettyPrint bool
+	}
+
 	// ListAuthorizedKeysUserCommand is the command line data structure for the listAuthorizedKeys action of user
 	ListAuthorizedKeysUserCommand struct {
 		PrettyPrint bool
 	}
 
+	// ListSecretsUserCommand is the command line data structure for the listSecrets action of user
+	ListSecretsUserCommand struct {
+		PrettyPrint bool
+	}
+
 	// RemoveAuthorizedKeysUserCommand is the command line data structure for the removeAuthorizedKeys action of user
 	RemoveAuthorizedKeysUserCommand struct {
 		Label       string
 		PrettyPrint bool
 	}
 
+	// RemoveSecretUserCommand is the command line data structure for the removeSecret action of user
+	RemoveSecretUserCommand struct {
+		Name        string
+		PrettyPrint bool
+	}
+
 	// SetAuthorizedKeysUserCommand is the command line data structure for the setAuthorizedKeys action of user
 	SetAuthorizedKeysUserCommand struct {
 		Payload     string
@@ -184,6 +644,22 @@ type (
 		PrettyPrint  bool
 	}
 
+	// SetQuotaUserCommand is the command line data structure for the setQuota action of user
+	SetQuotaUserCommand struct {
+		Payload     string
+		ContentType string
+		// ID of the user
+		UID         string
+		PrettyPrint bool
+	}
+
+	// SetSecretUserCommand is the command line data structure for the setSecret action of user
+	SetSecretUserCommand struct {
+		Payload     string
+		ContentType string
+		PrettyPrint bool
+	}
+
 	// DownloadCommand is the command line data structure for the download command.
 	DownloadCommand struct {
 		// OutFile is the path to the download output file.
@@ -217,12 +693,12 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "create",
-		Short: `create a new container`,
+		Use:   "add-domain",
+		Short: `Claim a custom domain for a container. Requests for the domain are routed to the container after the ownership is verified`,
 	}
-	tmp2 := new(CreateContainerCommand)
+	tmp2 := new(AddDomainContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/create"]`,
+		Use:   `container ["/api/v2/container/ID/domains"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp2.Run(c, args) },
 	}
@@ -231,12 +707,12 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "download",
-		Short: `Copy files from the container`,
+		Use:   "add-route",
+		Short: `Route requests for the path prefix on the host to a container. The host must be a subdomain of your container or a verified custom domain`,
 	}
-	tmp3 := new(DownloadContainerCommand)
+	tmp3 := new(AddRouteContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container [("/api/v2/container/ID/download"|"/api/v2/container/download")]`,
+		Use:   `container ["/api/v2/container/ID/routes"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp3.Run(c, args) },
 	}
@@ -245,12 +721,12 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "exec",
-		Short: `Exec a command with attaching to a container using WebSocket(Mainly for xterm.js, using a protocol for terminado)`,
+		Use:   "add-schedule",
+		Short: `Start or stop a container on a schedule. Specify action and schedule, or window which adds a pair of schedules starting and stopping it`,
 	}
-	tmp4 := new(ExecContainerCommand)
+	tmp4 := new(AddScheduleContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/exec"]`,
+		Use:   `container ["/api/v2/container/ID/schedules"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp4.Run(c, args) },
 	}
@@ -259,35 +735,35 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "get-config",
-		Short: `getConfig action`,
+		Use:   "add-secret",
+		Short: `Inject your secret into a container as an environment variable or a file. The container is recreated to apply it`,
 	}
-	tmp5 := new(GetConfigContainerCommand)
+	tmp5 := new(AddSecretContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/config"]`,
+		Use:   `container ["/api/v2/container/ID/secrets"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp5.Run(c, args) },
 	}
 	tmp5.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp5.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
-	tmp6 := new(GetConfigUserCommand)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "create",
+		Short: `create action`,
+	}
+	tmp6 := new(CreateContainerCommand)
 	sub = &cobra.Command{
-		Use:   `user ["/api/v2/user/config"]`,
+		Use:   `container ["/api/v2/container/create"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp6.Run(c, args) },
 	}
 	tmp6.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp6.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
-	app.AddCommand(command)
-	command = &cobra.Command{
-		Use:   "get-default-shell",
-		Short: ``,
-	}
-	tmp7 := new(GetDefaultShellUserCommand)
+	tmp7 := new(CreateJobCommand)
 	sub = &cobra.Command{
-		Use:   `user ["/api/v2/user/config/defaultShell"]`,
+		Use:   `job ["/api/v2/job/create"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp7.Run(c, args) },
 	}
@@ -296,12 +772,12 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "inspect",
-		Short: `Return details of a container`,
+		Use:   "download",
+		Short: `Copy files from the container`,
 	}
-	tmp8 := new(InspectContainerCommand)
+	tmp8 := new(DownloadContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/inspect"]`,
+		Use:   `container [("/api/v2/container/ID/download"|"/api/v2/container/download")]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp8.Run(c, args) },
 	}
@@ -310,12 +786,12 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "list",
-		Short: `Return a list of containers`,
+		Use:   "events",
+		Short: `Return events of a container, newest first`,
 	}
-	tmp9 := new(ListContainerCommand)
+	tmp9 := new(EventsContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/list"]`,
+		Use:   `container ["/api/v2/container/ID/events"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp9.Run(c, args) },
 	}
@@ -324,12 +800,12 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "list-authorized-keys",
-		Short: ``,
+		Use:   "exec",
+		Short: `Exec a command with attaching to a container using WebSocket(Mainly for xterm.js, using a protocol for terminado)`,
 	}
-	tmp10 := new(ListAuthorizedKeysUserCommand)
+	tmp10 := new(ExecContainerCommand)
 	sub = &cobra.Command{
-		Use:   `user ["/api/v2/user/config/authorizedKeys"]`,
+		Use:   `container ["/api/v2/container/ID/exec"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp10.Run(c, args) },
 	}
@@ -338,12 +814,12 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "logs",
-		Short: `Get stdout and stderr logs from a container.`,
+		Use:   "extend",
+		Short: `Change the time the container is removed at. The container is kept if neither ttl nor expiresAt is specified`,
 	}
-	tmp11 := new(LogsContainerCommand)
+	tmp11 := new(ExtendContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/logs"]`,
+		Use:   `container ["/api/v2/container/ID/extend"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp11.Run(c, args) },
 	}
@@ -352,26 +828,21 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "remove",
-		Short: `remove a container`,
+		Use:   "get-config",
+		Short: `getConfig action`,
 	}
-	tmp12 := new(RemoveContainerCommand)
+	tmp12 := new(GetConfigContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/remove"]`,
+		Use:   `container ["/api/v2/container/ID/config"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp12.Run(c, args) },
 	}
 	tmp12.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp12.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
-	app.AddCommand(command)
-	command = &cobra.Command{
-		Use:   "remove-authorized-keys",
-		Short: ``,
-	}
-	tmp13 := new(RemoveAuthorizedKeysUserCommand)
+	tmp13 := new(GetConfigUserCommand)
 	sub = &cobra.Command{
-		Use:   `user ["/api/v2/user/config/authorizedKeys"]`,
+		Use:   `user ["/api/v2/user/config"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp13.Run(c, args) },
 	}
@@ -380,61 +851,40 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "set-authorized-keys",
+		Use:   "get-default-shell",
 		Short: ``,
 	}
-	tmp14 := new(SetAuthorizedKeysUserCommand)
+	tmp14 := new(GetDefaultShellUserCommand)
 	sub = &cobra.Command{
-		Use:   `user ["/api/v2/user/config/authorizedKeys"]`,
+		Use:   `user ["/api/v2/user/config/defaultShell"]`,
 		Short: ``,
-		Long: `
-
-Payload example:
-
-[
-   {
-      "key": "nufwk5tf2z",
-      "label": "74"
-   },
-   {
-      "key": "nufwk5tf2z",
-      "label": "74"
-   }
-]`,
-		RunE: func(cmd *cobra.Command, args []string) error { return tmp14.Run(c, args) },
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp14.Run(c, args) },
 	}
 	tmp14.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp14.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "set-config",
-		Short: `Change the config of a container`,
+		Use:   "get-env",
+		Short: `Return environment variables of a container`,
 	}
-	tmp15 := new(SetConfigContainerCommand)
+	tmp15 := new(GetEnvContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/config"]`,
+		Use:   `container ["/api/v2/container/ID/env"]`,
 		Short: ``,
-		Long: `
-
-Payload example:
-
-{
-   "defaultShell": "Aut nobis saepe."
-}`,
-		RunE: func(cmd *cobra.Command, args []string) error { return tmp15.Run(c, args) },
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp15.Run(c, args) },
 	}
 	tmp15.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp15.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "set-default-shell",
-		Short: ``,
+		Use:   "get-quota",
+		Short: `Return the quota and the current usage`,
 	}
-	tmp16 := new(SetDefaultShellUserCommand)
+	tmp16 := new(GetQuotaUserCommand)
 	sub = &cobra.Command{
-		Use:   `user ["/api/v2/user/config/defaultShell"]`,
+		Use:   `user ["/api/v2/user/quota"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp16.Run(c, args) },
 	}
@@ -443,26 +893,21 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "start",
-		Short: `start a container`,
+		Use:   "inspect",
+		Short: `inspect action`,
 	}
-	tmp17 := new(StartContainerCommand)
+	tmp17 := new(InspectContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/start"]`,
+		Use:   `container ["/api/v2/container/ID/inspect"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp17.Run(c, args) },
 	}
 	tmp17.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp17.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
-	app.AddCommand(command)
-	command = &cobra.Command{
-		Use:   "stop",
-		Short: `stop a container`,
-	}
-	tmp18 := new(StopContainerCommand)
+	tmp18 := new(InspectJobCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/stop"]`,
+		Use:   `job ["/api/v2/job/ID/inspect"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp18.Run(c, args) },
 	}
@@ -471,261 +916,2205 @@ Payload example:
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "upload",
-		Short: `Copy files to the container`,
+		Use:   "list",
+		Short: `list action`,
 	}
-	tmp19 := new(UploadContainerCommand)
+	tmp19 := new(ListContainerCommand)
 	sub = &cobra.Command{
-		Use:   `container ["/api/v2/container/ID/upload"]`,
+		Use:   `container ["/api/v2/container/list"]`,
 		Short: ``,
-		Long: `
-
-Payload example:
-
-{
-   "allowOverwrite": false,
-   "copyUIDGID": true,
-   "data": "Quas omnis tenetur ut.jpg",
-   "path": "Fugit aut officia."
-}`,
-		RunE: func(cmd *cobra.Command, args []string) error { return tmp19.Run(c, args) },
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp19.Run(c, args) },
 	}
 	tmp19.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp19.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
+	tmp20 := new(ListJobCommand)
+	sub = &cobra.Command{
+		Use:   `job ["/api/v2/job/list"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp20.Run(c, args) },
+	}
+	tmp20.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp20.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
 	app.AddCommand(command)
-
-	dl := new(DownloadCommand)
-	dlc := &cobra.Command{
-		Use:   "download [PATH]",
-		Short: "Download file with given path",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			return dl.Run(c, args)
-		},
+	command = &cobra.Command{
+		Use:   "list-authorized-keys",
+		Short: ``,
 	}
-	dlc.Flags().StringVar(&dl.OutFile, "out", "", "Output file")
-	app.AddCommand(dlc)
-}
-
-func intFlagVal(name string, parsed int) *int {
-	if hasFlag(name) {
-		return &parsed
+	tmp21 := new(ListAuthorizedKeysUserCommand)
+	sub = &cobra.Command{
+		Use:   `user ["/api/v2/user/config/authorizedKeys"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp21.Run(c, args) },
 	}
-	return nil
-}
-
-func float64FlagVal(name string, parsed float64) *float64 {
-	if hasFlag(name) {
-		return &parsed
+	tmp21.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp21.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "list-domains",
+		Short: `Return custom domains of a container`,
 	}
-	return nil
-}
-
-func boolFlagVal(name string, parsed bool) *bool {
-	if hasFlag(name) {
-		return &parsed
+	tmp22 := new(ListDomainsContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/domains"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp22.Run(c, args) },
 	}
-	return nil
-}
-
-func stringFlagVal(name string, parsed string) *string {
-	if hasFlag(name) {
-		return &parsed
+	tmp22.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp22.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "list-routes",
+		Short: `Return path-prefix routes to a container`,
 	}
-	return nil
-}
-
-func hasFlag(name string) bool {
-	for _, arg := range os.Args[1:] {
-		if strings.HasPrefix(arg, "--"+name) {
-			return true
-		}
+	tmp23 := new(ListRoutesContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/routes"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp23.Run(c, args) },
 	}
-	return false
-}
-
-func jsonVal(val string) (*interface{}, error) {
-	var t interface{}
-	err := json.Unmarshal([]byte(val), &t)
-	if err != nil {
-		return nil, err
+	tmp23.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp23.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "list-schedules",
+		Short: `Return schedules starting or stopping a container`,
 	}
-	return &t, nil
-}
-
-func jsonArray(ins []string) ([]interface{}, error) {
-	if ins == nil {
-		return nil, nil
+	tmp24 := new(ListSchedulesContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/schedules"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp24.Run(c, args) },
 	}
-	var vals []interface{}
-	for _, id := range ins {
-		val, err := jsonVal(id)
-		if err != nil {
-			return nil, err
-		}
-		vals = append(vals, val)
+	tmp24.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp24.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "list-secrets",
+		Short: `listSecrets action`,
 	}
-	return vals, nil
-}
-
-func timeVal(val string) (*time.Time, error) {
-	t, err := time.Parse(time.RFC3339, val)
-	if err != nil {
-		return nil, err
+	tmp25 := new(ListSecretsContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/secrets"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp25.Run(c, args) },
 	}
-	return &t, nil
-}
-
-func timeArray(ins []string) ([]time.Time, error) {
-	if ins == nil {
-		return nil, nil
+	tmp25.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp25.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	tmp26 := new(ListSecretsUserCommand)
+	sub = &cobra.Command{
+		Use:   `user ["/api/v2/user/secrets"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp26.Run(c, args) },
 	}
-	var vals []time.Time
-	for _, id := range ins {
-		val, err := timeVal(id)
-		if err != nil {
-			return nil, err
-		}
-		vals = append(vals, *val)
+	tmp26.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp26.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "logs",
+		Short: `Get stdout and stderr logs from a container. Lines are prefixed with [replica <n>] if the container has multiple replicas`,
 	}
-	return vals, nil
-}
-
-func uuidVal(val string) (*uuid.UUID, error) {
-	t, err := uuid.FromString(val)
-	if err != nil {
-		return nil, err
+	tmp27 := new(LogsContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/logs"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp27.Run(c, args) },
 	}
-	return &t, nil
-}
-
-func uuidArray(ins []string) ([]uuid.UUID, error) {
-	if ins == nil {
-		return nil, nil
+	tmp27.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp27.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "metrics",
+		Short: `Return the history of the resource usage of a container. Samples are taken every minute and rolled up into hours after a day`,
 	}
-	var vals []uuid.UUID
-	for _, id := range ins {
-		val, err := uuidVal(id)
-		if err != nil {
-			return nil, err
-		}
-		vals = append(vals, *val)
+	tmp28 := new(MetricsContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/metrics"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp28.Run(c, args) },
 	}
-	return vals, nil
-}
-
-func float64Val(val string) (*float64, error) {
-	t, err := strconv.ParseFloat(val, 64)
-	if err != nil {
-		return nil, err
+	tmp28.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp28.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "pause",
+		Short: `pause all processes in a container`,
 	}
-	return &t, nil
-}
-
-func float64Array(ins []string) ([]float64, error) {
-	if ins == nil {
-		return nil, nil
+	tmp29 := new(PauseContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/pause"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp29.Run(c, args) },
 	}
-	var vals []float64
-	for _, id := range ins {
-		val, err := float64Val(id)
-		if err != nil {
+	tmp29.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp29.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "redeploy",
+		Short: `Recreate a container with a new image or configuration keeping its id, name and volumes. Omitted parameters are taken over from the current container`,
+	}
+	tmp30 := new(RedeployContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/redeploy"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp30.Run(c, args) },
+	}
+	tmp30.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp30.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "releases",
+		Short: `Return the release history of a container, newest first`,
+	}
+	tmp31 := new(ReleasesContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/releases"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp31.Run(c, args) },
+	}
+	tmp31.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp31.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "remove",
+		Short: `remove action`,
+	}
+	tmp32 := new(RemoveContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/remove"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp32.Run(c, args) },
+	}
+	tmp32.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp32.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	tmp33 := new(RemoveJobCommand)
+	sub = &cobra.Command{
+		Use:   `job ["/api/v2/job/ID/remove"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp33.Run(c, args) },
+	}
+	tmp33.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp33.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "remove-authorized-keys",
+		Short: ``,
+	}
+	tmp34 := new(RemoveAuthorizedKeysUserCommand)
+	sub = &cobra.Command{
+		Use:   `user ["/api/v2/user/config/authorizedKeys"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp34.Run(c, args) },
+	}
+	tmp34.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp34.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "remove-domain",
+		Short: `Remove a custom domain from a container`,
+	}
+	tmp35 := new(RemoveDomainContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/domains"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp35.Run(c, args) },
+	}
+	tmp35.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp35.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "remove-env",
+		Short: `Remove environment variables from a container. The container is recreated to apply them`,
+	}
+	tmp36 := new(RemoveEnvContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/env"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp36.Run(c, args) },
+	}
+	tmp36.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp36.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "remove-route",
+		Short: `Remove a path-prefix route from a container`,
+	}
+	tmp37 := new(RemoveRouteContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/routes"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp37.Run(c, args) },
+	}
+	tmp37.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp37.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "remove-schedule",
+		Short: `Remove a schedule from a container`,
+	}
+	tmp38 := new(RemoveScheduleContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/schedules"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp38.Run(c, args) },
+	}
+	tmp38.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp38.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "remove-secret",
+		Short: `removeSecret action`,
+	}
+	tmp39 := new(RemoveSecretContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/secrets"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp39.Run(c, args) },
+	}
+	tmp39.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp39.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	tmp40 := new(RemoveSecretUserCommand)
+	sub = &cobra.Command{
+		Use:   `user ["/api/v2/user/secrets"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp40.Run(c, args) },
+	}
+	tmp40.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp40.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "restart",
+		Short: `restart a container`,
+	}
+	tmp41 := new(RestartContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/restart"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp41.Run(c, args) },
+	}
+	tmp41.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp41.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "rollback",
+		Short: `Recreate a container from a prior release`,
+	}
+	tmp42 := new(RollbackContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/rollback"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp42.Run(c, args) },
+	}
+	tmp42.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp42.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "run",
+		Short: `run action`,
+	}
+	tmp43 := new(RunContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/run"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp43.Run(c, args) },
+	}
+	tmp43.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp43.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	tmp44 := new(RunJobCommand)
+	sub = &cobra.Command{
+		Use:   `job ["/api/v2/job/ID/run"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp44.Run(c, args) },
+	}
+	tmp44.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp44.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "run-stream",
+		Short: `Run a command in a one-off container streaming stdout and stderr as binary messages. The result is sent as a JSON text message(vpn.application/goa.container.run.result+json without output) at the end`,
+	}
+	tmp45 := new(RunStreamContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/run/stream"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp45.Run(c, args) },
+	}
+	tmp45.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp45.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "runs",
+		Short: `Return the run history of the job, newest first`,
+	}
+	tmp46 := new(RunsJobCommand)
+	sub = &cobra.Command{
+		Use:   `job ["/api/v2/job/ID/runs"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp46.Run(c, args) },
+	}
+	tmp46.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp46.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "scale",
+		Short: `Change the number of replicas of a container. Requests are balanced among the replicas, which share the volumes`,
+	}
+	tmp47 := new(ScaleContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/scale"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp47.Run(c, args) },
+	}
+	tmp47.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp47.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "schedule-logs",
+		Short: `Return the actions taken by the schedules of a container, newest first`,
+	}
+	tmp48 := new(ScheduleLogsContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/schedules/logs"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp48.Run(c, args) },
+	}
+	tmp48.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp48.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-authorized-keys",
+		Short: ``,
+	}
+	tmp49 := new(SetAuthorizedKeysUserCommand)
+	sub = &cobra.Command{
+		Use:   `user ["/api/v2/user/config/authorizedKeys"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+[
+   {
+      "key": "nufwk5tf2z",
+      "label": "74"
+   },
+   {
+      "key": "nufwk5tf2z",
+      "label": "74"
+   }
+]`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp49.Run(c, args) },
+	}
+	tmp49.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp49.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-config",
+		Short: `Change the config of a container`,
+	}
+	tmp50 := new(SetConfigContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/config"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "defaultShell": "Aut nobis saepe."
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp50.Run(c, args) },
+	}
+	tmp50.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp50.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-default-shell",
+		Short: ``,
+	}
+	tmp51 := new(SetDefaultShellUserCommand)
+	sub = &cobra.Command{
+		Use:   `user ["/api/v2/user/config/defaultShell"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp51.Run(c, args) },
+	}
+	tmp51.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp51.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-env",
+		Short: `Add or update environment variables of a container. The container is recreated to apply them`,
+	}
+	tmp52 := new(SetEnvContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/env"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+[
+   {
+      "name": "r3ehpy5jei",
+      "secret": false,
+      "value": "Porro numquam."
+   },
+   {
+      "name": "r3ehpy5jei",
+      "secret": false,
+      "value": "Porro numquam."
+   }
+]`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp52.Run(c, args) },
+	}
+	tmp52.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp52.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-limits",
+		Short: `Update the resource limits of a container without restarting it. Limits not specified are left unchanged and 0 removes them up to the maximum set by the administrator. The storage can not be changed`,
+	}
+	tmp53 := new(SetLimitsContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/limits"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp53.Run(c, args) },
+	}
+	tmp53.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp53.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-quota",
+		Short: `Override the default quota of a user. Missing ones use the defaults and 0 is unlimited. Only the administrators listed in modoki/admins on consul can set quotas`,
+	}
+	tmp54 := new(SetQuotaUserCommand)
+	sub = &cobra.Command{
+		Use:   `user ["/api/v2/user/quota/UID"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "containers": 0,
+   "cpu": 0,
+   "memory": "Non quis fugit velit.",
+   "storage": "Autem ducimus doloribus vel libero consequatur.",
+   "volumes": 1
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp54.Run(c, args) },
+	}
+	tmp54.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp54.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-secret",
+		Short: `Add or update a secret. Containers using it get the new value when they are recreated`,
+	}
+	tmp55 := new(SetSecretUserCommand)
+	sub = &cobra.Command{
+		Use:   `user ["/api/v2/user/secrets"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "name": "0qqinch2",
+   "value": "r6477hd66o"
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp55.Run(c, args) },
+	}
+	tmp55.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp55.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "start",
+		Short: `start a container`,
+	}
+	tmp56 := new(StartContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/start"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp56.Run(c, args) },
+	}
+	tmp56.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp56.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "stats",
+		Short: `Return the current resource usage of each replica of a container`,
+	}
+	tmp57 := new(StatsContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/stats"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp57.Run(c, args) },
+	}
+	tmp57.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp57.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "stats-stream",
+		Short: `Stream the resource usage of each replica of a container. Every message is a JSON of vpn.application/goa.container.stats+json sent about every second per replica`,
+	}
+	tmp58 := new(StatsStreamContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/stats/stream"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp58.Run(c, args) },
+	}
+	tmp58.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp58.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "stop",
+		Short: `stop a container`,
+	}
+	tmp59 := new(StopContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/stop"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp59.Run(c, args) },
+	}
+	tmp59.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp59.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "unpause",
+		Short: `unpause all processes in a container`,
+	}
+	tmp60 := new(UnpauseContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/unpause"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp60.Run(c, args) },
+	}
+	tmp60.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp60.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "upload",
+		Short: `Copy files to the container`,
+	}
+	tmp61 := new(UploadContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/upload"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "allowOverwrite": true,
+   "copyUIDGID": true,
+   "data": "Quas omnis tenetur ut.jpg",
+   "path": "Fugit aut officia."
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp61.Run(c, args) },
+	}
+	tmp61.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp61.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "verify-domain",
+		Short: `Verify the ownership of a custom domain with the TXT record or the HTTP token`,
+	}
+	tmp62 := new(VerifyDomainContainerCommand)
+	sub = &cobra.Command{
+		Use:   `container ["/api/v2/container/ID/domains/verify"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp62.Run(c, args) },
+	}
+	tmp62.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp62.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+
+	dl := new(DownloadCommand)
+	dlc := &cobra.Command{
+		Use:   "download [PATH]",
+		Short: "Download file with given path",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return dl.Run(c, args)
+		},
+	}
+	dlc.Flags().StringVar(&dl.OutFile, "out", "", "Output file")
+	app.AddCommand(dlc)
+}
+
+func intFlagVal(name string, parsed int) *int {
+	if hasFlag(name) {
+		return &parsed
+	}
+	return nil
+}
+
+func float64FlagVal(name string, parsed float64) *float64 {
+	if hasFlag(name) {
+		return &parsed
+	}
+	return nil
+}
+
+func boolFlagVal(name string, parsed bool) *bool {
+	if hasFlag(name) {
+		return &parsed
+	}
+	return nil
+}
+
+func stringFlagVal(name string, parsed string) *string {
+	if hasFlag(name) {
+		return &parsed
+	}
+	return nil
+}
+
+func hasFlag(name string) bool {
+	for _, arg := range os.Args[1:] {
+		if strings.HasPrefix(arg, "--"+name) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonVal(val string) (*interface{}, error) {
+	var t interface{}
+	err := json.Unmarshal([]byte(val), &t)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func jsonArray(ins []string) ([]interface{}, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []interface{}
+	for _, id := range ins {
+		val, err := jsonVal(id)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+func timeVal(val string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func timeArray(ins []string) ([]time.Time, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []time.Time
+	for _, id := range ins {
+		val, err := timeVal(id)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	return vals, nil
+}
+
+func uuidVal(val string) (*uuid.UUID, error) {
+	t, err := uuid.FromString(val)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func uuidArray(ins []string) ([]uuid.UUID, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []uuid.UUID
+	for _, id := range ins {
+		val, err := uuidVal(id)
+		if err != nil {
 			return nil, err
 		}
-		vals = append(vals, *val)
+		vals = append(vals, *val)
+	}
+	return vals, nil
+}
+
+func float64Val(val string) (*float64, error) {
+	t, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func float64Array(ins []string) ([]float64, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []float64
+	for _, id := range ins {
+		val, err := float64Val(id)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	return vals, nil
+}
+
+func boolVal(val string) (*bool, error) {
+	t, err := strconv.ParseBool(val)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func boolArray(ins []string) ([]bool, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []bool
+	for _, id := range ins {
+		val, err := boolVal(id)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	return vals, nil
+}
+
+// Run downloads files with given paths.
+func (cmd *DownloadCommand) Run(c *client.Client, args []string) error {
+	var (
+		fnf func(context.Context, string) (int64, error)
+		fnd func(context.Context, string, string) (int64, error)
+
+		rpath   = args[0]
+		outfile = cmd.OutFile
+		logger  = goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+		ctx     = goa.WithLogger(context.Background(), logger)
+		err     error
+	)
+
+	if rpath[0] != '/' {
+		rpath = "/" + rpath
+	}
+	if rpath == "/api/v2/swagger/swagger.json" {
+		fnf = c.DownloadSwaggerJSON
+		if outfile == "" {
+			outfile = "swagger.json"
+		}
+		goto found
+	}
+	if rpath == "/api/v2/swagger/swagger.yaml" {
+		fnf = c.DownloadSwaggerYaml
+		if outfile == "" {
+			outfile = "swagger.yaml"
+		}
+		goto found
+	}
+	return fmt.Errorf("don't know how to download %s", rpath)
+found:
+	ctx = goa.WithLogContext(ctx, "file", outfile)
+	if fnf != nil {
+		_, err = fnf(ctx, outfile)
+	} else {
+		_, err = fnd(ctx, rpath, outfile)
+	}
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// Run makes the HTTP request corresponding to the AddDomainContainerCommand command.
+func (cmd *AddDomainContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/domains", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.AddDomainContainer(ctx, path, cmd.Domain)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *AddDomainContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var domain string
+	cc.Flags().StringVar(&cmd.Domain, "domain", domain, `Domain name`)
+}
+
+// Run makes the HTTP request corresponding to the AddRouteContainerCommand command.
+func (cmd *AddRouteContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/routes", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp63 *bool
+	if cmd.StripPrefix != "" {
+		var err error
+		tmp63, err = boolVal(cmd.StripPrefix)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--stripPrefix", "err", err)
+			return err
+		}
+	}
+	resp, err := c.AddRouteContainer(ctx, path, cmd.Host, cmd.PathPrefix, intFlagVal("priority", cmd.Priority), tmp63)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *AddRouteContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var host string
+	cc.Flags().StringVar(&cmd.Host, "host", host, `Host name`)
+	var pathPrefix string
+	cc.Flags().StringVar(&cmd.PathPrefix, "pathPrefix", pathPrefix, `Path prefix`)
+	var priority int
+	cc.Flags().IntVar(&cmd.Priority, "priority", priority, `Priority of the route. Routes with higher priority are matched first`)
+	var stripPrefix string
+	cc.Flags().StringVar(&cmd.StripPrefix, "stripPrefix", stripPrefix, `Strip the prefix before forwarding requests`)
+}
+
+// Run makes the HTTP request corresponding to the AddScheduleContainerCommand command.
+func (cmd *AddScheduleContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/schedules", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.AddScheduleContainer(ctx, path, stringFlagVal("action", cmd.Action), stringFlagVal("schedule", cmd.Schedule), stringFlagVal("timezone", cmd.Timezone), stringFlagVal("window", cmd.Window))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *AddScheduleContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var action string
+	cc.Flags().StringVar(&cmd.Action, "action", action, `Action taken on the schedule`)
+	var schedule string
+	cc.Flags().StringVar(&cmd.Schedule, "schedule", schedule, `Cron expression(minute hour day month weekday) or a descriptor such as @daily`)
+	cc.Flags().StringVar(&cmd.Timezone, "timezone", "UTC", `IANA time zone the schedule is in, such as Asia/Tokyo`)
+	var window string
+	cc.Flags().StringVar(&cmd.Window, "window", window, `Days and time the container runs in, such as mon-fri 09:00-18:00. Days are in the weekday format of cron and can be omitted for every day`)
+}
+
+// Run makes the HTTP request corresponding to the AddSecretContainerCommand command.
+func (cmd *AddSecretContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/secrets", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp64 *bool
+	if cmd.Deferred != "" {
+		var err error
+		tmp64, err = boolVal(cmd.Deferred)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--deferred", "err", err)
+			return err
+		}
+	}
+	resp, err := c.AddSecretContainer(ctx, path, cmd.Name, tmp64, stringFlagVal("env", cmd.Env), stringFlagVal("file", cmd.File))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *AddSecretContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var deferred string
+	cc.Flags().StringVar(&cmd.Deferred, "deferred", deferred, `Apply the changes at the next restart instead of recreating the container now`)
+	var env string
+	cc.Flags().StringVar(&cmd.Env, "env", env, `Environment variable to inject the secret as`)
+	var file string
+	cc.Flags().StringVar(&cmd.File, "file", file, `Absolute path of the file to copy the secret to`)
+	var name string
+	cc.Flags().StringVar(&cmd.Name, "name", name, `Name of the secret`)
+}
+
+// Run makes the HTTP request corresponding to the CreateContainerCommand command.
+func (cmd *CreateContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/container/create"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp65 *time.Time
+	if cmd.ExpiresAt != "" {
+		var err error
+		tmp65, err = timeVal(cmd.ExpiresAt)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *time.Time value", "flag", "--expiresAt", "err", err)
+			return err
+		}
+	}
+	var tmp66 *bool
+	if cmd.SslRedirect != "" {
+		var err error
+		tmp66, err = boolVal(cmd.SslRedirect)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--sslRedirect", "err", err)
+			return err
+		}
+	}
+	resp, err := c.CreateContainer(ctx, path, cmd.Image, cmd.Name, cmd.Command, intFlagVal("cpu", cmd.CPU), cmd.Entrypoint, cmd.Env, tmp65, cmd.HealthCheckCommand, intFlagVal("healthCheckInterval", cmd.HealthCheckInterval), stringFlagVal("healthCheckPath", cmd.HealthCheckPath), intFlagVal("healthCheckRetries", cmd.HealthCheckRetries), intFlagVal("healthCheckStartPeriod", cmd.HealthCheckStartPeriod), intFlagVal("healthCheckTimeout", cmd.HealthCheckTimeout), intFlagVal("idleTimeout", cmd.IdleTimeout), stringFlagVal("kind", cmd.Kind), stringFlagVal("memory", cmd.Memory), stringFlagVal("metricsPath", cmd.MetricsPath), intFlagVal("metricsPort", cmd.MetricsPort), intFlagVal("pids", cmd.Pids), intFlagVal("port", cmd.Port), cmd.Ports, stringFlagVal("protocol", cmd.Protocol), intFlagVal("replicas", cmd.Replicas), intFlagVal("restartMaxRetries", cmd.RestartMaxRetries), stringFlagVal("restartPolicy", cmd.RestartPolicy), cmd.Secrets, tmp66, stringFlagVal("storage", cmd.Storage), intFlagVal("ttl", cmd.TTL), cmd.Volumes, stringFlagVal("workingDir", cmd.WorkingDir))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *CreateContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var command []string
+	cc.Flags().StringSliceVar(&cmd.Command, "command", command, `Command to run specified as a string or an array of strings.`)
+	var cpu int
+	cc.Flags().IntVar(&cmd.CPU, "cpu", cpu, `Percentage of a CPU the container can use. Defaults to the maximum set by the administrator`)
+	var entrypoint []string
+	cc.Flags().StringSliceVar(&cmd.Entrypoint, "entrypoint", entrypoint, `The entry point for the container as a string or an array of strings`)
+	var env []string
+	cc.Flags().StringSliceVar(&cmd.Env, "env", env, `Environment variables`)
+	var expiresAt string
+	cc.Flags().StringVar(&cmd.ExpiresAt, "expiresAt", expiresAt, `The time the container is removed at`)
+	var healthCheckCommand []string
+	cc.Flags().StringSliceVar(&cmd.HealthCheckCommand, "healthCheckCommand", healthCheckCommand, `Command run in the container to check the health. Healthy if it exits with 0`)
+	cc.Flags().IntVar(&cmd.HealthCheckInterval, "healthCheckInterval", 30, `Seconds between health checks`)
+	var healthCheckPath string
+	cc.Flags().StringVar(&cmd.HealthCheckPath, "healthCheckPath", healthCheckPath, `Path requested over HTTP on the port to check the health. The image needs curl or wget`)
+	cc.Flags().IntVar(&cmd.HealthCheckRetries, "healthCheckRetries", 3, `Consecutive failures to become unhealthy`)
+	var healthCheckStartPeriod int
+	cc.Flags().IntVar(&cmd.HealthCheckStartPeriod, "healthCheckStartPeriod", healthCheckStartPeriod, `Seconds for the container to initialize, in which failures are not counted`)
+	cc.Flags().IntVar(&cmd.HealthCheckTimeout, "healthCheckTimeout", 10, `Seconds to wait for a health check to finish`)
+	var idleTimeout int
+	cc.Flags().IntVar(&cmd.IdleTimeout, "idleTimeout", idleTimeout, `Seconds without incoming traffic until the container sleeps. A sleeping container is started by the next request. 0 disables sleeping`)
+	var image string
+	cc.Flags().StringVar(&cmd.Image, "image", image, `Name of image`)
+	cc.Flags().StringVar(&cmd.Kind, "kind", "web", `web is served on the subdomain. worker has no endpoint. private is not exposed publicly and reachable from other containers by <name>.<uid>`)
+	var memory string
+	cc.Flags().StringVar(&cmd.Memory, "memory", memory, `Memory the container can use such as 512M. Defaults to the maximum set by the administrator`)
+	var metricsPath string
+	cc.Flags().StringVar(&cmd.MetricsPath, "metricsPath", metricsPath, `Path of the Prometheus metrics. Defaults to /metrics`)
+	var metricsPort int
+	cc.Flags().IntVar(&cmd.MetricsPort, "metricsPort", metricsPort, `Port the container serves Prometheus metrics on. The container is listed in the service discovery for Prometheus`)
+	var name string
+	cc.Flags().StringVar(&cmd.Name, "name", name, `Name of container and subdomain`)
+	var pids int
+	cc.Flags().IntVar(&cmd.Pids, "pids", pids, `Maximum number of processes in the container. Defaults to the maximum set by the administrator`)
+	var port int
+	cc.Flags().IntVar(&cmd.Port, "port", port, `Port the container serves HTTP on. Defaults to the port exposed by the image, or 80`)
+	var ports []string
+	cc.Flags().StringSliceVar(&cmd.Ports, "ports", ports, `Additional ports exposed on their own subdomains(<port name>.<container name>.<addr>), specified as name:port or name:port/protocol`)
+	cc.Flags().StringVar(&cmd.Protocol, "protocol", "http", `Protocol the container serves on the port`)
+	cc.Flags().IntVar(&cmd.Replicas, "replicas", 1, `Number of containers run behind the subdomain`)
+	var restartMaxRetries int
+	cc.Flags().IntVar(&cmd.RestartMaxRetries, "restartMaxRetries", restartMaxRetries, `Maximum number of restarts with on-failure. 0 means unlimited`)
+	cc.Flags().StringVar(&cmd.RestartPolicy, "restartPolicy", "no", `Policy to restart the container when it exits. Containers dying repeatedly shortly after starting are restarted with exponential backoff`)
+	var secrets []string
+	cc.Flags().StringSliceVar(&cmd.Secrets, "secrets", secrets, `Your secrets injected into the container, specified as <secret>(as the env var of the same name), <secret>:<env var> or <secret>:<absolute file path>`)
+	cc.Flags().StringVar(&cmd.SslRedirect, "sslRedirect", "true", `Whether HTTP is redirected to HTTPS`)
+	var storage string
+	cc.Flags().StringVar(&cmd.Storage, "storage", storage, `Storage the container can use such as 10G. Defaults to the maximum set by the administrator`)
+	var ttl int
+	cc.Flags().IntVar(&cmd.TTL, "ttl", ttl, `Seconds until the container is removed`)
+	var volumes []string
+	cc.Flags().StringSliceVar(&cmd.Volumes, "volumes", volumes, `Path to volumes in a container`)
+	var workingDir string
+	cc.Flags().StringVar(&cmd.WorkingDir, "workingDir", workingDir, `Current directory (PWD) in the command will be launched`)
+}
+
+// Run makes the HTTP request corresponding to the DownloadContainerCommand command.
+func (cmd *DownloadContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/container/download"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.DownloadContainer(ctx, path, cmd.InternalPath)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *DownloadContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `ID or name`)
+	var internalPath string
+	cc.Flags().StringVar(&cmd.InternalPath, "internalPath", internalPath, `Path in the container to save files`)
+}
+
+// Run makes the HTTP request corresponding to the EventsContainerCommand command.
+func (cmd *EventsContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/events", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.EventsContainer(ctx, path, intFlagVal("limit", cmd.Limit))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *EventsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	cc.Flags().IntVar(&cmd.Limit, "limit", 50, `Maximum number of events`)
+}
+
+// Run establishes a websocket connection for the ExecContainerCommand command.
+func (cmd *ExecContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/exec", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp67 *bool
+	if cmd.Tty != "" {
+		var err error
+		tmp67, err = boolVal(cmd.Tty)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--tty", "err", err)
+			return err
+		}
+	}
+	ws, err := c.ExecContainer(ctx, path, cmd.Command, tmp67)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+	go goaclient.WSWrite(ws)
+	goaclient.WSRead(ws)
+
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ExecContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var command []string
+	cc.Flags().StringSliceVar(&cmd.Command, "command", command, `The path to the executable file`)
+	var tty string
+	cc.Flags().StringVar(&cmd.Tty, "tty", tty, `Tty`)
+}
+
+// Run makes the HTTP request corresponding to the ExtendContainerCommand command.
+func (cmd *ExtendContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/extend", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp68 *time.Time
+	if cmd.ExpiresAt != "" {
+		var err error
+		tmp68, err = timeVal(cmd.ExpiresAt)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *time.Time value", "flag", "--expiresAt", "err", err)
+			return err
+		}
+	}
+	resp, err := c.ExtendContainer(ctx, path, tmp68, intFlagVal("ttl", cmd.TTL))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ExtendContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var expiresAt string
+	cc.Flags().StringVar(&cmd.ExpiresAt, "expiresAt", expiresAt, `The time the container is removed at`)
+	var ttl int
+	cc.Flags().IntVar(&cmd.TTL, "ttl", ttl, `Seconds from now until the container is removed`)
+}
+
+// Run makes the HTTP request corresponding to the GetConfigContainerCommand command.
+func (cmd *GetConfigContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/config", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetConfigContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetConfigContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run makes the HTTP request corresponding to the GetEnvContainerCommand command.
+func (cmd *GetEnvContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/env", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetEnvContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetEnvContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run makes the HTTP request corresponding to the InspectContainerCommand command.
+func (cmd *InspectContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/inspect", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.InspectContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *InspectContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `ID or name`)
+}
+
+// Run makes the HTTP request corresponding to the ListContainerCommand command.
+func (cmd *ListContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/container/list"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ListContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ListContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+}
+
+// Run makes the HTTP request corresponding to the ListDomainsContainerCommand command.
+func (cmd *ListDomainsContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/domains", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ListDomainsContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ListDomainsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run makes the HTTP request corresponding to the ListRoutesContainerCommand command.
+func (cmd *ListRoutesContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/routes", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ListRoutesContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ListRoutesContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run makes the HTTP request corresponding to the ListSchedulesContainerCommand command.
+func (cmd *ListSchedulesContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/schedules", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ListSchedulesContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ListSchedulesContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run makes the HTTP request corresponding to the ListSecretsContainerCommand command.
+func (cmd *ListSecretsContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/secrets", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ListSecretsContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ListSecretsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run establishes a websocket connection for the LogsContainerCommand command.
+func (cmd *LogsContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/logs", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp69 *bool
+	if cmd.Follow != "" {
+		var err error
+		tmp69, err = boolVal(cmd.Follow)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--follow", "err", err)
+			return err
+		}
+	}
+	var tmp70 *time.Time
+	if cmd.Since != "" {
+		var err error
+		tmp70, err = timeVal(cmd.Since)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *time.Time value", "flag", "--since", "err", err)
+			return err
+		}
+	}
+	var tmp71 *bool
+	if cmd.Stderr != "" {
+		var err error
+		tmp71, err = boolVal(cmd.Stderr)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--stderr", "err", err)
+			return err
+		}
+	}
+	var tmp72 *bool
+	if cmd.Stdout != "" {
+		var err error
+		tmp72, err = boolVal(cmd.Stdout)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--stdout", "err", err)
+			return err
+		}
+	}
+	var tmp73 *bool
+	if cmd.Timestamps != "" {
+		var err error
+		tmp73, err = boolVal(cmd.Timestamps)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--timestamps", "err", err)
+			return err
+		}
+	}
+	var tmp74 *time.Time
+	if cmd.Until != "" {
+		var err error
+		tmp74, err = timeVal(cmd.Until)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *time.Time value", "flag", "--until", "err", err)
+			return err
+		}
+	}
+	ws, err := c.LogsContainer(ctx, path, tmp69, tmp70, tmp71, tmp72, stringFlagVal("tail", cmd.Tail), tmp73, tmp74)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+	go goaclient.WSWrite(ws)
+	goaclient.WSRead(ws)
+
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *LogsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var follow string
+	cc.Flags().StringVar(&cmd.Follow, "follow", follow, ``)
+	var since string
+	cc.Flags().StringVar(&cmd.Since, "since", since, ``)
+	var stderr string
+	cc.Flags().StringVar(&cmd.Stderr, "stderr", stderr, ``)
+	var stdout string
+	cc.Flags().StringVar(&cmd.Stdout, "stdout", stdout, ``)
+	cc.Flags().StringVar(&cmd.Tail, "tail", "all", ``)
+	var timestamps string
+	cc.Flags().StringVar(&cmd.Timestamps, "timestamps", timestamps, ``)
+	var until string
+	cc.Flags().StringVar(&cmd.Until, "until", until, ``)
+}
+
+// Run makes the HTTP request corresponding to the MetricsContainerCommand command.
+func (cmd *MetricsContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/metrics", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp75 *time.Time
+	if cmd.From != "" {
+		var err error
+		tmp75, err = timeVal(cmd.From)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *time.Time value", "flag", "--from", "err", err)
+			return err
+		}
+	}
+	var tmp76 *time.Time
+	if cmd.To != "" {
+		var err error
+		tmp76, err = timeVal(cmd.To)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *time.Time value", "flag", "--to", "err", err)
+			return err
+		}
+	}
+	resp, err := c.MetricsContainer(ctx, path, tmp75, intFlagVal("step", cmd.Step), tmp76)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *MetricsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var from string
+	cc.Flags().StringVar(&cmd.From, "from", from, `The start of the series. Defaults to an hour before to`)
+	cc.Flags().IntVar(&cmd.Step, "step", 60, `Seconds between the points`)
+	var to string
+	cc.Flags().StringVar(&cmd.To, "to", to, `The end of the series. Defaults to now`)
+}
+
+// Run makes the HTTP request corresponding to the PauseContainerCommand command.
+func (cmd *PauseContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/pause", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.PauseContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *PauseContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run makes the HTTP request corresponding to the RedeployContainerCommand command.
+func (cmd *RedeployContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/redeploy", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.RedeployContainer(ctx, path, cmd.Command, intFlagVal("drainPeriod", cmd.DrainPeriod), cmd.Entrypoint, cmd.Env, intFlagVal("healthTimeout", cmd.HealthTimeout), stringFlagVal("image", cmd.Image), stringFlagVal("strategy", cmd.Strategy), intFlagVal("timeout", cmd.Timeout), stringFlagVal("workingDir", cmd.WorkingDir))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RedeployContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var command []string
+	cc.Flags().StringSliceVar(&cmd.Command, "command", command, `Command to run specified as a string or an array of strings.`)
+	cc.Flags().IntVar(&cmd.DrainPeriod, "drainPeriod", 10, `Seconds to keep the current container serving in-flight requests after switching in blueGreen`)
+	var entrypoint []string
+	cc.Flags().StringSliceVar(&cmd.Entrypoint, "entrypoint", entrypoint, `The entry point for the container as a string or an array of strings`)
+	var env []string
+	cc.Flags().StringSliceVar(&cmd.Env, "env", env, `Environment variables`)
+	cc.Flags().IntVar(&cmd.HealthTimeout, "healthTimeout", 60, `Seconds to wait for the new container to become healthy in blueGreen. It is rolled back on timeout`)
+	var image string
+	cc.Flags().StringVar(&cmd.Image, "image", image, `Name of image. The current image is pulled again if omitted`)
+	cc.Flags().StringVar(&cmd.Strategy, "strategy", "recreate", `recreate: stop the current container and start the new one, blueGreen: switch to the new container after it becomes healthy without downtime`)
+	cc.Flags().IntVar(&cmd.Timeout, "timeout", 15, `Seconds to wait before killing the current container`)
+	var workingDir string
+	cc.Flags().StringVar(&cmd.WorkingDir, "workingDir", workingDir, `Current directory (PWD) in the command will be launched`)
+}
+
+// Run makes the HTTP request corresponding to the ReleasesContainerCommand command.
+func (cmd *ReleasesContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/releases", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ReleasesContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ReleasesContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run makes the HTTP request corresponding to the RemoveContainerCommand command.
+func (cmd *RemoveContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/remove", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp77 *bool
+	if cmd.Force != "" {
+		var err error
+		tmp77, err = boolVal(cmd.Force)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--force", "err", err)
+			return err
+		}
+	}
+	if tmp77 == nil {
+		goa.LogError(ctx, "required flag is missing", "flag", "--force")
+		return fmt.Errorf("required flag force is missing")
+	}
+	resp, err := c.RemoveContainer(ctx, path, *tmp77)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RemoveContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var force string
+	cc.Flags().StringVar(&cmd.Force, "force", force, `If the container is running, kill it before removing it.`)
+}
+
+// Run makes the HTTP request corresponding to the RemoveDomainContainerCommand command.
+func (cmd *RemoveDomainContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/domains", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.RemoveDomainContainer(ctx, path, cmd.Domain)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RemoveDomainContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var domain string
+	cc.Flags().StringVar(&cmd.Domain, "domain", domain, `Domain name`)
+}
+
+// Run makes the HTTP request corresponding to the RemoveEnvContainerCommand command.
+func (cmd *RemoveEnvContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/env", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp78 *bool
+	if cmd.Deferred != "" {
+		var err error
+		tmp78, err = boolVal(cmd.Deferred)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--deferred", "err", err)
+			return err
+		}
+	}
+	resp, err := c.RemoveEnvContainer(ctx, path, cmd.Name, tmp78)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RemoveEnvContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var deferred string
+	cc.Flags().StringVar(&cmd.Deferred, "deferred", deferred, `Apply the changes at the next restart instead of recreating the container now`)
+	var name []string
+	cc.Flags().StringSliceVar(&cmd.Name, "name", name, `Names of environment variables`)
+}
+
+// Run makes the HTTP request corresponding to the RemoveRouteContainerCommand command.
+func (cmd *RemoveRouteContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/routes", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.RemoveRouteContainer(ctx, path, cmd.Route)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RemoveRouteContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var route int
+	cc.Flags().IntVar(&cmd.Route, "route", route, `Route ID`)
+}
+
+// Run makes the HTTP request corresponding to the RemoveScheduleContainerCommand command.
+func (cmd *RemoveScheduleContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/schedules", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.RemoveScheduleContainer(ctx, path, cmd.Schedule)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RemoveScheduleContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var schedule int
+	cc.Flags().IntVar(&cmd.Schedule, "schedule", schedule, `Schedule ID`)
+}
+
+// Run makes the HTTP request corresponding to the RemoveSecretContainerCommand command.
+func (cmd *RemoveSecretContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/secrets", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp79 *bool
+	if cmd.Deferred != "" {
+		var err error
+		tmp79, err = boolVal(cmd.Deferred)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--deferred", "err", err)
+			return err
+		}
+	}
+	resp, err := c.RemoveSecretContainer(ctx, path, cmd.Name, tmp79)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RemoveSecretContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var deferred string
+	cc.Flags().StringVar(&cmd.Deferred, "deferred", deferred, `Apply the changes at the next restart instead of recreating the container now`)
+	var name string
+	cc.Flags().StringVar(&cmd.Name, "name", name, `Name of the secret`)
+}
+
+// Run makes the HTTP request corresponding to the RestartContainerCommand command.
+func (cmd *RestartContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/restart", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.RestartContainer(ctx, path, intFlagVal("timeout", cmd.Timeout))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RestartContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	cc.Flags().IntVar(&cmd.Timeout, "timeout", 15, `Seconds to wait before killing the container`)
+}
+
+// Run makes the HTTP request corresponding to the RollbackContainerCommand command.
+func (cmd *RollbackContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/rollback", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.RollbackContainer(ctx, path, intFlagVal("drainPeriod", cmd.DrainPeriod), intFlagVal("healthTimeout", cmd.HealthTimeout), intFlagVal("release", cmd.Release), stringFlagVal("strategy", cmd.Strategy), intFlagVal("timeout", cmd.Timeout))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RollbackContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	cc.Flags().IntVar(&cmd.DrainPeriod, "drainPeriod", 10, `Seconds to keep the current container serving in-flight requests after switching in blueGreen`)
+	cc.Flags().IntVar(&cmd.HealthTimeout, "healthTimeout", 60, `Seconds to wait for the new container to become healthy in blueGreen. It is rolled back on timeout`)
+	var release int
+	cc.Flags().IntVar(&cmd.Release, "release", release, `Release number to roll back to. Defaults to the previous release`)
+	cc.Flags().StringVar(&cmd.Strategy, "strategy", "recreate", `recreate: stop the current container and start the new one, blueGreen: switch to the new container after it becomes healthy without downtime`)
+	cc.Flags().IntVar(&cmd.Timeout, "timeout", 15, `Seconds to wait before killing the current container`)
+}
+
+// Run makes the HTTP request corresponding to the RunContainerCommand command.
+func (cmd *RunContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/container/run"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.RunContainer(ctx, path, cmd.Image, cmd.Command, cmd.Entrypoint, cmd.Env, intFlagVal("timeout", cmd.Timeout), stringFlagVal("workingDir", cmd.WorkingDir))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RunContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var command []string
+	cc.Flags().StringSliceVar(&cmd.Command, "command", command, `Command to run specified as a string or an array of strings.`)
+	var entrypoint []string
+	cc.Flags().StringSliceVar(&cmd.Entrypoint, "entrypoint", entrypoint, `The entry point for the container as a string or an array of strings`)
+	var env []string
+	cc.Flags().StringSliceVar(&cmd.Env, "env", env, `Environment variables`)
+	var image string
+	cc.Flags().StringVar(&cmd.Image, "image", image, `Name of image`)
+	cc.Flags().IntVar(&cmd.Timeout, "timeout", 600, `Seconds until the container is killed`)
+	var workingDir string
+	cc.Flags().StringVar(&cmd.WorkingDir, "workingDir", workingDir, `Current directory (PWD) in the command will be launched`)
+}
+
+// Run establishes a websocket connection for the RunStreamContainerCommand command.
+func (cmd *RunStreamContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/container/run/stream"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	ws, err := c.RunStreamContainer(ctx, path, cmd.Image, cmd.Command, cmd.Entrypoint, cmd.Env, intFlagVal("timeout", cmd.Timeout), stringFlagVal("workingDir", cmd.WorkingDir))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+	go goaclient.WSWrite(ws)
+	goaclient.WSRead(ws)
+
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RunStreamContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var command []string
+	cc.Flags().StringSliceVar(&cmd.Command, "command", command, `Command to run specified as a string or an array of strings.`)
+	var entrypoint []string
+	cc.Flags().StringSliceVar(&cmd.Entrypoint, "entrypoint", entrypoint, `The entry point for the container as a string or an array of strings`)
+	var env []string
+	cc.Flags().StringSliceVar(&cmd.Env, "env", env, `Environment variables`)
+	var image string
+	cc.Flags().StringVar(&cmd.Image, "image", image, `Name of image`)
+	cc.Flags().IntVar(&cmd.Timeout, "timeout", 600, `Seconds until the container is killed`)
+	var workingDir string
+	cc.Flags().StringVar(&cmd.WorkingDir, "workingDir", workingDir, `Current directory (PWD) in the command will be launched`)
+}
+
+// Run makes the HTTP request corresponding to the ScaleContainerCommand command.
+func (cmd *ScaleContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/scale", url.QueryEscape(cmd.ID))
 	}
-	return vals, nil
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ScaleContainer(ctx, path, cmd.Replicas, intFlagVal("timeout", cmd.Timeout))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
 }
 
-func boolVal(val string) (*bool, error) {
-	t, err := strconv.ParseBool(val)
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ScaleContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var replicas int
+	cc.Flags().IntVar(&cmd.Replicas, "replicas", replicas, `Number of replicas`)
+	cc.Flags().IntVar(&cmd.Timeout, "timeout", 15, `Seconds to wait before killing the removed replicas`)
+}
+
+// Run makes the HTTP request corresponding to the ScheduleLogsContainerCommand command.
+func (cmd *ScheduleLogsContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/schedules/logs", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ScheduleLogsContainer(ctx, path, intFlagVal("limit", cmd.Limit))
 	if err != nil {
-		return nil, err
+		goa.LogError(ctx, "failed", "err", err)
+		return err
 	}
-	return &t, nil
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
 }
 
-func boolArray(ins []string) ([]bool, error) {
-	if ins == nil {
-		return nil, nil
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ScheduleLogsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	cc.Flags().IntVar(&cmd.Limit, "limit", 50, `Maximum number of logs`)
+}
+
+// Run makes the HTTP request corresponding to the SetConfigContainerCommand command.
+func (cmd *SetConfigContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/config", url.QueryEscape(cmd.ID))
 	}
-	var vals []bool
-	for _, id := range ins {
-		val, err := boolVal(id)
+	var payload client.ContainerConfig
+	if cmd.Payload != "" {
+		err := json.Unmarshal([]byte(cmd.Payload), &payload)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("failed to deserialize payload: %s", err)
 		}
-		vals = append(vals, *val)
 	}
-	return vals, nil
-}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.SetConfigContainer(ctx, path, &payload, cmd.ContentType)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
 
-// Run downloads files with given paths.
-func (cmd *DownloadCommand) Run(c *client.Client, args []string) error {
-	var (
-		fnf func(context.Context, string) (int64, error)
-		fnd func(context.Context, string, string) (int64, error)
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
 
-		rpath   = args[0]
-		outfile = cmd.OutFile
-		logger  = goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
-		ctx     = goa.WithLogger(context.Background(), logger)
-		err     error
-	)
+// RegisterFlags registers the command flags with the command line.
+func (cmd *SetConfigContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
+	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
 
-	if rpath[0] != '/' {
-		rpath = "/" + rpath
+// Run makes the HTTP request corresponding to the SetEnvContainerCommand command.
+func (cmd *SetEnvContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/env", url.QueryEscape(cmd.ID))
 	}
-	if rpath == "/api/v2/swagger/swagger.json" {
-		fnf = c.DownloadSwaggerJSON
-		if outfile == "" {
-			outfile = "swagger.json"
+	var payload client.SetEnvContainerPayload
+	if cmd.Payload != "" {
+		err := json.Unmarshal([]byte(cmd.Payload), &payload)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize payload: %s", err)
 		}
-		goto found
 	}
-	if rpath == "/api/v2/swagger/swagger.yaml" {
-		fnf = c.DownloadSwaggerYaml
-		if outfile == "" {
-			outfile = "swagger.yaml"
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	var tmp80 *bool
+	if cmd.Deferred != "" {
+		var err error
+		tmp80, err = boolVal(cmd.Deferred)
+		if err != nil {
+			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--deferred", "err", err)
+			return err
 		}
-		goto found
 	}
-	return fmt.Errorf("don't know how to download %s", rpath)
-found:
-	ctx = goa.WithLogContext(ctx, "file", outfile)
-	if fnf != nil {
-		_, err = fnf(ctx, outfile)
+	resp, err := c.SetEnvContainer(ctx, path, payload, tmp80, cmd.ContentType)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *SetEnvContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
+	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var deferred string
+	cc.Flags().StringVar(&cmd.Deferred, "deferred", deferred, `Apply the changes at the next restart instead of recreating the container now`)
+}
+
+// Run makes the HTTP request corresponding to the SetLimitsContainerCommand command.
+func (cmd *SetLimitsContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
 	} else {
-		_, err = fnd(ctx, rpath, outfile)
+		path = fmt.Sprintf("/api/v2/container/%v/limits", url.QueryEscape(cmd.ID))
 	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.SetLimitsContainer(ctx, path, intFlagVal("cpu", cmd.CPU), stringFlagVal("memory", cmd.Memory), intFlagVal("pids", cmd.Pids))
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
 	}
 
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
 	return nil
 }
 
-// Run makes the HTTP request corresponding to the CreateContainerCommand command.
-func (cmd *CreateContainerCommand) Run(c *client.Client, args []string) error {
+// RegisterFlags registers the command flags with the command line.
+func (cmd *SetLimitsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var cpu int
+	cc.Flags().IntVar(&cmd.CPU, "cpu", cpu, `Percentage of a CPU the container can use`)
+	var memory string
+	cc.Flags().StringVar(&cmd.Memory, "memory", memory, `Memory the container can use such as 512M`)
+	var pids int
+	cc.Flags().IntVar(&cmd.Pids, "pids", pids, `Maximum number of processes in the container`)
+}
+
+// Run makes the HTTP request corresponding to the StartContainerCommand command.
+func (cmd *StartContainerCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = "/api/v2/container/create"
+		path = fmt.Sprintf("/api/v2/container/%v/start", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	var tmp20 *bool
-	if cmd.SslRedirect != "" {
-		var err error
-		tmp20, err = boolVal(cmd.SslRedirect)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--sslRedirect", "err", err)
-			return err
-		}
-	}
-	resp, err := c.CreateContainer(ctx, path, cmd.Image, cmd.Name, cmd.Command, cmd.Entrypoint, cmd.Env, tmp20, cmd.Volumes, stringFlagVal("workingDir", cmd.WorkingDir))
+	resp, err := c.StartContainer(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -736,35 +3125,22 @@ func (cmd *CreateContainerCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *CreateContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
-	var command []string
-	cc.Flags().StringSliceVar(&cmd.Command, "command", command, `Command to run specified as a string or an array of strings.`)
-	var entrypoint []string
-	cc.Flags().StringSliceVar(&cmd.Entrypoint, "entrypoint", entrypoint, `The entry point for the container as a string or an array of strings`)
-	var env []string
-	cc.Flags().StringSliceVar(&cmd.Env, "env", env, `Environment variables`)
-	var image string
-	cc.Flags().StringVar(&cmd.Image, "image", image, `Name of image`)
-	var name string
-	cc.Flags().StringVar(&cmd.Name, "name", name, `Name of container and subdomain`)
-	cc.Flags().StringVar(&cmd.SslRedirect, "sslRedirect", "true", `Whether HTTP is redirected to HTTPS`)
-	var volumes []string
-	cc.Flags().StringSliceVar(&cmd.Volumes, "volumes", volumes, `Path to volumes in a container`)
-	var workingDir string
-	cc.Flags().StringVar(&cmd.WorkingDir, "workingDir", workingDir, `Current directory (PWD) in the command will be launched`)
+func (cmd *StartContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
 }
 
-// Run makes the HTTP request corresponding to the DownloadContainerCommand command.
-func (cmd *DownloadContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the StatsContainerCommand command.
+func (cmd *StatsContainerCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = "/api/v2/container/download"
+		path = fmt.Sprintf("/api/v2/container/%v/stats", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.DownloadContainer(ctx, path, cmd.InternalPath)
+	resp, err := c.StatsContainer(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -775,33 +3151,22 @@ func (cmd *DownloadContainerCommand) Run(c *client.Client, args []string) error
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *DownloadContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *StatsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	var id string
-	cc.Flags().StringVar(&cmd.ID, "id", id, `ID or name`)
-	var internalPath string
-	cc.Flags().StringVar(&cmd.InternalPath, "internalPath", internalPath, `Path in the container to save files`)
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
 }
 
-// Run establishes a websocket connection for the ExecContainerCommand command.
-func (cmd *ExecContainerCommand) Run(c *client.Client, args []string) error {
+// Run establishes a websocket connection for the StatsStreamContainerCommand command.
+func (cmd *StatsStreamContainerCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/exec", url.QueryEscape(cmd.ID))
+		path = fmt.Sprintf("/api/v2/container/%v/stats/stream", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	var tmp21 *bool
-	if cmd.Tty != "" {
-		var err error
-		tmp21, err = boolVal(cmd.Tty)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--tty", "err", err)
-			return err
-		}
-	}
-	ws, err := c.ExecContainer(ctx, path, cmd.Command, tmp21)
+	ws, err := c.StatsStreamContainer(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -813,26 +3178,22 @@ func (cmd *ExecContainerCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *ExecContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *StatsStreamContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	var id string
 	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
-	var command []string
-	cc.Flags().StringSliceVar(&cmd.Command, "command", command, `The path to the executable file`)
-	var tty string
-	cc.Flags().StringVar(&cmd.Tty, "tty", tty, `Tty`)
 }
 
-// Run makes the HTTP request corresponding to the GetConfigContainerCommand command.
-func (cmd *GetConfigContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the StopContainerCommand command.
+func (cmd *StopContainerCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/config", url.QueryEscape(cmd.ID))
+		path = fmt.Sprintf("/api/v2/container/%v/stop", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.GetConfigContainer(ctx, path)
+	resp, err := c.StopContainer(ctx, path, intFlagVal("timeout", cmd.Timeout))
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -843,22 +3204,56 @@ func (cmd *GetConfigContainerCommand) Run(c *client.Client, args []string) error
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *GetConfigContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *StopContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	var id string
 	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	cc.Flags().IntVar(&cmd.Timeout, "timeout", 15, `Seconds to wait before killing the container`)
 }
 
-// Run makes the HTTP request corresponding to the InspectContainerCommand command.
-func (cmd *InspectContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the UnpauseContainerCommand command.
+func (cmd *UnpauseContainerCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/inspect", url.QueryEscape(cmd.ID))
+		path = fmt.Sprintf("/api/v2/container/%v/unpause", url.QueryEscape(cmd.ID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.UnpauseContainer(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *UnpauseContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+}
+
+// Run makes the HTTP request corresponding to the UploadContainerCommand command.
+func (cmd *UploadContainerCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/container/%v/upload", url.QueryEscape(cmd.ID))
+	}
+	var payload client.UploadPayload
+	if cmd.Payload != "" {
+		err := json.Unmarshal([]byte(cmd.Payload), &payload)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize payload: %s", err)
+		}
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.InspectContainer(ctx, path)
+	resp, err := c.UploadContainer(ctx, path, &payload, cmd.ContentType)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -869,22 +3264,24 @@ func (cmd *InspectContainerCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *InspectContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *UploadContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
+	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
 	var id string
 	cc.Flags().StringVar(&cmd.ID, "id", id, `ID or name`)
 }
 
-// Run makes the HTTP request corresponding to the ListContainerCommand command.
-func (cmd *ListContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the VerifyDomainContainerCommand command.
+func (cmd *VerifyDomainContainerCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = "/api/v2/container/list"
+		path = fmt.Sprintf("/api/v2/container/%v/domains/verify", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.ListContainer(ctx, path)
+	resp, err := c.VerifyDomainContainer(ctx, path, cmd.Domain)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -895,127 +3292,60 @@ func (cmd *ListContainerCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *ListContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *VerifyDomainContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var id string
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	var domain string
+	cc.Flags().StringVar(&cmd.Domain, "domain", domain, `Domain name`)
 }
 
-// Run establishes a websocket connection for the LogsContainerCommand command.
-func (cmd *LogsContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the CreateJobCommand command.
+func (cmd *CreateJobCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/logs", url.QueryEscape(cmd.ID))
+		path = "/api/v2/job/create"
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	var tmp22 *bool
-	if cmd.Follow != "" {
-		var err error
-		tmp22, err = boolVal(cmd.Follow)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--follow", "err", err)
-			return err
-		}
-	}
-	var tmp23 *time.Time
-	if cmd.Since != "" {
-		var err error
-		tmp23, err = timeVal(cmd.Since)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *time.Time value", "flag", "--since", "err", err)
-			return err
-		}
-	}
-	var tmp24 *bool
-	if cmd.Stderr != "" {
-		var err error
-		tmp24, err = boolVal(cmd.Stderr)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--stderr", "err", err)
-			return err
-		}
-	}
-	var tmp25 *bool
-	if cmd.Stdout != "" {
-		var err error
-		tmp25, err = boolVal(cmd.Stdout)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--stdout", "err", err)
-			return err
-		}
-	}
-	var tmp26 *bool
-	if cmd.Timestamps != "" {
-		var err error
-		tmp26, err = boolVal(cmd.Timestamps)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--timestamps", "err", err)
-			return err
-		}
-	}
-	var tmp27 *time.Time
-	if cmd.Until != "" {
-		var err error
-		tmp27, err = timeVal(cmd.Until)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *time.Time value", "flag", "--until", "err", err)
-			return err
-		}
-	}
-	ws, err := c.LogsContainer(ctx, path, tmp22, tmp23, tmp24, tmp25, stringFlagVal("tail", cmd.Tail), tmp26, tmp27)
+	resp, err := c.CreateJob(ctx, path, cmd.Name, cmd.Schedule, cmd.Command, stringFlagVal("container", cmd.Container), cmd.Env, stringFlagVal("image", cmd.Image))
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
 	}
-	go goaclient.WSWrite(ws)
-	goaclient.WSRead(ws)
 
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
 	return nil
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *LogsContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
-	var id string
-	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
-	var follow string
-	cc.Flags().StringVar(&cmd.Follow, "follow", follow, ``)
-	var since string
-	cc.Flags().StringVar(&cmd.Since, "since", since, ``)
-	var stderr string
-	cc.Flags().StringVar(&cmd.Stderr, "stderr", stderr, ``)
-	var stdout string
-	cc.Flags().StringVar(&cmd.Stdout, "stdout", stdout, ``)
-	cc.Flags().StringVar(&cmd.Tail, "tail", "all", ``)
-	var timestamps string
-	cc.Flags().StringVar(&cmd.Timestamps, "timestamps", timestamps, ``)
-	var until string
-	cc.Flags().StringVar(&cmd.Until, "until", until, ``)
+func (cmd *CreateJobCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var command []string
+	cc.Flags().StringSliceVar(&cmd.Command, "command", command, `Command to run. Required with container`)
+	var container string
+	cc.Flags().StringVar(&cmd.Container, "container", container, `id or name of the container the command is executed in`)
+	var env []string
+	cc.Flags().StringSliceVar(&cmd.Env, "env", env, `Environment variables`)
+	var image string
+	cc.Flags().StringVar(&cmd.Image, "image", image, `Name of the image run as a one-off container`)
+	var name string
+	cc.Flags().StringVar(&cmd.Name, "name", name, `Name of the job`)
+	var schedule string
+	cc.Flags().StringVar(&cmd.Schedule, "schedule", schedule, `Cron expression(minute hour day month weekday) or a descriptor such as @daily`)
 }
 
-// Run makes the HTTP request corresponding to the RemoveContainerCommand command.
-func (cmd *RemoveContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the InspectJobCommand command.
+func (cmd *InspectJobCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/remove", url.QueryEscape(cmd.ID))
+		path = fmt.Sprintf("/api/v2/job/%v/inspect", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	var tmp28 *bool
-	if cmd.Force != "" {
-		var err error
-		tmp28, err = boolVal(cmd.Force)
-		if err != nil {
-			goa.LogError(ctx, "failed to parse flag into *bool value", "flag", "--force", "err", err)
-			return err
-		}
-	}
-	if tmp28 == nil {
-		goa.LogError(ctx, "required flag is missing", "flag", "--force")
-		return fmt.Errorf("required flag force is missing")
-	}
-	resp, err := c.RemoveContainer(ctx, path, *tmp28)
+	resp, err := c.InspectJob(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -1026,31 +3356,22 @@ func (cmd *RemoveContainerCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *RemoveContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *InspectJobCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	var id string
 	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
-	var force string
-	cc.Flags().StringVar(&cmd.Force, "force", force, `If the container is running, kill it before removing it.`)
 }
 
-// Run makes the HTTP request corresponding to the SetConfigContainerCommand command.
-func (cmd *SetConfigContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the ListJobCommand command.
+func (cmd *ListJobCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/config", url.QueryEscape(cmd.ID))
-	}
-	var payload client.ContainerConfig
-	if cmd.Payload != "" {
-		err := json.Unmarshal([]byte(cmd.Payload), &payload)
-		if err != nil {
-			return fmt.Errorf("failed to deserialize payload: %s", err)
-		}
+		path = "/api/v2/job/list"
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.SetConfigContainer(ctx, path, &payload, cmd.ContentType)
+	resp, err := c.ListJob(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -1061,24 +3382,20 @@ func (cmd *SetConfigContainerCommand) Run(c *client.Client, args []string) error
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *SetConfigContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
-	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
-	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
-	var id string
-	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+func (cmd *ListJobCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 }
 
-// Run makes the HTTP request corresponding to the StartContainerCommand command.
-func (cmd *StartContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the RemoveJobCommand command.
+func (cmd *RemoveJobCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/start", url.QueryEscape(cmd.ID))
+		path = fmt.Sprintf("/api/v2/job/%v/remove", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.StartContainer(ctx, path)
+	resp, err := c.RemoveJob(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -1089,22 +3406,22 @@ func (cmd *StartContainerCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *StartContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *RemoveJobCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	var id string
 	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
 }
 
-// Run makes the HTTP request corresponding to the StopContainerCommand command.
-func (cmd *StopContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the RunJobCommand command.
+func (cmd *RunJobCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/stop", url.QueryEscape(cmd.ID))
+		path = fmt.Sprintf("/api/v2/job/%v/run", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.StopContainer(ctx, path)
+	resp, err := c.RunJob(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -1115,29 +3432,22 @@ func (cmd *StopContainerCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *StopContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *RunJobCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	var id string
 	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
 }
 
-// Run makes the HTTP request corresponding to the UploadContainerCommand command.
-func (cmd *UploadContainerCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the RunsJobCommand command.
+func (cmd *RunsJobCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/api/v2/container/%v/upload", url.QueryEscape(cmd.ID))
-	}
-	var payload client.UploadPayload
-	if cmd.Payload != "" {
-		err := json.Unmarshal([]byte(cmd.Payload), &payload)
-		if err != nil {
-			return fmt.Errorf("failed to deserialize payload: %s", err)
-		}
+		path = fmt.Sprintf("/api/v2/job/%v/runs", url.QueryEscape(cmd.ID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.UploadContainer(ctx, path, &payload, cmd.ContentType)
+	resp, err := c.RunsJob(ctx, path, intFlagVal("limit", cmd.Limit))
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -1148,11 +3458,10 @@ func (cmd *UploadContainerCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *UploadContainerCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
-	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
-	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
+func (cmd *RunsJobCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	var id string
-	cc.Flags().StringVar(&cmd.ID, "id", id, `ID or name`)
+	cc.Flags().StringVar(&cmd.ID, "id", id, `id or name`)
+	cc.Flags().IntVar(&cmd.Limit, "limit", 20, `Maximum number of runs`)
 }
 
 // Run makes the HTTP request corresponding to the AddAuthorizedKeysUserCommand command.
@@ -1236,6 +3545,30 @@ func (cmd *GetDefaultShellUserCommand) Run(c *client.Client, args []string) erro
 func (cmd *GetDefaultShellUserCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 }
 
+// Run makes the HTTP request corresponding to the GetQuotaUserCommand command.
+func (cmd *GetQuotaUserCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/user/quota"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetQuotaUser(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetQuotaUserCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+}
+
 // Run makes the HTTP request corresponding to the ListAuthorizedKeysUserCommand command.
 func (cmd *ListAuthorizedKeysUserCommand) Run(c *client.Client, args []string) error {
 	var path string
@@ -1260,6 +3593,30 @@ func (cmd *ListAuthorizedKeysUserCommand) Run(c *client.Client, args []string) e
 func (cmd *ListAuthorizedKeysUserCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 }
 
+// Run makes the HTTP request corresponding to the ListSecretsUserCommand command.
+func (cmd *ListSecretsUserCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/user/secrets"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ListSecretsUser(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ListSecretsUserCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+}
+
 // Run makes the HTTP request corresponding to the RemoveAuthorizedKeysUserCommand command.
 func (cmd *RemoveAuthorizedKeysUserCommand) Run(c *client.Client, args []string) error {
 	var path string
@@ -1286,6 +3643,32 @@ func (cmd *RemoveAuthorizedKeysUserCommand) RegisterFlags(cc *cobra.Command, c *
 	cc.Flags().StringVar(&cmd.Label, "label", label, ``)
 }
 
+// Run makes the HTTP request corresponding to the RemoveSecretUserCommand command.
+func (cmd *RemoveSecretUserCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/user/secrets"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.RemoveSecretUser(ctx, path, cmd.Name)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *RemoveSecretUserCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var name string
+	cc.Flags().StringVar(&cmd.Name, "name", name, ``)
+}
+
 // Run makes the HTTP request corresponding to the SetAuthorizedKeysUserCommand command.
 func (cmd *SetAuthorizedKeysUserCommand) Run(c *client.Client, args []string) error {
 	var path string
@@ -1344,3 +3727,71 @@ func (cmd *SetDefaultShellUserCommand) RegisterFlags(cc *cobra.Command, c *clien
 	var defaultShell string
 	cc.Flags().StringVar(&cmd.DefaultShell, "defaultShell", defaultShell, ``)
 }
+
+// Run makes the HTTP request corresponding to the SetQuotaUserCommand command.
+func (cmd *SetQuotaUserCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/api/v2/user/quota/%v", url.QueryEscape(cmd.UID))
+	}
+	var payload client.UserQuota
+	if cmd.Payload != "" {
+		err := json.Unmarshal([]byte(cmd.Payload), &payload)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize payload: %s", err)
+		}
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.SetQuotaUser(ctx, path, &payload, cmd.ContentType)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *SetQuotaUserCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
+	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
+	var uid string
+	cc.Flags().StringVar(&cmd.UID, "uid", uid, `ID of the user`)
+}
+
+// Run makes the HTTP request corresponding to the SetSecretUserCommand command.
+func (cmd *SetSecretUserCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/api/v2/user/secrets"
+	}
+	var payload client.UserSecret
+	if cmd.Payload != "" {
+		err := json.Unmarshal([]byte(cmd.Payload), &payload)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize payload: %s", err)
+		}
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.SetSecretUser(ctx, path, &payload, cmd.ContentType)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *SetSecretUserCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
+	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/docker/libkv/store"
 
@@ -196,3 +198,153 @@ func (c *UserController) SetDefaultShell(ctx *app.SetDefaultShellUserContext) er
 	return ctx.NoContent()
 	// UserController_SetDefaultShell: end_implement
 }
+
+// ListSecrets runs the listSecrets action.
+func (c *UserController) ListSecrets(ctx *app.ListSecretsUserContext) error {
+	// UserController_ListSecrets: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	secrets, err := c.Secrets.List(ctx, uid)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.GoaUserSecretCollection, len(secrets))
+	for i := range secrets {
+		res[i] = &app.GoaUserSecret{
+			Name:      secrets[i].Name,
+			UpdatedAt: secrets[i].UpdatedAt,
+		}
+	}
+
+	return ctx.OK(res)
+	// UserController_ListSecrets: end_implement
+}
+
+// SetSecret runs the setSecret action.
+func (c *UserController) SetSecret(ctx *app.SetSecretUserContext) error {
+	// UserController_SetSecret: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := c.Secrets.Put(ctx, uid, ctx.Payload.Name, ctx.Payload.Value); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.NoContent()
+	// UserController_SetSecret: end_implement
+}
+
+// RemoveSecret runs the removeSecret action.
+func (c *UserController) RemoveSecret(ctx *app.RemoveSecretUserContext) error {
+	// UserController_RemoveSecret: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var names []string
+	err = c.DB.Select(&names, "SELECT containers.name FROM containerSecrets INNER JOIN containers ON containerSecrets.containerID=containers.id WHERE containers.uid=? AND containerSecrets.name=?", uid, ctx.Name)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "DB error")))
+	}
+
+	if len(names) != 0 {
+		return ctx.Conflict(goa.ErrInvalidRequest(fmt.Errorf("The secret is used by containers: %s", strings.Join(names, ", "))))
+	}
+
+	if err := c.Secrets.Delete(ctx, uid, ctx.Name); err == sql.ErrNoRows {
+		return ctx.NotFound()
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.NoContent()
+	// UserController_RemoveSecret: end_implement
+}
+
+// GetQuota runs the getQuota action.
+func (c *UserController) GetQuota(ctx *app.GetQuotaUserContext) error {
+	// UserController_GetQuota: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	q, err := userQuota(ctx, c.DB, c.Consul, uid, false)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	usage, err := quotaUsage(ctx, c.DB, uid, 0)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.GoaUserQuota{
+		Limits: q.media(true),
+		Usage:  usage.media(false),
+	})
+
+	// UserController_GetQuota: end_implement
+}
+
+// SetQuota runs the setQuota action.
+func (c *UserController) SetQuota(ctx *app.SetQuotaUserContext) error {
+	// UserController_SetQuota: start_implement
+
+	uid, err := GetUIDFromJWT(ctx)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if !isAdmin(c.Consul, uid) {
+		return ctx.Forbidden(goa.ErrInvalidRequest(errors.New("Only administrators can set quotas")))
+	}
+
+	memory, err := parseBytes("memory", ctx.Payload.Memory)
+
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	storage, err := parseBytes("storage", ctx.Payload.Storage)
+
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	// Missing ones are NULL to use the defaults
+	_, err = c.DB.ExecContext(
+		ctx,
+		`INSERT INTO userQuotas (uid, containers, cpu, memory, storage, volumes) VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE containers=VALUES(containers), cpu=VALUES(cpu), memory=VALUES(memory), storage=VALUES(storage), volumes=VALUES(volumes)`,
+		ctx.UID, ctx.Payload.Containers, ctx.Payload.CPU, memory, storage, ctx.Payload.Volumes,
+	)
+
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(errors.Wrap(err, "Database Error")))
+	}
+
+	return ctx.NoContent()
+
+	// UserController_SetQuota: end_implement
+}
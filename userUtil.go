@@ -10,4 +10,5 @@ type UserControllerUtil struct {
 	DB           *sqlx.DB
 	DockerClient *client.Client
 	Consul       *consulTraefik.Client
+	Secrets      *SecretStore
 }